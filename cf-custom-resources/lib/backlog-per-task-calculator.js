@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const aws = require("aws-sdk");
+
+/**
+ * This lambda function calculates the backlog of SQS messages per running ECS tasks,
+ * and writes the metric to CloudWatch using the embedded metric format.
+ * The metric is used by the service's target tracking scaling policies.
+ *
+ * The following environment variables are expected:
+ *   NAMESPACE: the CloudWatch namespace of the metric.
+ *   CLUSTER_NAME: the name of the ECS cluster of the service.
+ *   SERVICE_NAME: the name of the ECS service.
+ *   QUEUE_NAMES: a comma-separated list of SQS queue names that the service polls from.
+ */
+exports.handler = async (event, context) => {
+  const timestamp = Date.now();
+  const queueNames = process.env.QUEUE_NAMES.split(",");
+  try {
+    const runningCount = await getRunningTaskCount(
+      process.env.CLUSTER_NAME,
+      process.env.SERVICE_NAME
+    );
+    const backlogs = await Promise.all(
+      queueNames.map(async (name) => {
+        const url = await getQueueURL(name);
+        return {
+          queueName: name,
+          backlogPerTask: await getBacklogPerTask(url, runningCount),
+        };
+      })
+    );
+    for (const { queueName, backlogPerTask } of backlogs) {
+      console.log(
+        JSON.stringify(
+          backlogPerTaskEMF(process.env.NAMESPACE, queueName, backlogPerTask, timestamp)
+        )
+      );
+    }
+  } catch (err) {
+    console.error(`Unexpected error ${err}`);
+  }
+};
+
+/**
+ * Returns the number of running tasks of an ECS service.
+ *
+ * @param {string} clusterId the name or ARN of the ECS cluster.
+ * @param {string} serviceName the name of the ECS service.
+ * @returns {number} the number of running tasks, or 0 if the service doesn't exist.
+ */
+const getRunningTaskCount = async (clusterId, serviceName) => {
+  const ecs = new aws.ECS();
+  const resp = await ecs
+    .describeServices({
+      cluster: clusterId,
+      services: [serviceName],
+    })
+    .promise();
+  if (resp.services.length !== 1) {
+    throw new Error(
+      `Unexpected number of services ${resp.services.length} for cluster ${clusterId} and service ${serviceName}`
+    );
+  }
+  return resp.services[0].runningCount;
+};
+
+/**
+ * Returns the URL of an SQS queue.
+ *
+ * @param {string} queueName the name of the queue.
+ * @returns {string} the URL of the queue.
+ */
+const getQueueURL = async (queueName) => {
+  const sqs = new aws.SQS();
+  const resp = await sqs
+    .getQueueUrl({
+      QueueName: queueName,
+    })
+    .promise();
+  return resp.QueueUrl;
+};
+
+/**
+ * Returns the number of visible messages in the queue divided by the number of running tasks.
+ * If there are no running tasks, the number of visible messages is returned instead.
+ *
+ * @param {string} queueURL the URL of the queue.
+ * @param {number} runningTaskCount the number of running tasks of the service.
+ * @returns {number} the rounded up number of messages per task.
+ */
+const getBacklogPerTask = async (queueURL, runningTaskCount) => {
+  const sqs = new aws.SQS();
+  const resp = await sqs
+    .getQueueAttributes({
+      QueueUrl: queueURL,
+      AttributeNames: ["ApproximateNumberOfMessages"],
+    })
+    .promise();
+  const visible = parseInt(resp.Attributes.ApproximateNumberOfMessages, 10);
+  if (runningTaskCount === 0) {
+    return visible;
+  }
+  return Math.ceil(visible / runningTaskCount);
+};
+
+/**
+ * Returns the BacklogPerTask metric in the CloudWatch embedded metric format.
+ * See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch_Embedded_Metric_Format_Specification.html
+ *
+ * @param {string} namespace the CloudWatch namespace of the metric.
+ * @param {string} queueName the name of the queue used as the metric dimension.
+ * @param {number} backlogPerTask the value of the metric.
+ * @param {number} timestamp the number of milliseconds since epoch.
+ * @returns {object} the metric in the embedded metric format.
+ */
+const backlogPerTaskEMF = (namespace, queueName, backlogPerTask, timestamp) => ({
+  _aws: {
+    Timestamp: timestamp,
+    CloudWatchMetrics: [
+      {
+        Namespace: namespace,
+        Dimensions: [["QueueName"]],
+        Metrics: [
+          {
+            Name: "BacklogPerTask",
+            Unit: "Count",
+          },
+        ],
+      },
+    ],
+  },
+  QueueName: queueName,
+  BacklogPerTask: backlogPerTask,
+});
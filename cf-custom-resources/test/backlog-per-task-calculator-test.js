@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("BacklogPerTaskCalculator", () => {
+  const AWS = require("aws-sdk-mock");
+  const sinon = require("sinon");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  const BacklogPerTaskCalculator = require("../lib/backlog-per-task-calculator");
+  const origLog = console.log;
+  const origErr = console.error;
+  const origEnv = process.env;
+
+  let logFake;
+  let errFake;
+
+  beforeEach(() => {
+    logFake = sinon.fake();
+    errFake = sinon.fake();
+    console.log = logFake;
+    console.error = errFake;
+    process.env = {
+      ...origEnv,
+      NAMESPACE: "app-env-svc",
+      CLUSTER_NAME: "cluster",
+      SERVICE_NAME: "svc",
+      QUEUE_NAMES: "eventsQueue,ordersQueue",
+    };
+  });
+  afterEach(() => {
+    AWS.restore();
+    console.log = origLog;
+    console.error = origErr;
+    process.env = origEnv;
+  });
+
+  test("should write the backlog per task of each queue", () => {
+    const describeServicesFake = sinon.fake.resolves({
+      services: [{ runningCount: 3 }],
+    });
+    const getQueueUrlFake = sinon.stub();
+    getQueueUrlFake
+      .withArgs(sinon.match({ QueueName: "eventsQueue" }))
+      .resolves({ QueueUrl: "url/eventsQueue" });
+    getQueueUrlFake
+      .withArgs(sinon.match({ QueueName: "ordersQueue" }))
+      .resolves({ QueueUrl: "url/ordersQueue" });
+    const getQueueAttributesFake = sinon.stub();
+    getQueueAttributesFake
+      .withArgs(sinon.match({ QueueUrl: "url/eventsQueue" }))
+      .resolves({ Attributes: { ApproximateNumberOfMessages: "10" } });
+    getQueueAttributesFake
+      .withArgs(sinon.match({ QueueUrl: "url/ordersQueue" }))
+      .resolves({ Attributes: { ApproximateNumberOfMessages: "0" } });
+    AWS.mock("ECS", "describeServices", describeServicesFake);
+    AWS.mock("SQS", "getQueueUrl", getQueueUrlFake);
+    AWS.mock("SQS", "getQueueAttributes", getQueueAttributesFake);
+
+    return LambdaTester(BacklogPerTaskCalculator.handler)
+      .event({})
+      .expectResolve(() => {
+        sinon.assert.calledWith(describeServicesFake, {
+          cluster: "cluster",
+          services: ["svc"],
+        });
+        sinon.assert.calledTwice(logFake);
+        const events = logFake.args.map((args) => JSON.parse(args[0]));
+        expect(events[0].QueueName).toBe("eventsQueue");
+        expect(events[0].BacklogPerTask).toBe(4);
+        expect(events[0]._aws.CloudWatchMetrics[0].Namespace).toBe("app-env-svc");
+        expect(events[1].QueueName).toBe("ordersQueue");
+        expect(events[1].BacklogPerTask).toBe(0);
+      });
+  });
+
+  test("should use the number of visible messages when there are no running tasks", () => {
+    process.env.QUEUE_NAMES = "eventsQueue";
+    AWS.mock("ECS", "describeServices", sinon.fake.resolves({
+      services: [{ runningCount: 0 }],
+    }));
+    AWS.mock("SQS", "getQueueUrl", sinon.fake.resolves({ QueueUrl: "url/eventsQueue" }));
+    AWS.mock("SQS", "getQueueAttributes", sinon.fake.resolves({
+      Attributes: { ApproximateNumberOfMessages: "7" },
+    }));
+
+    return LambdaTester(BacklogPerTaskCalculator.handler)
+      .event({})
+      .expectResolve(() => {
+        sinon.assert.calledOnce(logFake);
+        expect(JSON.parse(logFake.args[0][0]).BacklogPerTask).toBe(7);
+      });
+  });
+
+  test("should log an error if the service cannot be described", () => {
+    AWS.mock("ECS", "describeServices", sinon.fake.rejects(new Error("some error")));
+
+    return LambdaTester(BacklogPerTaskCalculator.handler)
+      .event({})
+      .expectResolve(() => {
+        sinon.assert.notCalled(logFake);
+        sinon.assert.calledWith(errFake, "Unexpected error Error: some error");
+      });
+  });
+});
@@ -67,6 +67,7 @@ func buildRootCmd() *cobra.Command {
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
+	cmd.AddCommand(cli.BuildSchemaCmd())
 
 	// "Release" command group.
 	cmd.AddCommand(cli.BuildPipelineCmd())
@@ -92,6 +92,54 @@ func (c *CloudFormation) DescribeChangeSet(changeSetID, stackName string) (*Chan
 	return out, nil
 }
 
+// CreateChangeSet creates a change set for the stack without executing it and returns the change set ID.
+// If the stack does not exist, the change set creates the stack. Otherwise, the change set updates the existing stack.
+// If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) CreateChangeSet(stack *Stack) (changeSetID string, err error) {
+	newChangeSet := newUpdateChangeSet
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if !errors.As(err, &stackNotFound) {
+			return "", err
+		}
+		newChangeSet = newCreateChangeSet
+	} else {
+		status := StackStatus(aws.StringValue(descr.StackStatus))
+		if status.InProgress() {
+			return "", &ErrStackUpdateInProgress{
+				Name: stack.Name,
+			}
+		}
+		if status.requiresCleanup() {
+			if err := c.DeleteAndWait(stack.Name); err != nil {
+				return "", fmt.Errorf("clean up previously failed stack %s: %w", stack.Name, err)
+			}
+			newChangeSet = newCreateChangeSet
+		}
+	}
+	cs, err := newChangeSet(c.client, stack.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := cs.createOrErrEmpty(stack.stackConfig); err != nil {
+		return "", err
+	}
+	return cs.name, nil
+}
+
+// ExecuteChangeSet executes a change set that was previously created with CreateChangeSet.
+func (c *CloudFormation) ExecuteChangeSet(changeSetID, stackName string) error {
+	cs := &changeSet{name: changeSetID, stackName: stackName, client: c.client}
+	return cs.execute()
+}
+
+// DeleteChangeSet removes a change set that was created but not executed.
+func (c *CloudFormation) DeleteChangeSet(changeSetID, stackName string) error {
+	cs := &changeSet{name: changeSetID, stackName: stackName, client: c.client}
+	return cs.delete()
+}
+
 // WaitForCreate blocks until the stack is created or until the max attempt window expires.
 func (c *CloudFormation) WaitForCreate(ctx context.Context, stackName string) error {
 	err := c.client.WaitUntilStackCreateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
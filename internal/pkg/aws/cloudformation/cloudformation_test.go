@@ -218,6 +218,184 @@ func TestCloudFormation_DescribeChangeSet(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_CreateChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"fail if checking the stack description fails": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe stack %s: some error", mockStack.Name),
+		},
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStack.Name,
+			},
+		},
+		"deletes the empty change set and returns ErrChangeSetEmpty": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					StatusReason: aws.String("The submitted information didn't contain changes. Submit different information to create a change set."),
+				}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedErr: &ErrChangeSetEmpty{
+				cs: &changeSet{
+					name:      mockChangeSetName,
+					stackName: mockStack.Name,
+				},
+			},
+		},
+		"creates a change set of type CREATE without executing it if the stack doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+					require.Equal(t, cloudformation.ChangeSetTypeCreate, aws.StringValue(in.ChangeSetType))
+					return &cloudformation.CreateChangeSetOutput{
+						Id: aws.String(mockChangeSetID),
+					}, nil
+				})
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"creates a change set of type UPDATE without executing it if the stack exists": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+					require.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(in.ChangeSetType))
+					return &cloudformation.CreateChangeSetOutput{
+						Id: aws.String(mockChangeSetID),
+					}, nil
+				})
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.CreateChangeSet(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, mockChangeSetID, id)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteChangeSet(t *testing.T) {
+	t.Run("skips execution if the change set has no changes", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+			ExecutionStatus: aws.String(cloudformation.ExecutionStatusUnavailable),
+			StatusReason:    aws.String(noChangesReason),
+		}, nil)
+		m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+		cfn := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		err := cfn.ExecuteChangeSet(mockChangeSetID, "phonetool-test")
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("executes an available change set", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+			ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+		}, nil)
+		m.EXPECT().ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+			ChangeSetName: aws.String(mockChangeSetID),
+			StackName:     aws.String("phonetool-test"),
+		}).Return(nil, errors.New("some error"))
+		cfn := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		err := cfn.ExecuteChangeSet(mockChangeSetID, "phonetool-test")
+
+		// THEN
+		require.EqualError(t, err, fmt.Sprintf("execute change set %s for stack phonetool-test: some error", mockChangeSetID))
+	})
+}
+
+func TestCloudFormation_DeleteChangeSet(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockclient(ctrl)
+	m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetID),
+		StackName:     aws.String("phonetool-test"),
+	}).Return(nil, errors.New("some error"))
+	cfn := CloudFormation{
+		client: m,
+	}
+
+	// WHEN
+	err := cfn.DeleteChangeSet(mockChangeSetID, "phonetool-test")
+
+	// THEN
+	require.EqualError(t, err, fmt.Sprintf("delete change set %s for stack phonetool-test: some error", mockChangeSetID))
+}
+
 func TestCloudFormation_WaitForCreate(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -67,6 +67,19 @@ func WithParameters(params map[string]string) StackOption {
 	}
 }
 
+// WithPreviousParameterValue makes the stack keep the value that it's deployed with for the parameter.
+func WithPreviousParameterValue(key string) StackOption {
+	return func(s *Stack) {
+		for _, param := range s.Parameters {
+			if aws.StringValue(param.ParameterKey) != key {
+				continue
+			}
+			param.ParameterValue = nil
+			param.UsePreviousValue = aws.Bool(true)
+		}
+	}
+}
+
 // WithTags applies the tags to a stack.
 func WithTags(tags map[string]string) StackOption {
 	return func(s *Stack) {
@@ -68,3 +68,21 @@ func TestNewStackWithURL(t *testing.T) {
 	}, s.Tags)
 	require.Equal(t, aws.String("arn"), s.RoleARN)
 }
+
+func TestWithPreviousParameterValue(t *testing.T) {
+	// WHEN
+	s := NewStack("hello", "world",
+		WithParameters(map[string]string{
+			"AddonsTemplateURL": "",
+		}),
+		WithPreviousParameterValue("AddonsTemplateURL"),
+		WithPreviousParameterValue("Port"))
+
+	// THEN
+	require.Equal(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:     aws.String("AddonsTemplateURL"),
+			UsePreviousValue: aws.Bool(true),
+		},
+	}, s.Parameters)
+}
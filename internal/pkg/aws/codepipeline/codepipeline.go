@@ -7,6 +7,7 @@ package codepipeline
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/xlab/treeprint"
@@ -28,6 +29,9 @@ type api interface {
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	StartPipelineExecution(input *cp.StartPipelineExecutionInput) (*cp.StartPipelineExecutionOutput, error)
+	GetPipelineExecution(input *cp.GetPipelineExecutionInput) (*cp.GetPipelineExecutionOutput, error)
+	ListActionExecutions(input *cp.ListActionExecutionsInput) (*cp.ListActionExecutionsOutput, error)
 }
 
 type resourceGetter interface {
@@ -73,9 +77,36 @@ type StageState struct {
 }
 
 // StageAction wraps a CodePipeline stage action.
+// Actions that did not run yet in the stage have an empty status.
 type StageAction struct {
-	Name   string `json:"name"`
-	Status string `json:"status"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// Execution represents an execution of a pipeline.
+type Execution struct {
+	ID            string            `json:"id"`
+	Status        string            `json:"status"`
+	CommitID      string            `json:"commitId,omitempty"` // Revision of the source that the execution released.
+	Trigger       string            `json:"trigger"`
+	StartedAt     time.Time         `json:"startedAt"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+	FailedActions []ActionExecution `json:"failedActions,omitempty"`
+}
+
+// Duration returns how long the execution ran for, or has been running for if it is in progress.
+func (e *Execution) Duration() time.Duration {
+	return e.UpdatedAt.Sub(e.StartedAt)
+}
+
+// ActionExecution represents the latest run of an action during an execution of a pipeline.
+type ActionExecution struct {
+	StageName  string    `json:"stageName"`
+	ActionName string    `json:"actionName"`
+	Status     string    `json:"status"`
+	Summary    string    `json:"summary,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
 }
 
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
@@ -192,12 +223,121 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	}); err != nil {
 		noFailedActions := &cp.StageNotRetryableException{}
 		if !errors.As(err, &noFailedActions) {
-			return fmt.Errorf("retry pipeline source stage: %w", err)
+			return fmt.Errorf("retry stage %s of pipeline %s: %w", stageName, pipelineName, err)
 		}
 	}
 	return nil
 }
 
+// StartPipelineExecution starts a new execution of the pipeline with the latest revision of its source,
+// and returns the ID of the execution.
+func (c *CodePipeline) StartPipelineExecution(pipelineName string) (string, error) {
+	out, err := c.client.StartPipelineExecution(&cp.StartPipelineExecutionInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of pipeline %s: %w", pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecutionId), nil
+}
+
+// PipelineExecutionStatus returns the status of an execution of the pipeline, such as "InProgress" or "Succeeded".
+func (c *CodePipeline) PipelineExecutionStatus(pipelineName, executionID string) (string, error) {
+	out, err := c.client.GetPipelineExecution(&cp.GetPipelineExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		PipelineExecutionId: aws.String(executionID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get execution %s of pipeline %s: %w", executionID, pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecution.Status), nil
+}
+
+// ActionExecutions returns the latest run of each action that started during an execution of the pipeline,
+// in the order the actions started.
+func (c *CodePipeline) ActionExecutions(pipelineName, executionID string) ([]ActionExecution, error) {
+	var details []*cp.ActionExecutionDetail
+	input := &cp.ListActionExecutionsInput{
+		PipelineName: aws.String(pipelineName),
+		Filter: &cp.ActionExecutionFilter{
+			PipelineExecutionId: aws.String(executionID),
+		},
+	}
+	for {
+		out, err := c.client.ListActionExecutions(input)
+		if err != nil {
+			return nil, fmt.Errorf("list action executions of execution %s of pipeline %s: %w", executionID, pipelineName, err)
+		}
+		details = append(details, out.ActionExecutionDetails...)
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	// An action that was retried runs more than once during the execution, keep its latest run only.
+	latest := make(map[string]ActionExecution)
+	for _, detail := range details {
+		action := actionExecution(detail)
+		key := action.StageName + "/" + action.ActionName
+		if prev, ok := latest[key]; ok && prev.StartedAt.After(action.StartedAt) {
+			continue
+		}
+		latest[key] = action
+	}
+	var actions []ActionExecution
+	for _, action := range latest {
+		actions = append(actions, action)
+	}
+	sort.SliceStable(actions, func(i, j int) bool {
+		if actions[i].StartedAt.Equal(actions[j].StartedAt) {
+			return actions[i].ActionName < actions[j].ActionName
+		}
+		return actions[i].StartedAt.Before(actions[j].StartedAt)
+	})
+	return actions, nil
+}
+
+// ListExecutions returns the most recent executions of the pipeline, latest first.
+// The actions that failed are retrieved for failed executions.
+func (c *CodePipeline) ListExecutions(pipelineName string, maxResults int) ([]*Execution, error) {
+	out, err := c.client.ListPipelineExecutions(&cp.ListPipelineExecutionsInput{
+		PipelineName: aws.String(pipelineName),
+		MaxResults:   aws.Int64(int64(maxResults)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list executions of pipeline %s: %w", pipelineName, err)
+	}
+	var executions []*Execution
+	for _, summary := range out.PipelineExecutionSummaries {
+		execution := &Execution{
+			ID:        aws.StringValue(summary.PipelineExecutionId),
+			Status:    aws.StringValue(summary.Status),
+			StartedAt: aws.TimeValue(summary.StartTime),
+			UpdatedAt: aws.TimeValue(summary.LastUpdateTime),
+		}
+		if len(summary.SourceRevisions) > 0 {
+			execution.CommitID = aws.StringValue(summary.SourceRevisions[0].RevisionId)
+		}
+		if summary.Trigger != nil {
+			execution.Trigger = aws.StringValue(summary.Trigger.TriggerType)
+		}
+		if execution.Status == cp.PipelineExecutionStatusFailed {
+			actions, err := c.ActionExecutions(pipelineName, execution.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, action := range actions {
+				if action.Status == cp.ActionExecutionStatusFailed {
+					execution.FailedActions = append(execution.FailedActions, action)
+				}
+			}
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
+
 // GetPipelineByTags retrieves all of pipelines for an application.
 func (c *CodePipeline) GetPipelinesByTags(tags map[string]string) ([]*Pipeline, error) {
 	var pipelines []*Pipeline
@@ -243,12 +383,7 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 		}
 		var actions []StageAction
 		for _, actionState := range stage.ActionStates {
-			if actionState.LatestExecution != nil {
-				actions = append(actions, StageAction{
-					Name:   aws.StringValue(actionState.ActionName),
-					Status: aws.StringValue(actionState.LatestExecution.Status),
-				})
-			}
+			actions = append(actions, stageAction(actionState))
 		}
 		stageStates = append(stageStates, &StageState{
 			StageName:  stageName,
@@ -344,8 +479,39 @@ func (c *CodePipeline) getPipelineName(resourceArn string) (string, error) {
 	return parsedArn.Resource, nil
 }
 
+func stageAction(state *cp.ActionState) StageAction {
+	action := StageAction{
+		Name: aws.StringValue(state.ActionName),
+	}
+	if state.LatestExecution == nil {
+		return action
+	}
+	action.Status = aws.StringValue(state.LatestExecution.Status)
+	action.Summary = aws.StringValue(state.LatestExecution.Summary)
+	if details := state.LatestExecution.ErrorDetails; details != nil && aws.StringValue(details.Message) != "" {
+		action.Summary = aws.StringValue(details.Message)
+	}
+	return action
+}
+
+func actionExecution(detail *cp.ActionExecutionDetail) ActionExecution {
+	action := ActionExecution{
+		StageName:  aws.StringValue(detail.StageName),
+		ActionName: aws.StringValue(detail.ActionName),
+		Status:     aws.StringValue(detail.Status),
+		StartedAt:  aws.TimeValue(detail.StartTime),
+	}
+	if detail.Output != nil && detail.Output.ExecutionResult != nil {
+		action.Summary = aws.StringValue(detail.Output.ExecutionResult.ExternalExecutionSummary)
+	}
+	return action
+}
+
 func (sa StageAction) humanString() string {
-	return sa.Name + "\t\t" + fmtStatus(sa.Status)
+	if sa.Summary == "" {
+		return sa.Name + "\t\t" + fmtStatus(sa.Status)
+	}
+	return sa.Name + "\t\t" + fmtStatus(sa.Status) + "\t" + sa.Summary
 }
 
 func fmtStatus(status string) string {
@@ -361,8 +361,11 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusFailed)},
 					},
 					{
-						ActionName:      aws.String("action2"),
-						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusInProgress)},
+						ActionName: aws.String("action2"),
+						LatestExecution: &codepipeline.ActionExecution{
+							Status:  aws.String(codepipeline.ActionExecutionStatusInProgress),
+							Summary: aws.String("Building image"),
+						},
 					},
 					{
 						ActionName:      aws.String("action3"),
@@ -379,8 +382,15 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusSucceeded)},
 					},
 					{
-						ActionName:      aws.String("TestCommands"),
-						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusFailed)},
+						ActionName: aws.String("TestCommands"),
+						LatestExecution: &codepipeline.ActionExecution{
+							Status:       aws.String(codepipeline.ActionExecutionStatusFailed),
+							Summary:      aws.String("Build terminated with state: FAILED"),
+							ErrorDetails: &codepipeline.ErrorDetails{Message: aws.String("Error while executing command: make test")},
+						},
+					},
+					{
+						ActionName: aws.String("smoke"),
 					},
 				},
 				StageName: aws.String("DeployTo-test"),
@@ -434,8 +444,9 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 								Status: "Failed",
 							},
 							{
-								Name:   "action2",
-								Status: "InProgress",
+								Name:    "action2",
+								Status:  "InProgress",
+								Summary: "Building image",
 							},
 							{
 								Name:   "action3",
@@ -452,8 +463,12 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 								Status: "Succeeded",
 							},
 							{
-								Name:   "TestCommands",
-								Status: "Failed",
+								Name:    "TestCommands",
+								Status:  "Failed",
+								Summary: "Error while executing command: make test",
+							},
+							{
+								Name: "smoke",
 							},
 						},
 						Transition: "ENABLED",
@@ -624,7 +639,7 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 					}).Return(nil, mockErr)
 			},
 			expectedOut:   nil,
-			expectedError: fmt.Errorf("retry pipeline source stage: some error"),
+			expectedError: fmt.Errorf("retry stage Source of pipeline pipeline-dinder-badgoose-repo: some error"),
 		},
 	}
 
@@ -657,3 +672,273 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_StartPipelineExecution(t *testing.T) {
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"returns the ID of the execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String("pipeline-dinder-badgoose-repo"),
+				}).Return(&codepipeline.StartPipelineExecutionOutput{
+					PipelineExecutionId: aws.String("exec-1"),
+				}, nil)
+			},
+			wantedID: "exec-1",
+		},
+		"returns a wrapped error if the execution cannot be started": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("start execution of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+			cp := CodePipeline{client: mockClient}
+
+			// WHEN
+			id, err := cp.StartPipelineExecution("pipeline-dinder-badgoose-repo")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedID, id)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_ActionExecutions(t *testing.T) {
+	startTime := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted    []ActionExecution
+		wantedErr error
+	}{
+		"returns the latest run of each action in the order they started": {
+			callMocks: func(m codepipelineMocks) {
+				gomock.InOrder(
+					m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+						PipelineName: aws.String("pipeline-dinder-badgoose-repo"),
+						Filter: &codepipeline.ActionExecutionFilter{
+							PipelineExecutionId: aws.String("exec-1"),
+						},
+					}).Return(&codepipeline.ListActionExecutionsOutput{
+						ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+							{
+								StageName:  aws.String("DeployTo-test"),
+								ActionName: aws.String("CreateOrUpdate-api-test"),
+								Status:     aws.String("Succeeded"),
+								StartTime:  aws.Time(startTime.Add(5 * time.Minute)),
+							},
+							{
+								StageName:  aws.String("DeployTo-test"),
+								ActionName: aws.String("CreateOrUpdate-api-test"),
+								Status:     aws.String("Failed"),
+								StartTime:  aws.Time(startTime.Add(2 * time.Minute)),
+								Output: &codepipeline.ActionExecutionOutput{
+									ExecutionResult: &codepipeline.ActionExecutionResult{
+										ExternalExecutionSummary: aws.String("Stack update failed"),
+									},
+								},
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+						PipelineName: aws.String("pipeline-dinder-badgoose-repo"),
+						Filter: &codepipeline.ActionExecutionFilter{
+							PipelineExecutionId: aws.String("exec-1"),
+						},
+						NextToken: aws.String("next"),
+					}).Return(&codepipeline.ListActionExecutionsOutput{
+						ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+							{
+								StageName:  aws.String("Source"),
+								ActionName: aws.String("SourceCodeFor-dinder"),
+								Status:     aws.String("Succeeded"),
+								StartTime:  aws.Time(startTime),
+							},
+						},
+					}, nil),
+				)
+			},
+			wanted: []ActionExecution{
+				{
+					StageName:  "Source",
+					ActionName: "SourceCodeFor-dinder",
+					Status:     "Succeeded",
+					StartedAt:  startTime,
+				},
+				{
+					StageName:  "DeployTo-test",
+					ActionName: "CreateOrUpdate-api-test",
+					Status:     "Succeeded",
+					StartedAt:  startTime.Add(5 * time.Minute),
+				},
+			},
+		},
+		"returns a wrapped error if the action executions cannot be listed": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list action executions of execution exec-1 of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+			cp := CodePipeline{client: mockClient}
+
+			// WHEN
+			actions, err := cp.ActionExecutions("pipeline-dinder-badgoose-repo", "exec-1")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actions)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_ListExecutions(t *testing.T) {
+	startTime := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted    []*Execution
+		wantedErr error
+	}{
+		"returns the executions with the failed actions of failed executions": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+					PipelineName: aws.String("pipeline-dinder-badgoose-repo"),
+					MaxResults:   aws.Int64(2),
+				}).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("exec-2"),
+							Status:              aws.String("Failed"),
+							StartTime:           aws.Time(startTime.Add(time.Hour)),
+							LastUpdateTime:      aws.Time(startTime.Add(time.Hour + 10*time.Minute)),
+							SourceRevisions: []*codepipeline.SourceRevision{
+								{RevisionId: aws.String("a1b2c3d")},
+							},
+							Trigger: &codepipeline.ExecutionTrigger{
+								TriggerType: aws.String("StartPipelineExecution"),
+							},
+						},
+						{
+							PipelineExecutionId: aws.String("exec-1"),
+							Status:              aws.String("Succeeded"),
+							StartTime:           aws.Time(startTime),
+							LastUpdateTime:      aws.Time(startTime.Add(15 * time.Minute)),
+							Trigger: &codepipeline.ExecutionTrigger{
+								TriggerType: aws.String("Webhook"),
+							},
+						},
+					},
+				}, nil)
+				m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+					PipelineName: aws.String("pipeline-dinder-badgoose-repo"),
+					Filter: &codepipeline.ActionExecutionFilter{
+						PipelineExecutionId: aws.String("exec-2"),
+					},
+				}).Return(&codepipeline.ListActionExecutionsOutput{
+					ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+						{
+							StageName:  aws.String("DeployTo-test"),
+							ActionName: aws.String("TestCommands"),
+							Status:     aws.String("Failed"),
+							StartTime:  aws.Time(startTime.Add(time.Hour + 5*time.Minute)),
+						},
+						{
+							StageName:  aws.String("Source"),
+							ActionName: aws.String("SourceCodeFor-dinder"),
+							Status:     aws.String("Succeeded"),
+							StartTime:  aws.Time(startTime.Add(time.Hour)),
+						},
+					},
+				}, nil)
+			},
+			wanted: []*Execution{
+				{
+					ID:        "exec-2",
+					Status:    "Failed",
+					CommitID:  "a1b2c3d",
+					Trigger:   "StartPipelineExecution",
+					StartedAt: startTime.Add(time.Hour),
+					UpdatedAt: startTime.Add(time.Hour + 10*time.Minute),
+					FailedActions: []ActionExecution{
+						{
+							StageName:  "DeployTo-test",
+							ActionName: "TestCommands",
+							Status:     "Failed",
+							StartedAt:  startTime.Add(time.Hour + 5*time.Minute),
+						},
+					},
+				},
+				{
+					ID:        "exec-1",
+					Status:    "Succeeded",
+					Trigger:   "Webhook",
+					StartedAt: startTime,
+					UpdatedAt: startTime.Add(15 * time.Minute),
+				},
+			},
+		},
+		"returns a wrapped error if the executions cannot be listed": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list executions of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+			cp := CodePipeline{client: mockClient}
+
+			// WHEN
+			executions, err := cp.ListExecutions("pipeline-dinder-badgoose-repo", 2)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, executions)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecr"
+)
+
+const (
+	errCodeScanNotFound         = "ScanNotFoundException"
+	errCodeImageScanQuotaExceed = "LimitExceededException"
+)
+
+// FindingSeverities lists the severities of the findings of an image scan from the most to the least severe.
+var FindingSeverities = []string{
+	ecr.FindingSeverityCritical,
+	ecr.FindingSeverityHigh,
+	ecr.FindingSeverityMedium,
+	ecr.FindingSeverityLow,
+	ecr.FindingSeverityInformational,
+	ecr.FindingSeverityUndefined,
+}
+
+var scanWaiters = []request.WaiterOption{
+	request.WithWaiterDelay(request.ConstantWaiterDelay(5 * time.Second)), // How long to wait in between polls of the scan status.
+	request.WithWaiterMaxAttempts(120),                                    // Wait for at most 10 mins for the scan to complete.
+}
+
+// ErrScanNotFound occurs when an image has never been scanned.
+type ErrScanNotFound struct {
+	repoName string
+	digest   string
+}
+
+func (e *ErrScanNotFound) Error() string {
+	return fmt.Sprintf("no scan found for image %s in ecr repo %s", e.digest, e.repoName)
+}
+
+// ImageScanFinding is a vulnerability found by an image scan.
+type ImageScanFinding struct {
+	Name     string `json:"name"` // Name is the ID of the vulnerability, for example "CVE-2021-3711".
+	Severity string `json:"severity"`
+	URI      string `json:"uri,omitempty"`
+}
+
+// ImageScanFindings holds the results of the scan of an image.
+type ImageScanFindings struct {
+	Digest            string             `json:"digest"`
+	Status            string             `json:"status"`
+	StatusDescription string             `json:"statusDescription,omitempty"`
+	CompletedAt       time.Time          `json:"completedAt"`
+	SeverityCounts    map[string]int     `json:"severityCounts"`
+	Findings          []ImageScanFinding `json:"-"`
+}
+
+// AtOrAbove returns the findings whose severity is at or above the given severity,
+// except for the findings whose name is in the allowed list.
+func (f *ImageScanFindings) AtOrAbove(severity string, allowed []string) []ImageScanFinding {
+	rank := severityRank(severity)
+	var findings []ImageScanFinding
+	for _, finding := range f.Findings {
+		if severityRank(finding.Severity) > rank || containsFold(allowed, finding.Name) {
+			continue
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+// Summary returns the number of findings per severity, for example "1 critical, 3 high".
+func (f *ImageScanFindings) Summary() string {
+	var counts []string
+	for _, severity := range FindingSeverities {
+		if n := f.SeverityCounts[severity]; n > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", n, strings.ToLower(severity)))
+		}
+	}
+	if len(counts) == 0 {
+		return "no findings"
+	}
+	return strings.Join(counts, ", ")
+}
+
+// ScanImage starts a scan of the image with the given digest and waits for its findings.
+// If the image was already scanned in the last 24 hours, the findings of that scan are returned.
+func (c ECR) ScanImage(repoName, digest string) (*ImageScanFindings, error) {
+	imageID := &ecr.ImageIdentifier{
+		ImageDigest: aws.String(digest),
+	}
+	_, err := c.client.StartImageScan(&ecr.StartImageScanInput{
+		RepositoryName: aws.String(repoName),
+		ImageId:        imageID,
+	})
+	if err != nil && !isAWSErrCode(err, errCodeImageScanQuotaExceed) {
+		return nil, fmt.Errorf("ecr repo %s start scan of image %s: %w", repoName, digest, err)
+	}
+	err = c.client.WaitUntilImageScanCompleteWithContext(context.Background(), &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId:        imageID,
+	}, scanWaiters...)
+	if err != nil {
+		// The waiter also stops when the scan fails, in which case the status description holds the reason.
+		if findings, ferr := c.ImageScanFindings(repoName, digest); ferr == nil && findings.Status == ecr.ScanStatusFailed {
+			return nil, fmt.Errorf("scan of image %s in ecr repo %s failed: %s", digest, repoName, findings.StatusDescription)
+		}
+		return nil, fmt.Errorf("ecr repo %s wait for scan of image %s to complete: %w", repoName, digest, err)
+	}
+	return c.ImageScanFindings(repoName, digest)
+}
+
+// ImageScanFindings calls the ECR DescribeImageScanFindings API and returns the status and results of the latest scan
+// of the image with the given digest. It returns an ErrScanNotFound if the image has never been scanned.
+func (c ECR) ImageScanFindings(repoName, digest string) (*ImageScanFindings, error) {
+	in := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: aws.String(digest),
+		},
+	}
+	findings := &ImageScanFindings{
+		Digest:         digest,
+		SeverityCounts: make(map[string]int),
+	}
+	for {
+		resp, err := c.client.DescribeImageScanFindings(in)
+		if err != nil {
+			if isAWSErrCode(err, errCodeScanNotFound) {
+				return nil, &ErrScanNotFound{
+					repoName: repoName,
+					digest:   digest,
+				}
+			}
+			return nil, fmt.Errorf("ecr repo %s describe scan findings of image %s: %w", repoName, digest, err)
+		}
+		if resp.ImageScanStatus != nil {
+			findings.Status = aws.StringValue(resp.ImageScanStatus.Status)
+			findings.StatusDescription = aws.StringValue(resp.ImageScanStatus.Description)
+		}
+		if resp.ImageScanFindings != nil {
+			findings.CompletedAt = aws.TimeValue(resp.ImageScanFindings.ImageScanCompletedAt)
+			for severity, count := range resp.ImageScanFindings.FindingSeverityCounts {
+				findings.SeverityCounts[severity] = int(aws.Int64Value(count))
+			}
+			for _, finding := range resp.ImageScanFindings.Findings {
+				findings.Findings = append(findings.Findings, ImageScanFinding{
+					Name:     aws.StringValue(finding.Name),
+					Severity: aws.StringValue(finding.Severity),
+					URI:      aws.StringValue(finding.Uri),
+				})
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return findings, nil
+}
+
+// severityRank returns the position of the severity in FindingSeverities, the lower the more severe.
+func severityRank(severity string) int {
+	for i, s := range FindingSeverities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return len(FindingSeverities)
+}
+
+func containsFold(items []string, s string) bool {
+	for _, item := range items {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAWSErrCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
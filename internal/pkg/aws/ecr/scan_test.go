@@ -0,0 +1,360 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockScanRepoName = "phonetool/api"
+	mockScanDigest   = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+)
+
+func TestECR_ScanImage(t *testing.T) {
+	mockError := errors.New("some error")
+	mockCompletedAt := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	completedScan := &ecr.DescribeImageScanFindingsOutput{
+		ImageScanStatus: &ecr.ImageScanStatus{
+			Status: aws.String(ecr.ScanStatusComplete),
+		},
+		ImageScanFindings: &ecr.ImageScanFindings{
+			ImageScanCompletedAt: aws.Time(mockCompletedAt),
+			FindingSeverityCounts: map[string]*int64{
+				ecr.FindingSeverityHigh: aws.Int64(1),
+			},
+			Findings: []*ecr.ImageScanFinding{
+				{
+					Name:     aws.String("CVE-2021-3711"),
+					Severity: aws.String(ecr.FindingSeverityHigh),
+					Uri:      aws.String("https://security-tracker.debian.org/tracker/CVE-2021-3711"),
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      *ImageScanFindings
+		wantedError error
+	}{
+		"errors if failed to start the scan": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartImageScan(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: errors.New("ecr repo phonetool/api start scan of image " + mockScanDigest + ": some error"),
+		},
+		"errors if failed to wait for the scan": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartImageScan(gomock.Any()).Return(&ecr.StartImageScanOutput{}, nil)
+				m.EXPECT().WaitUntilImageScanCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockError)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status: aws.String(ecr.ScanStatusInProgress),
+					},
+				}, nil)
+			},
+			wantedError: errors.New("ecr repo phonetool/api wait for scan of image " + mockScanDigest + " to complete: some error"),
+		},
+		"errors with the reason if the scan failed": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartImageScan(gomock.Any()).Return(&ecr.StartImageScanOutput{}, nil)
+				m.EXPECT().WaitUntilImageScanCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockError)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status:      aws.String(ecr.ScanStatusFailed),
+						Description: aws.String("UnsupportedImageError: The operating system is not supported."),
+					},
+				}, nil)
+			},
+			wantedError: errors.New("scan of image " + mockScanDigest + " in ecr repo phonetool/api failed: UnsupportedImageError: The operating system is not supported."),
+		},
+		"returns the findings of the previous scan if the image was already scanned today": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartImageScan(gomock.Any()).Return(nil, awserr.New(errCodeImageScanQuotaExceed, "quota exceeded", nil))
+				m.EXPECT().WaitUntilImageScanCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(completedScan, nil)
+			},
+			wanted: &ImageScanFindings{
+				Digest:      mockScanDigest,
+				Status:      ecr.ScanStatusComplete,
+				CompletedAt: mockCompletedAt,
+				SeverityCounts: map[string]int{
+					ecr.FindingSeverityHigh: 1,
+				},
+				Findings: []ImageScanFinding{
+					{
+						Name:     "CVE-2021-3711",
+						Severity: ecr.FindingSeverityHigh,
+						URI:      "https://security-tracker.debian.org/tracker/CVE-2021-3711",
+					},
+				},
+			},
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartImageScan(&ecr.StartImageScanInput{
+					RepositoryName: aws.String(mockScanRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String(mockScanDigest),
+					},
+				}).Return(&ecr.StartImageScanOutput{}, nil)
+				m.EXPECT().WaitUntilImageScanCompleteWithContext(gomock.Any(), &ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockScanRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String(mockScanDigest),
+					},
+				}, gomock.Any()).Return(nil)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status: aws.String(ecr.ScanStatusComplete),
+					},
+					ImageScanFindings: &ecr.ImageScanFindings{
+						ImageScanCompletedAt: aws.Time(mockCompletedAt),
+					},
+				}, nil)
+			},
+			wanted: &ImageScanFindings{
+				Digest:         mockScanDigest,
+				Status:         ecr.ScanStatusComplete,
+				CompletedAt:    mockCompletedAt,
+				SeverityCounts: map[string]int{},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			got, err := client.ScanImage(mockScanRepoName, mockScanDigest)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestECR_ImageScanFindings(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      *ImageScanFindings
+		wantedError error
+	}{
+		"returns ErrScanNotFound if the image was never scanned": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, awserr.New(errCodeScanNotFound, "not found", nil))
+			},
+			wantedError: &ErrScanNotFound{
+				repoName: mockScanRepoName,
+				digest:   mockScanDigest,
+			},
+		},
+		"errors if failed to describe the findings": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("ecr repo phonetool/api describe scan findings of image " + mockScanDigest + ": some error"),
+		},
+		"returns the reason of a failed scan": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status:      aws.String(ecr.ScanStatusFailed),
+						Description: aws.String("UnsupportedImageError: The operating system is not supported."),
+					},
+				}, nil)
+			},
+			wanted: &ImageScanFindings{
+				Digest:            mockScanDigest,
+				Status:            ecr.ScanStatusFailed,
+				StatusDescription: "UnsupportedImageError: The operating system is not supported.",
+				SeverityCounts:    map[string]int{},
+			},
+		},
+		"collects the findings of every page": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockScanRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String(mockScanDigest),
+					},
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status: aws.String(ecr.ScanStatusComplete),
+					},
+					ImageScanFindings: &ecr.ImageScanFindings{
+						FindingSeverityCounts: map[string]*int64{
+							ecr.FindingSeverityCritical: aws.Int64(1),
+							ecr.FindingSeverityLow:      aws.Int64(1),
+						},
+						Findings: []*ecr.ImageScanFinding{
+							{
+								Name:     aws.String("CVE-2021-3711"),
+								Severity: aws.String(ecr.FindingSeverityCritical),
+							},
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockScanRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String(mockScanDigest),
+					},
+					NextToken: aws.String("token"),
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status: aws.String(ecr.ScanStatusComplete),
+					},
+					ImageScanFindings: &ecr.ImageScanFindings{
+						FindingSeverityCounts: map[string]*int64{
+							ecr.FindingSeverityCritical: aws.Int64(1),
+							ecr.FindingSeverityLow:      aws.Int64(1),
+						},
+						Findings: []*ecr.ImageScanFinding{
+							{
+								Name:     aws.String("CVE-2020-1971"),
+								Severity: aws.String(ecr.FindingSeverityLow),
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &ImageScanFindings{
+				Digest: mockScanDigest,
+				Status: ecr.ScanStatusComplete,
+				SeverityCounts: map[string]int{
+					ecr.FindingSeverityCritical: 1,
+					ecr.FindingSeverityLow:      1,
+				},
+				Findings: []ImageScanFinding{
+					{
+						Name:     "CVE-2021-3711",
+						Severity: ecr.FindingSeverityCritical,
+					},
+					{
+						Name:     "CVE-2020-1971",
+						Severity: ecr.FindingSeverityLow,
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			// WHEN
+			got, err := client.ImageScanFindings(mockScanRepoName, mockScanDigest)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestImageScanFindings_AtOrAbove(t *testing.T) {
+	findings := &ImageScanFindings{
+		Findings: []ImageScanFinding{
+			{Name: "CVE-2021-3711", Severity: ecr.FindingSeverityCritical},
+			{Name: "CVE-2021-3712", Severity: ecr.FindingSeverityHigh},
+			{Name: "CVE-2020-1971", Severity: ecr.FindingSeverityMedium},
+			{Name: "CVE-2019-1551", Severity: ecr.FindingSeverityLow},
+		},
+	}
+	testCases := map[string]struct {
+		severity string
+		allowed  []string
+
+		wanted []ImageScanFinding
+	}{
+		"returns the findings at or above the severity": {
+			severity: "high",
+			wanted: []ImageScanFinding{
+				{Name: "CVE-2021-3711", Severity: ecr.FindingSeverityCritical},
+				{Name: "CVE-2021-3712", Severity: ecr.FindingSeverityHigh},
+			},
+		},
+		"skips the allowed findings": {
+			severity: ecr.FindingSeverityMedium,
+			allowed:  []string{"cve-2021-3711", "CVE-2020-1971"},
+			wanted: []ImageScanFinding{
+				{Name: "CVE-2021-3712", Severity: ecr.FindingSeverityHigh},
+			},
+		},
+		"returns nothing if every finding is allowed": {
+			severity: ecr.FindingSeverityCritical,
+			allowed:  []string{"CVE-2021-3711"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, findings.AtOrAbove(tc.severity, tc.allowed))
+		})
+	}
+}
+
+func TestImageScanFindings_Summary(t *testing.T) {
+	testCases := map[string]struct {
+		counts map[string]int
+		wanted string
+	}{
+		"no findings": {
+			wanted: "no findings",
+		},
+		"orders the counts by severity": {
+			counts: map[string]int{
+				ecr.FindingSeverityLow:      4,
+				ecr.FindingSeverityCritical: 1,
+				ecr.FindingSeverityHigh:     2,
+			},
+			wanted: "1 critical, 2 high, 4 low",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := &ImageScanFindings{SeverityCounts: tc.counts}
+			require.Equal(t, tc.wanted, f.Summary())
+		})
+	}
+}
@@ -7,6 +7,8 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -30,6 +32,7 @@ type api interface {
 	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+	ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
 	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
@@ -93,6 +96,47 @@ func (e *ECS) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// TaskDefinitionRevisions calls ECS API and returns the ARNs of the active and inactive task definitions
+// in the family, sorted from the latest revision to the oldest.
+func (e *ECS) TaskDefinitionRevisions(family string) ([]string, error) {
+	var arns []string
+	for _, status := range []string{ecs.TaskDefinitionStatusActive, ecs.TaskDefinitionStatusInactive} {
+		in := &ecs.ListTaskDefinitionsInput{
+			FamilyPrefix: aws.String(family),
+			Status:       aws.String(status),
+			Sort:         aws.String(ecs.SortOrderDesc),
+		}
+		for {
+			resp, err := e.client.ListTaskDefinitions(in)
+			if err != nil {
+				return nil, fmt.Errorf("list %s task definitions in family %s: %w", strings.ToLower(status), family, err)
+			}
+			for _, taskDefARN := range aws.StringValueSlice(resp.TaskDefinitionArns) {
+				// The family prefix also matches families that share the same prefix, such as "api" and "api-v2".
+				if taskDefinitionFamily(taskDefARN) == family {
+					arns = append(arns, taskDefARN)
+				}
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			in.NextToken = resp.NextToken
+		}
+	}
+	revisions := make(map[string]int, len(arns))
+	for _, taskDefARN := range arns {
+		revision, err := TaskDefinitionVersion(taskDefARN)
+		if err != nil {
+			return nil, err
+		}
+		revisions[taskDefARN] = revision
+	}
+	sort.SliceStable(arns, func(i, j int) bool {
+		return revisions[arns[i]] > revisions[arns[j]]
+	})
+	return arns, nil
+}
+
 // Service calls ECS API and returns the specified service running in the cluster.
 func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	resp, err := e.client.DescribeServices(&ecs.DescribeServicesInput{
@@ -104,6 +104,104 @@ func TestECS_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestECS_TaskDefinitionRevisions(t *testing.T) {
+	mockError := errors.New("some error")
+
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr  error
+		wantARNs []string
+	}{
+		"should return wrapped error if fail to list task definitions": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-api"),
+					Status:       aws.String(ecs.TaskDefinitionStatusActive),
+					Sort:         aws.String(ecs.SortOrderDesc),
+				}).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("list active task definitions in family phonetool-test-api: some error"),
+		},
+		"should return wrapped error if a task definition ARN is malformed": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(gomock.Any()).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api"}),
+				}, nil)
+				m.EXPECT().ListTaskDefinitions(gomock.Any()).Return(&ecs.ListTaskDefinitionsOutput{}, nil)
+			},
+			wantErr: fmt.Errorf(`convert version task-definition/phonetool-test-api from string to int: strconv.Atoi: parsing "task-definition/phonetool-test-api": invalid syntax`),
+		},
+		"should return active and inactive revisions of the family from the latest to the oldest": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+						FamilyPrefix: aws.String("phonetool-test-api"),
+						Status:       aws.String(ecs.TaskDefinitionStatusActive),
+						Sort:         aws.String(ecs.SortOrderDesc),
+					}).Return(&ecs.ListTaskDefinitionsOutput{
+						TaskDefinitionArns: aws.StringSlice([]string{
+							"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:10",
+							"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api-v2:3",
+						}),
+					}, nil),
+					m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+						FamilyPrefix: aws.String("phonetool-test-api"),
+						Status:       aws.String(ecs.TaskDefinitionStatusInactive),
+						Sort:         aws.String(ecs.SortOrderDesc),
+					}).Return(&ecs.ListTaskDefinitionsOutput{
+						TaskDefinitionArns: aws.StringSlice([]string{
+							"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:9",
+						}),
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+						FamilyPrefix: aws.String("phonetool-test-api"),
+						Status:       aws.String(ecs.TaskDefinitionStatusInactive),
+						Sort:         aws.String(ecs.SortOrderDesc),
+						NextToken:    aws.String("token"),
+					}).Return(&ecs.ListTaskDefinitionsOutput{
+						TaskDefinitionArns: aws.StringSlice([]string{
+							"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+						}),
+					}, nil),
+				)
+			},
+			wantARNs: []string{
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:10",
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:9",
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			got, err := service.TaskDefinitionRevisions("phonetool-test-api")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantARNs, got)
+			}
+		})
+	}
+}
+
 func TestECS_Service(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -223,6 +223,16 @@ func (t *TaskDefinition) Image(containerName string) (string, error) {
 	return "", fmt.Errorf("container %s not found", containerName)
 }
 
+// DockerLabels returns the container's docker labels of the task definition.
+func (t *TaskDefinition) DockerLabels(containerName string) (map[string]string, error) {
+	for _, container := range t.ContainerDefinitions {
+		if aws.StringValue(container.Name) == containerName {
+			return aws.StringValueMap(container.DockerLabels), nil
+		}
+	}
+	return nil, fmt.Errorf("container %s not found", containerName)
+}
+
 // Command returns the container's command overrides of the task definition.
 func (t *TaskDefinition) Command(containerName string) ([]string, error) {
 	for _, container := range t.ContainerDefinitions {
@@ -274,6 +284,20 @@ func TaskDefinitionVersion(taskDefARN string) (int, error) {
 	return version, nil
 }
 
+// taskDefinitionFamily returns the family of a task definition ARN.
+// For example, given "arn:aws:ecs:us-east-1:568623488001:task-definition/some-task-def:6", it returns "some-task-def".
+func taskDefinitionFamily(taskDefARN string) string {
+	parsedARN, err := arn.Parse(taskDefARN)
+	if err != nil {
+		return ""
+	}
+	familyAndRevision := strings.TrimPrefix(parsedARN.Resource, "task-definition/")
+	if i := strings.LastIndex(familyAndRevision, ":"); i != -1 {
+		return familyAndRevision[:i]
+	}
+	return familyAndRevision
+}
+
 func shortTaskID(id string) string {
 	if len(id) >= shortTaskIDLength {
 		return id[:shortTaskIDLength]
@@ -507,6 +507,59 @@ func TestTaskDefinition_Image(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_DockerLabels(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers    []*ecs.ContainerDefinition
+		inContainerName string
+
+		wantedLabels map[string]string
+		wantedError  error
+	}{
+		"should return the container's docker labels": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+				},
+				{
+					Name: aws.String("container-2"),
+					DockerLabels: aws.StringMap(map[string]string{
+						"copilot-deployed-by": "arn:aws:iam::123456789012:user/alice",
+					}),
+				},
+			},
+			inContainerName: "container-2",
+			wantedLabels: map[string]string{
+				"copilot-deployed-by": "arn:aws:iam::123456789012:user/alice",
+			},
+		},
+		"container not found": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("container-1"),
+				},
+			},
+			inContainerName: "container-3",
+			wantedError:     errors.New("container container-3 not found"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				ContainerDefinitions: tc.inContainers,
+			}
+
+			gotLabels, err := taskDefinition.DockerLabels(tc.inContainerName)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.Equal(t, tc.wantedLabels, gotLabels)
+			}
+		})
+	}
+}
+
 func TestTaskDefinition_Command(t *testing.T) {
 	testCases := map[string]struct {
 		inContainers    []*ecs.ContainerDefinition
@@ -15,10 +15,19 @@ import (
 const (
 	// TargetHealthStateHealthy wraps the ELBV2 health status HEALTHY.
 	TargetHealthStateHealthy = elbv2.TargetHealthStateEnumHealthy
+
+	// LoadBalancerTypeApplication wraps the ELBV2 type of Application Load Balancers.
+	LoadBalancerTypeApplication = elbv2.LoadBalancerTypeEnumApplication
+	// LoadBalancerSchemeInternetFacing wraps the ELBV2 scheme of load balancers that are reachable from the internet.
+	LoadBalancerSchemeInternetFacing = elbv2.LoadBalancerSchemeEnumInternetFacing
 )
 
 type api interface {
 	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
+	DescribeListeners(input *elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error)
+	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DescribeLoadBalancers(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -53,6 +62,153 @@ func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
 	return ret, nil
 }
 
+// TargetGroupWeight is the weight of a target group in a listener rule that forwards requests to multiple target groups.
+type TargetGroupWeight struct {
+	TargetGroupARN string
+	Weight         int
+}
+
+// WeightedListenerRule returns the ARN of the listener rule that splits requests between the target group and other target groups.
+// If no listener rule forwards requests to the target group along with other target groups, returns an empty string.
+func (e *ELBV2) WeightedListenerRule(targetGroupARN string) (string, error) {
+	out, err := e.client.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: aws.StringSlice([]string{targetGroupARN}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe target group %s: %w", targetGroupARN, err)
+	}
+	for _, tg := range out.TargetGroups {
+		for _, lbARN := range aws.StringValueSlice(tg.LoadBalancerArns) {
+			listeners, err := e.listeners(lbARN)
+			if err != nil {
+				return "", err
+			}
+			for _, listener := range listeners {
+				listenerARN := aws.StringValue(listener.ListenerArn)
+				rules, err := e.rules(&elbv2.DescribeRulesInput{ListenerArn: aws.String(listenerARN)})
+				if err != nil {
+					return "", fmt.Errorf("describe rules of listener %s: %w", listenerARN, err)
+				}
+				for _, rule := range rules {
+					weights := targetGroupWeights(rule)
+					if len(weights) < 2 {
+						continue
+					}
+					for _, w := range weights {
+						if w.TargetGroupARN == targetGroupARN {
+							return aws.StringValue(rule.RuleArn), nil
+						}
+					}
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// TargetGroupWeights returns the weights of the target groups that the listener rule forwards requests to.
+func (e *ELBV2) TargetGroupWeights(ruleARN string) ([]TargetGroupWeight, error) {
+	rules, err := e.rules(&elbv2.DescribeRulesInput{RuleArns: aws.StringSlice([]string{ruleARN})})
+	if err != nil {
+		return nil, fmt.Errorf("describe listener rule %s: %w", ruleARN, err)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("listener rule %s not found", ruleARN)
+	}
+	return targetGroupWeights(rules[0]), nil
+}
+
+// LoadBalancer holds the attributes of a load balancer.
+type LoadBalancer struct {
+	ARN            string
+	Type           string
+	Scheme         string
+	DNSName        string
+	HostedZoneID   string // ID of the Route 53 hosted zone of the load balancer.
+	VPCID          string
+	SecurityGroups []string
+	ListenerPorts  []int64
+}
+
+// LoadBalancer returns the attributes of the load balancer and the ports of its listeners.
+func (e *ELBV2) LoadBalancer(lbARN string) (*LoadBalancer, error) {
+	out, err := e.client.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: aws.StringSlice([]string{lbARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe load balancer %s: %w", lbARN, err)
+	}
+	if len(out.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("load balancer %s not found", lbARN)
+	}
+	lb := out.LoadBalancers[0]
+	listeners, err := e.listeners(lbARN)
+	if err != nil {
+		return nil, err
+	}
+	var ports []int64
+	for _, l := range listeners {
+		ports = append(ports, aws.Int64Value(l.Port))
+	}
+	return &LoadBalancer{
+		ARN:            aws.StringValue(lb.LoadBalancerArn),
+		Type:           aws.StringValue(lb.Type),
+		Scheme:         aws.StringValue(lb.Scheme),
+		DNSName:        aws.StringValue(lb.DNSName),
+		HostedZoneID:   aws.StringValue(lb.CanonicalHostedZoneId),
+		VPCID:          aws.StringValue(lb.VpcId),
+		SecurityGroups: aws.StringValueSlice(lb.SecurityGroups),
+		ListenerPorts:  ports,
+	}, nil
+}
+
+func (e *ELBV2) listeners(lbARN string) ([]*elbv2.Listener, error) {
+	var listeners []*elbv2.Listener
+	in := &elbv2.DescribeListenersInput{LoadBalancerArn: aws.String(lbARN)}
+	for {
+		out, err := e.client.DescribeListeners(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe listeners of load balancer %s: %w", lbARN, err)
+		}
+		listeners = append(listeners, out.Listeners...)
+		if out.NextMarker == nil {
+			return listeners, nil
+		}
+		in.Marker = out.NextMarker
+	}
+}
+
+func (e *ELBV2) rules(in *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
+	var rules []*elbv2.Rule
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, out.Rules...)
+		if out.NextMarker == nil {
+			return rules, nil
+		}
+		in.Marker = out.NextMarker
+	}
+}
+
+func targetGroupWeights(rule *elbv2.Rule) []TargetGroupWeight {
+	var weights []TargetGroupWeight
+	for _, action := range rule.Actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward || action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			weights = append(weights, TargetGroupWeight{
+				TargetGroupARN: aws.StringValue(tg.TargetGroupArn),
+				Weight:         int(aws.Int64Value(tg.Weight)),
+			})
+		}
+	}
+	return weights
+}
+
 // TargetID returns the target's ID, which is either an instance or an IP address.
 func (t *TargetHealth) TargetID() string {
 	return t.targetID()
@@ -189,3 +189,284 @@ func TestTargetHealth_HealthStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestELBV2_WeightedListenerRule(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedARN   string
+		wantedError error
+	}{
+		"error if fail to describe target group": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe target group group-1: some error"),
+		},
+		"error if fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{{LoadBalancerArns: aws.StringSlice([]string{"lb-1"})}},
+				}, nil)
+				m.EXPECT().DescribeListeners(gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{{ListenerArn: aws.String("listener-1")}},
+				}, nil)
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe rules of listener listener-1: some error"),
+		},
+		"returns empty string if the target group is not behind a weighted rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{{LoadBalancerArns: aws.StringSlice([]string{"lb-1"})}},
+				}, nil)
+				m.EXPECT().DescribeListeners(gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{{ListenerArn: aws.String("listener-1")}},
+				}, nil)
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn: aws.String("rule-1"),
+							Actions: []*elbv2.Action{{
+								Type: aws.String(elbv2.ActionTypeEnumForward),
+								ForwardConfig: &elbv2.ForwardActionConfig{
+									TargetGroups: []*elbv2.TargetGroupTuple{{TargetGroupArn: aws.String("group-1"), Weight: aws.Int64(1)}},
+								},
+							}},
+						},
+					},
+				}, nil)
+			},
+		},
+		"success across pages": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+					TargetGroupArns: aws.StringSlice([]string{"group-1"}),
+				}).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{{LoadBalancerArns: aws.StringSlice([]string{"lb-1"})}},
+				}, nil)
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String("lb-1"),
+				}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{{ListenerArn: aws.String("listener-1")}},
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules:      []*elbv2.Rule{{RuleArn: aws.String("rule-1")}},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn: aws.String("rule-2"),
+							Actions: []*elbv2.Action{{
+								Type: aws.String(elbv2.ActionTypeEnumForward),
+								ForwardConfig: &elbv2.ForwardActionConfig{
+									TargetGroups: []*elbv2.TargetGroupTuple{
+										{TargetGroupArn: aws.String("group-1"), Weight: aws.Int64(100)},
+										{TargetGroupArn: aws.String("group-2"), Weight: aws.Int64(0)},
+									},
+								},
+							}},
+						},
+					},
+				}, nil)
+			},
+			wantedARN: "rule-2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.WeightedListenerRule("group-1")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_TargetGroupWeights(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedWeights []TargetGroupWeight
+		wantedError   error
+	}{
+		"error if fail to describe the rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe listener rule rule-1: some error"),
+		},
+		"error if the rule does not exist": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{}, nil)
+			},
+			wantedError: errors.New("listener rule rule-1 not found"),
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{"rule-1"}),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn: aws.String("rule-1"),
+							Actions: []*elbv2.Action{{
+								Type: aws.String(elbv2.ActionTypeEnumForward),
+								ForwardConfig: &elbv2.ForwardActionConfig{
+									TargetGroups: []*elbv2.TargetGroupTuple{
+										{TargetGroupArn: aws.String("group-1"), Weight: aws.Int64(90)},
+										{TargetGroupArn: aws.String("group-2"), Weight: aws.Int64(10)},
+									},
+								},
+							}},
+						},
+					},
+				}, nil)
+			},
+			wantedWeights: []TargetGroupWeight{
+				{TargetGroupARN: "group-1", Weight: 90},
+				{TargetGroupARN: "group-2", Weight: 10},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.TargetGroupWeights("rule-1")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedWeights, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_LoadBalancer(t *testing.T) {
+	const mockARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedLB    *LoadBalancer
+		wantedError error
+	}{
+		"error if fail to describe the load balancer": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe load balancer " + mockARN + ": some error"),
+		},
+		"error if the load balancer does not exist": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+			},
+			wantedError: errors.New("load balancer " + mockARN + " not found"),
+		},
+		"error if fail to describe the listeners": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{{LoadBalancerArn: aws.String(mockARN)}},
+				}, nil)
+				m.EXPECT().DescribeListeners(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe listeners of load balancer " + mockARN + ": some error"),
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+					LoadBalancerArns: aws.StringSlice([]string{mockARN}),
+				}).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{{
+						LoadBalancerArn:       aws.String(mockARN),
+						Type:                  aws.String(elbv2.LoadBalancerTypeEnumApplication),
+						Scheme:                aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing),
+						DNSName:               aws.String("my-lb-1234.us-west-2.elb.amazonaws.com"),
+						CanonicalHostedZoneId: aws.String("Z1H1FL5HABSF5"),
+						VpcId:                 aws.String("vpc-1"),
+						SecurityGroups:        aws.StringSlice([]string{"sg-1"}),
+					}},
+				}, nil)
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(mockARN),
+				}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{{Port: aws.Int64(8080)}},
+				}, nil)
+			},
+			wantedLB: &LoadBalancer{
+				ARN:            mockARN,
+				Type:           elbv2.LoadBalancerTypeEnumApplication,
+				Scheme:         elbv2.LoadBalancerSchemeEnumInternetFacing,
+				DNSName:        "my-lb-1234.us-west-2.elb.amazonaws.com",
+				HostedZoneID:   "Z1H1FL5HABSF5",
+				VPCID:          "vpc-1",
+				SecurityGroups: []string{"sg-1"},
+				ListenerPorts:  []int64{8080},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.LoadBalancer(mockARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedLB, got)
+			}
+		})
+	}
+}
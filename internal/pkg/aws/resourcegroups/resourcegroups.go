@@ -15,6 +15,7 @@ import (
 
 const (
 	ResourceTypeStateMachine = "states:stateMachine"
+	ResourceTypeSQSQueue     = "sqs"
 )
 
 type api interface {
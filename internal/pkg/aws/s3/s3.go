@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path"
 	"strconv"
 	"strings"
@@ -35,6 +36,7 @@ type s3API interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
 }
 
 // NamedBinary is a named binary to be uploaded.
@@ -107,6 +109,35 @@ func (s *S3) Upload(bucket, key string, file NamedBinary) (string, error) {
 	return s.upload(bucket, key, buf)
 }
 
+// PutObject uploads data to an S3 bucket under the specified key and returns its url.
+func (s *S3) PutObject(bucket, key string, data io.Reader) (string, error) {
+	return s.upload(bucket, key, data)
+}
+
+// GetObject returns the content of the object under the specified key in an S3 bucket.
+// If the object does not exist, it returns an ErrObjectNotFound.
+func (s *S3) GetObject(bucket, key string) ([]byte, error) {
+	resp, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &ErrObjectNotFound{
+				bucket: bucket,
+				key:    key,
+			}
+		}
+		return nil, fmt.Errorf("get object %s from bucket %s: %w", key, bucket, err)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
@@ -206,3 +237,13 @@ func (s *S3) upload(bucket, key string, buf io.Reader) (string, error) {
 	}
 	return resp.Location, nil
 }
+
+// ErrObjectNotFound occurs when an object does not exist in a bucket.
+type ErrObjectNotFound struct {
+	bucket string
+	key    string
+}
+
+func (e *ErrObjectNotFound) Error() string {
+	return fmt.Sprintf("object %s not found in bucket %s", e.key, e.bucket)
+}
@@ -221,6 +221,120 @@ func TestS3_Upload(t *testing.T) {
 	}
 }
 
+func TestS3_PutObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
+
+		wantedURL string
+		wantError error
+	}{
+		"return error if upload fails": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: fmt.Errorf("upload mockKey to bucket mockBucket: some error"),
+		},
+		"should upload to the s3 bucket under the key": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Do(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) {
+					b, err := ioutil.ReadAll(in.Body)
+					require.NoError(t, err)
+					require.Equal(t, "bar", string(b))
+					require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+					require.Equal(t, "mockKey", aws.StringValue(in.Key))
+				}).Return(&s3manager.UploadOutput{
+					Location: "mockURL",
+				}, nil)
+			},
+			wantedURL: "mockURL",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerAPI(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			gotURL, gotErr := service.PutObject("mockBucket", "mockKey", bytes.NewBufferString("bar"))
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedURL, gotURL)
+			}
+		})
+	}
+}
+
+func TestS3_GetObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedContent string
+		wantError     error
+	}{
+		"return ErrObjectNotFound if the key does not exist": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "message", nil))
+			},
+			wantError: &ErrObjectNotFound{
+				bucket: "mockBucket",
+				key:    "mockKey",
+			},
+		},
+		"return error if fail to get the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: fmt.Errorf("get object mockKey from bucket mockBucket: some error"),
+		},
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(&s3.GetObjectOutput{
+					Body: ioutil.NopCloser(bytes.NewBufferString("bar")),
+				}, nil)
+			},
+			wantedContent: "bar",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, gotErr := service.GetObject("mockBucket", "mockKey")
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedContent, string(got))
+			}
+		})
+	}
+}
+
 type namedBinary struct{}
 
 func (n namedBinary) Name() string { return "foo" }
@@ -105,3 +105,55 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_GetSecretValue(t *testing.T) {
+	mockSecretID := "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-abc123"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedValue   string
+		expectedError error
+	}{
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretID),
+				}).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get secret %s from secrets manager: %w", mockSecretID, mockError),
+		},
+		"should return the secret string if successful": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretID),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("H0NKH0NKH0NK"),
+				}, nil)
+			},
+			wantedValue: "H0NKH0NKH0NK",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.GetSecretValue(mockSecretID)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.wantedValue, got)
+		})
+	}
+}
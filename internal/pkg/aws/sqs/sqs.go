@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqs provides a client to make API requests to Amazon Simple Queue Service.
+package sqs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+type api interface {
+	GetQueueUrl(input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error)
+	GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+}
+
+// QueueDepth holds the approximate number of messages in a queue.
+type QueueDepth struct {
+	Name     string `json:"name"`
+	Visible  int    `json:"visible"`  // Visible is the number of messages available for retrieval.
+	InFlight int    `json:"inFlight"` // InFlight is the number of messages received by a consumer but not deleted yet.
+	Delayed  int    `json:"delayed"`  // Delayed is the number of messages not available for retrieval yet.
+}
+
+// SQS wraps an AWS SQS client.
+type SQS struct {
+	client api
+}
+
+// New returns a SQS struct configured against the input session.
+func New(s *session.Session) *SQS {
+	return &SQS{
+		client: sqs.New(s),
+	}
+}
+
+// QueueDepth returns the approximate number of messages in the queue with the given ARN.
+func (s *SQS) QueueDepth(queueARN string) (*QueueDepth, error) {
+	parsed, err := arn.Parse(queueARN)
+	if err != nil {
+		return nil, fmt.Errorf("parse queue ARN %s: %w", queueARN, err)
+	}
+	urlOut, err := s.client.GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName:              aws.String(parsed.Resource),
+		QueueOwnerAWSAccountId: aws.String(parsed.AccountID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get url of queue %s: %w", parsed.Resource, err)
+	}
+	attrsOut, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl: urlOut.QueueUrl,
+		AttributeNames: aws.StringSlice([]string{
+			sqs.QueueAttributeNameApproximateNumberOfMessages,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get attributes of queue %s: %w", parsed.Resource, err)
+	}
+	depth := &QueueDepth{
+		Name: parsed.Resource,
+	}
+	for attr, field := range map[string]*int{
+		sqs.QueueAttributeNameApproximateNumberOfMessages:           &depth.Visible,
+		sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: &depth.InFlight,
+		sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:    &depth.Delayed,
+	} {
+		val, ok := attrsOut.Attributes[attr]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(aws.StringValue(val))
+		if err != nil {
+			return nil, fmt.Errorf("parse attribute %s of queue %s: %w", attr, parsed.Resource, err)
+		}
+		*field = n
+	}
+	return depth, nil
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sqs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQS_QueueDepth(t *testing.T) {
+	const (
+		mockQueueARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue"
+		mockQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue"
+	)
+	mockGetQueueUrl := func(m *mocks.Mockapi) {
+		m.EXPECT().GetQueueUrl(&sqs.GetQueueUrlInput{
+			QueueName:              aws.String("phonetool-test-worker-EventsQueue"),
+			QueueOwnerAWSAccountId: aws.String("123456789012"),
+		}).Return(&sqs.GetQueueUrlOutput{
+			QueueUrl: aws.String(mockQueueURL),
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inQueueARN string
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedDepth *QueueDepth
+		wantedError error
+	}{
+		"errors if the queue ARN is invalid": {
+			inQueueARN:  "badARN",
+			setupMocks:  func(m *mocks.Mockapi) {},
+			wantedError: errors.New("parse queue ARN badARN: arn: invalid prefix"),
+		},
+		"errors if failed to get the queue url": {
+			inQueueARN: mockQueueARN,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueUrl(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get url of queue phonetool-test-worker-EventsQueue: some error"),
+		},
+		"errors if failed to get the queue attributes": {
+			inQueueARN: mockQueueARN,
+			setupMocks: func(m *mocks.Mockapi) {
+				mockGetQueueUrl(m)
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get attributes of queue phonetool-test-worker-EventsQueue: some error"),
+		},
+		"errors if an attribute is not a number": {
+			inQueueARN: mockQueueARN,
+			setupMocks: func(m *mocks.Mockapi) {
+				mockGetQueueUrl(m)
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						sqs.QueueAttributeNameApproximateNumberOfMessages: aws.String("many"),
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`parse attribute ApproximateNumberOfMessages of queue phonetool-test-worker-EventsQueue: strconv.Atoi: parsing "many": invalid syntax`),
+		},
+		"success": {
+			inQueueARN: mockQueueARN,
+			setupMocks: func(m *mocks.Mockapi) {
+				mockGetQueueUrl(m)
+				m.EXPECT().GetQueueAttributes(&sqs.GetQueueAttributesInput{
+					QueueUrl: aws.String(mockQueueURL),
+					AttributeNames: aws.StringSlice([]string{
+						sqs.QueueAttributeNameApproximateNumberOfMessages,
+						sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+						sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+					}),
+				}).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						sqs.QueueAttributeNameApproximateNumberOfMessages:           aws.String("42"),
+						sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: aws.String("3"),
+						sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:    aws.String("0"),
+					},
+				}, nil)
+			},
+			wantedDepth: &QueueDepth{
+				Name:     "phonetool-test-worker-EventsQueue",
+				Visible:  42,
+				InFlight: 3,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := SQS{
+				client: mockClient,
+			}
+
+			// WHEN
+			depth, err := client.QueueDepth(tc.inQueueARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDepth, depth)
+			}
+		})
+	}
+}
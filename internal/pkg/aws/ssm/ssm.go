@@ -19,6 +19,7 @@ import (
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
 }
 
 // SSM wraps an AWS SSM client.
@@ -115,6 +116,18 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// GetSecretValue retrieves the decrypted value of the parameter with the given name or ARN.
+func (s *SSM) GetSecretValue(name string) (string, error) {
+	resp, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(resp.Parameter.Value), nil
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
@@ -361,3 +361,60 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_GetSecretValue(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		mockClient func(*mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"should wrap the error if the parameter cannot be retrieved": {
+			inName: "/copilot/myapp/myenv/secrets/db-password",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/copilot/myapp/myenv/secrets/db-password"),
+					WithDecryption: aws.Bool(true),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameter /copilot/myapp/myenv/secrets/db-password: some error"),
+		},
+		"should return the decrypted value": {
+			inName: "/copilot/myapp/myenv/secrets/db-password",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/copilot/myapp/myenv/secrets/db-password"),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("super secure password"),
+					},
+				}, nil)
+			},
+			wantedValue: "super secure password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.GetSecretValue(tc.inName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, got)
+			}
+		})
+	}
+}
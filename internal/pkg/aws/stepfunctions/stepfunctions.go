@@ -5,15 +5,53 @@
 package stepfunctions
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sfn"
 )
 
+// Execution statuses of a state machine.
+const (
+	ExecutionStatusRunning   = sfn.ExecutionStatusRunning
+	ExecutionStatusSucceeded = sfn.ExecutionStatusSucceeded
+)
+
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
+	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	DescribeExecution(input *sfn.DescribeExecutionInput) (*sfn.DescribeExecutionOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+}
+
+// Execution holds the status of a state machine execution.
+type Execution struct {
+	ARN       string
+	Status    string
+	StartDate time.Time
+	StopDate  time.Time // StopDate is zero if the execution is still running.
+}
+
+// IsRunning returns true if the execution has not finished yet.
+func (e *Execution) IsRunning() bool {
+	return e.Status == ExecutionStatusRunning
+}
+
+// ExecutionTask holds the identifiers of an ECS task started by a state machine execution.
+type ExecutionTask struct {
+	TaskARN    string
+	ClusterARN string
+}
+
+// ExecutionHistory summarizes the events of a state machine execution.
+type ExecutionHistory struct {
+	Tasks []*ExecutionTask // Tasks holds every ECS task submitted by the execution, including retries.
+	Error string           // Error is the error code of the failure, empty if the execution did not fail.
+	Cause string           // Cause is the reason of the failure, empty if the execution did not fail.
 }
 
 // StepFunctions wraps an AWS StepFunctions client.
@@ -39,3 +77,130 @@ func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string,
 
 	return aws.StringValue(out.Definition), nil
 }
+
+// StartExecution starts an execution of the state machine with the JSON input and returns the ARN of the execution.
+func (s *StepFunctions) StartExecution(stateMachineARN, input string) (string, error) {
+	in := &sfn.StartExecutionInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+	if input != "" {
+		in.Input = aws.String(input)
+	}
+	out, err := s.client.StartExecution(in)
+	if err != nil {
+		return "", fmt.Errorf("start execution of state machine %s: %w", stateMachineARN, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
+
+// Execution returns the status of a state machine execution.
+func (s *StepFunctions) Execution(executionARN string) (*Execution, error) {
+	out, err := s.client.DescribeExecution(&sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(executionARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+	}
+	return &Execution{
+		ARN:       aws.StringValue(out.ExecutionArn),
+		Status:    aws.StringValue(out.Status),
+		StartDate: aws.TimeValue(out.StartDate),
+		StopDate:  aws.TimeValue(out.StopDate),
+	}, nil
+}
+
+// Executions returns up to maxResults of the most recent executions of the state machine, newest first.
+func (s *StepFunctions) Executions(stateMachineARN string, maxResults int) ([]*Execution, error) {
+	var executions []*Execution
+	in := &sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+	for len(executions) < maxResults {
+		in.MaxResults = aws.Int64(int64(maxResults - len(executions)))
+		out, err := s.client.ListExecutions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+		}
+		for _, execution := range out.Executions {
+			executions = append(executions, &Execution{
+				ARN:       aws.StringValue(execution.ExecutionArn),
+				Status:    aws.StringValue(execution.Status),
+				StartDate: aws.TimeValue(execution.StartDate),
+				StopDate:  aws.TimeValue(execution.StopDate),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return executions, nil
+}
+
+// ExecutionTasks returns the ECS tasks submitted by a state machine execution so far, including retried tasks.
+func (s *StepFunctions) ExecutionTasks(executionARN string) ([]*ExecutionTask, error) {
+	history, err := s.ExecutionHistory(executionARN)
+	if err != nil {
+		return nil, err
+	}
+	return history.Tasks, nil
+}
+
+// ExecutionHistory returns the ECS tasks submitted by a state machine execution so far and the reason of its failure, if any.
+func (s *StepFunctions) ExecutionHistory(executionARN string) (*ExecutionHistory, error) {
+	history := &ExecutionHistory{}
+	in := &sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionARN),
+	}
+	for {
+		out, err := s.client.GetExecutionHistory(in)
+		if err != nil {
+			return nil, fmt.Errorf("get history of execution %s: %w", executionARN, err)
+		}
+		for _, event := range out.Events {
+			switch {
+			case event.TaskSubmittedEventDetails != nil:
+				tasks, err := submittedTasks(aws.StringValue(event.TaskSubmittedEventDetails.Output))
+				if err != nil {
+					return nil, err
+				}
+				history.Tasks = append(history.Tasks, tasks...)
+			case event.ExecutionFailedEventDetails != nil:
+				history.Error = aws.StringValue(event.ExecutionFailedEventDetails.Error)
+				history.Cause = aws.StringValue(event.ExecutionFailedEventDetails.Cause)
+			case event.ExecutionTimedOutEventDetails != nil:
+				history.Error = aws.StringValue(event.ExecutionTimedOutEventDetails.Error)
+				history.Cause = aws.StringValue(event.ExecutionTimedOutEventDetails.Cause)
+			case event.ExecutionAbortedEventDetails != nil:
+				history.Error = aws.StringValue(event.ExecutionAbortedEventDetails.Error)
+				history.Cause = aws.StringValue(event.ExecutionAbortedEventDetails.Cause)
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return history, nil
+}
+
+// submittedTasks parses the output of a submitted ECS task, which is the RunTask response.
+func submittedTasks(output string) ([]*ExecutionTask, error) {
+	var runTask struct {
+		Tasks []struct {
+			TaskArn    string `json:"TaskArn"`
+			ClusterArn string `json:"ClusterArn"`
+		} `json:"Tasks"`
+	}
+	if err := json.Unmarshal([]byte(output), &runTask); err != nil {
+		return nil, fmt.Errorf("unmarshal submitted task output: %w", err)
+	}
+	var tasks []*ExecutionTask
+	for _, task := range runTask.Tasks {
+		tasks = append(tasks, &ExecutionTask{
+			TaskARN:    task.TaskArn,
+			ClusterARN: task.ClusterArn,
+		})
+	}
+	return tasks, nil
+}
@@ -7,6 +7,7 @@ package stepfunctions
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
@@ -68,3 +69,385 @@ func TestStepFunctions_StateMachineDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestStepFunctions_StartExecution(t *testing.T) {
+	testCases := map[string]struct {
+		inInput string
+
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedARN   string
+	}{
+		"fail to start execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start execution of state machine mockStateMachine: some error"),
+		},
+		"success with input": {
+			inInput: `{"Overrides":{}}`,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					Input:           aws.String(`{"Overrides":{}}`),
+				}).Return(&sfn.StartExecutionOutput{
+					ExecutionArn: aws.String("mockExecution"),
+				}, nil)
+			},
+			wantedARN: "mockExecution",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.StartExecution("mockStateMachine", tc.inInput)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_Execution(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError     error
+		wantedExecution *Execution
+		wantedRunning   bool
+	}{
+		"fail to describe execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe execution mockExecution: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("mockExecution"),
+				}).Return(&sfn.DescribeExecutionOutput{
+					ExecutionArn: aws.String("mockExecution"),
+					Status:       aws.String(sfn.ExecutionStatusRunning),
+				}, nil)
+			},
+			wantedExecution: &Execution{
+				ARN:    "mockExecution",
+				Status: ExecutionStatusRunning,
+			},
+			wantedRunning: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.Execution("mockExecution")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecution, out)
+				require.Equal(t, tc.wantedRunning, out.IsRunning())
+			}
+		})
+	}
+}
+
+func TestStepFunctions_ExecutionTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedTasks []*ExecutionTask
+	}{
+		"fail to get execution history": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution mockExecution: some error"),
+		},
+		"returns submitted tasks across pages": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecution"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeExecutionStarted),
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String(`{"Tasks":[{"TaskArn":"task1","ClusterArn":"cluster"}]}`),
+							},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecution"),
+					NextToken:    aws.String("next"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String(`{"Tasks":[{"TaskArn":"task2","ClusterArn":"cluster"}]}`),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedTasks: []*ExecutionTask{
+				{
+					TaskARN:    "task1",
+					ClusterARN: "cluster",
+				},
+				{
+					TaskARN:    "task2",
+					ClusterARN: "cluster",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ExecutionTasks("mockExecution")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_Executions(t *testing.T) {
+	startDate := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	stopDate := time.Date(2021, 9, 1, 9, 5, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError      error
+		wantedExecutions []*Execution
+	}{
+		"fail to list executions": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine mockStateMachine: some error"),
+		},
+		"returns executions across pages up to the maximum": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					MaxResults:      aws.Int64(2),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("execution2"),
+							Status:       aws.String(sfn.ExecutionStatusRunning),
+							StartDate:    aws.Time(startDate),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					MaxResults:      aws.Int64(1),
+					NextToken:       aws.String("next"),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("execution1"),
+							Status:       aws.String(sfn.ExecutionStatusSucceeded),
+							StartDate:    aws.Time(startDate),
+							StopDate:     aws.Time(stopDate),
+						},
+					},
+					NextToken: aws.String("more"),
+				}, nil)
+			},
+			wantedExecutions: []*Execution{
+				{
+					ARN:       "execution2",
+					Status:    ExecutionStatusRunning,
+					StartDate: startDate,
+				},
+				{
+					ARN:       "execution1",
+					Status:    ExecutionStatusSucceeded,
+					StartDate: startDate,
+					StopDate:  stopDate,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.Executions("mockStateMachine", 2)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutions, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_ExecutionHistory(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedHistory *ExecutionHistory
+	}{
+		"fail to parse submitted task output": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String(`not json`),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedError: errors.New("unmarshal submitted task output: invalid character 'o' in literal null (expecting 'u')"),
+		},
+		"returns retried tasks and the failure of the execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String(`{"Tasks":[{"TaskArn":"task1","ClusterArn":"cluster"}]}`),
+							},
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskFailed),
+							TaskFailedEventDetails: &sfn.TaskFailedEventDetails{
+								Error: aws.String("States.TaskFailed"),
+								Cause: aws.String("Essential container in task exited"),
+							},
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String(`{"Tasks":[{"TaskArn":"task2","ClusterArn":"cluster"}]}`),
+							},
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeExecutionFailed),
+							ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{
+								Error: aws.String("States.TaskFailed"),
+								Cause: aws.String("Essential container in task exited"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedHistory: &ExecutionHistory{
+				Tasks: []*ExecutionTask{
+					{
+						TaskARN:    "task1",
+						ClusterARN: "cluster",
+					},
+					{
+						TaskARN:    "task2",
+						ClusterARN: "cluster",
+					},
+				},
+				Error: "States.TaskFailed",
+				Cause: "Essential container in task exited",
+			},
+		},
+		"returns the reason of a timed out execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeExecutionTimedOut),
+							ExecutionTimedOutEventDetails: &sfn.ExecutionTimedOutEventDetails{
+								Error: aws.String("States.Timeout"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedHistory: &ExecutionHistory{
+				Error: "States.Timeout",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ExecutionHistory("mockExecution")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedHistory, out)
+			}
+		})
+	}
+}
@@ -23,8 +23,10 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppInitCommand())
 	cmd.AddCommand(buildAppListCommand())
 	cmd.AddCommand(buildAppShowCmd())
+	cmd.AddCommand(buildAppGraphCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
 	cmd.AddCommand(buildAppUpgradeCmd())
+	cmd.AddCommand(buildAppMigrateStoreCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,297 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appGraphNamePrompt     = "Which application would you like to graph?"
+	appGraphNameHelpPrompt = "An application is a collection of related services."
+)
+
+// Formats of the graph of an application.
+const (
+	graphFormatText    = "text"
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+	graphFormatJSON    = "json"
+)
+
+var graphFormats = []string{graphFormatText, graphFormatDOT, graphFormatMermaid, graphFormatJSON}
+
+type appGraphVars struct {
+	appName string
+	envName string
+	format  string
+}
+
+type appGraphOpts struct {
+	appGraphVars
+
+	store             store
+	ws                wsWlDirReader
+	deployStore       deployedWorkloadsLister
+	sel               appSelector
+	w                 io.Writer
+	unmarshal         func([]byte) (manifest.WorkloadManifest, error)
+	newAddons         func(wkld string) (templater, error)
+	newEndpointGetter func(app, env string) (endpointGetter, error)
+}
+
+func newAppGraphOpts(vars appGraphVars) (*appGraphOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
+	return &appGraphOpts{
+		appGraphVars: vars,
+		store:        store,
+		ws:           ws,
+		deployStore:  deployStore,
+		sel:          selector.NewSelect(prompt.New(), store),
+		w:            log.OutputWriter,
+		unmarshal:    manifest.UnmarshalWorkload,
+		newAddons: func(wkld string) (templater, error) {
+			return addon.New(wkld)
+		},
+		newEndpointGetter: func(app, env string) (endpointGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+			}
+			return d, nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *appGraphOpts) Validate() error {
+	if !contains(o.format, graphFormats) {
+		return fmt.Errorf("invalid format %s: must be one of %s", o.format, strings.Join(graphFormats, ", "))
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.appName != "" && o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *appGraphOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appGraphNamePrompt, appGraphNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute writes the graph of the workloads in the workspace in the requested format.
+func (o *appGraphOpts) Execute() error {
+	graph, err := o.graph()
+	if err != nil {
+		return err
+	}
+	switch o.format {
+	case graphFormatDOT:
+		fmt.Fprint(o.w, graph.DOTString())
+	case graphFormatMermaid:
+		fmt.Fprint(o.w, graph.MermaidString())
+	case graphFormatJSON:
+		data, err := graph.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	default:
+		fmt.Fprint(o.w, graph.HumanString())
+	}
+	return nil
+}
+
+func (o *appGraphOpts) graph() (*describe.AppGraph, error) {
+	names, err := o.ws.WorkloadNames()
+	if err != nil {
+		return nil, fmt.Errorf("list services and jobs in workspace: %w", err)
+	}
+	in := describe.AppGraphInput{
+		App: o.appName,
+		Env: o.envName,
+	}
+	deployed := make(map[string]bool)
+	if o.envName != "" {
+		if in.Namespace, err = o.serviceDiscoveryNamespace(); err != nil {
+			return nil, err
+		}
+		if deployed, err = o.deployedWorkloads(); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range names {
+		wkld, err := o.graphWorkload(name)
+		if err != nil {
+			return nil, err
+		}
+		wkld.NotDeployed = o.envName != "" && !deployed[name]
+		in.Workloads = append(in.Workloads, *wkld)
+	}
+	return describe.NewAppGraph(in), nil
+}
+
+func (o *appGraphOpts) graphWorkload(name string) (*describe.GraphWorkload, error) {
+	wkld, err := o.store.GetWorkload(o.appName, name)
+	if err != nil {
+		return nil, fmt.Errorf("get workload %s in application %s: %w", name, o.appName, err)
+	}
+	var raw []byte
+	if contains(wkld.Type, manifest.JobTypes) {
+		raw, err = o.ws.ReadJobManifest(name)
+	} else {
+		raw, err = o.ws.ReadServiceManifest(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+	}
+	if o.envName != "" {
+		if mft, err = mft.ApplyEnv(o.envName); err != nil {
+			return nil, fmt.Errorf("apply environment %s override to manifest of %s: %w", o.envName, name, err)
+		}
+	}
+	outputs, err := o.addonOutputs(name)
+	if err != nil {
+		return nil, err
+	}
+	return &describe.GraphWorkload{
+		Name:     name,
+		Type:     wkld.Type,
+		Manifest: mft,
+		Addons:   outputs,
+	}, nil
+}
+
+func (o *appGraphOpts) addonOutputs(wkld string) ([]addon.Output, error) {
+	addons, err := o.newAddons(wkld)
+	if err != nil {
+		return nil, fmt.Errorf("new addons client for %s: %w", wkld, err)
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieve addons template of %s: %w", wkld, err)
+	}
+	outputs, err := addon.Outputs(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("get addons outputs of %s: %w", wkld, err)
+	}
+	return outputs, nil
+}
+
+func (o *appGraphOpts) serviceDiscoveryNamespace() (string, error) {
+	getter, err := o.newEndpointGetter(o.appName, o.envName)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := getter.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return "", fmt.Errorf("get service discovery endpoint of environment %s: %w", o.envName, err)
+	}
+	return namespace, nil
+}
+
+func (o *appGraphOpts) deployedWorkloads() (map[string]bool, error) {
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed in environment %s: %w", o.envName, err)
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs deployed in environment %s: %w", o.envName, err)
+	}
+	deployed := make(map[string]bool)
+	for _, name := range append(svcs, jobs...) {
+		deployed[name] = true
+	}
+	return deployed, nil
+}
+
+// buildAppGraphCmd builds the command for drawing the graph of the workloads of an application.
+func buildAppGraphCmd() *cobra.Command {
+	vars := appGraphVars{}
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Shows how the services and jobs of an application depend on each other.",
+		Long: `Shows how the services and jobs in your workspace depend on each other.
+Workloads are connected through the SNS topics they publish and subscribe to,
+the service discovery endpoints referenced in their variables, the EFS file systems
+they mount, and the outputs of their addons.`,
+		Example: `
+  Shows the graph of the application in your workspace as tables.
+  /code $ copilot app graph
+  Renders the graph with the overrides and service discovery endpoints of the "test" environment with Graphviz.
+  /code $ copilot app graph --env test --format dot | dot -Tpng -o graph.png
+  Writes the graph as a Mermaid flowchart, which can be embedded in a pull request description.
+  /code $ copilot app graph --format mermaid`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppGraphOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", appGraphEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.format, formatFlag, graphFormatText, appGraphFormatFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
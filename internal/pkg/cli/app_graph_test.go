@@ -0,0 +1,261 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appGraphMocks struct {
+	store       *mocks.Mockstore
+	ws          *mocks.MockwsWlDirReader
+	deployStore *mocks.MockdeployedWorkloadsLister
+	addons      *mocks.Mocktemplater
+	endpoint    *mocks.MockendpointGetter
+}
+
+const (
+	testAppGraphAPIManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+publish:
+  topics:
+    - name: orders
+`
+	testAppGraphWorkerManifest = `name: worker
+type: Worker Service
+image:
+  build: worker/Dockerfile
+variables:
+  API_URL: http://api.test.phonetool.local:8080
+subscribe:
+  topics:
+    - name: orders
+      service: api
+`
+)
+
+func TestAppGraphOpts_Validate(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+		inFormat  string
+
+		setupMocks func(m appGraphMocks)
+
+		wantedError error
+	}{
+		"invalid format": {
+			inAppName:  "phonetool",
+			inFormat:   "svg",
+			setupMocks: func(m appGraphMocks) {},
+
+			wantedError: errors.New("invalid format svg: must be one of text, dot, mermaid, json"),
+		},
+		"invalid app name": {
+			inAppName: "phonetool",
+			inFormat:  graphFormatText,
+			setupMocks: func(m appGraphMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get application phonetool: %w", testError),
+		},
+		"invalid env name": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inFormat:  graphFormatDOT,
+			setupMocks: func(m appGraphMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get environment test in application phonetool: %w", testError),
+		},
+		"valid app and env": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inFormat:  graphFormatMermaid,
+			setupMocks: func(m appGraphMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := appGraphMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &appGraphOpts{
+				appGraphVars: appGraphVars{
+					appName: tc.inAppName,
+					envName: tc.inEnvName,
+					format:  tc.inFormat,
+				},
+				store: m.store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppGraphOpts_Execute(t *testing.T) {
+	testError := errors.New("some error")
+	mockWorkloads := func(m appGraphMocks) {
+		m.ws.EXPECT().WorkloadNames().Return([]string{"api", "worker"}, nil)
+		m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifest.BackendServiceType}, nil)
+		m.store.EXPECT().GetWorkload("phonetool", "worker").Return(&config.Workload{Name: "worker", Type: manifest.WorkerServiceType}, nil)
+		m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(testAppGraphAPIManifest), nil)
+		m.ws.EXPECT().ReadServiceManifest("worker").Return([]byte(testAppGraphWorkerManifest), nil)
+	}
+	testCases := map[string]struct {
+		inEnvName  string
+		inFormat   string
+		setupMocks func(m appGraphMocks)
+
+		wantedContent []string
+		wantedError   error
+	}{
+		"fails to list workloads": {
+			inFormat: graphFormatText,
+			setupMocks: func(m appGraphMocks) {
+				m.ws.EXPECT().WorkloadNames().Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list services and jobs in workspace: %w", testError),
+		},
+		"fails to get the service discovery endpoint": {
+			inEnvName: "test",
+			inFormat:  graphFormatText,
+			setupMocks: func(m appGraphMocks) {
+				m.ws.EXPECT().WorkloadNames().Return([]string{"api"}, nil)
+				m.endpoint.EXPECT().ServiceDiscoveryEndpoint().Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("get service discovery endpoint of environment test: %w", testError),
+		},
+		"fails to read the addons template": {
+			inFormat: graphFormatText,
+			setupMocks: func(m appGraphMocks) {
+				m.ws.EXPECT().WorkloadNames().Return([]string{"api"}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifest.BackendServiceType}, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(testAppGraphAPIManifest), nil)
+				m.addons.EXPECT().Template().Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("retrieve addons template of api: %w", testError),
+		},
+		"writes the graph as text with deployment state": {
+			inEnvName: "test",
+			inFormat:  graphFormatText,
+			setupMocks: func(m appGraphMocks) {
+				mockWorkloads(m)
+				m.endpoint.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return(nil, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{}).Times(2)
+			},
+
+			wantedContent: []string{
+				"api.test.phonetool.local:8080",
+				"Worker Service, not deployed",
+				"publishes",
+				"notifies",
+				"calls",
+			},
+		},
+		"writes the graph as a mermaid flowchart": {
+			inFormat: graphFormatMermaid,
+			setupMocks: func(m appGraphMocks) {
+				mockWorkloads(m)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{}).Times(2)
+			},
+
+			wantedContent: []string{
+				"flowchart LR",
+				`-->|"publishes"|`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := appGraphMocks{
+				store:       mocks.NewMockstore(ctrl),
+				ws:          mocks.NewMockwsWlDirReader(ctrl),
+				deployStore: mocks.NewMockdeployedWorkloadsLister(ctrl),
+				addons:      mocks.NewMocktemplater(ctrl),
+				endpoint:    mocks.NewMockendpointGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &appGraphOpts{
+				appGraphVars: appGraphVars{
+					appName: "phonetool",
+					envName: tc.inEnvName,
+					format:  tc.inFormat,
+				},
+				store:       m.store,
+				ws:          m.ws,
+				deployStore: m.deployStore,
+				w:           b,
+				unmarshal:   manifest.UnmarshalWorkload,
+				newAddons: func(string) (templater, error) {
+					return m.addons, nil
+				},
+				newEndpointGetter: func(string, string) (endpointGetter, error) {
+					return m.endpoint, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			for _, content := range tc.wantedContent {
+				require.Contains(t, b.String(), content)
+			}
+		})
+	}
+}
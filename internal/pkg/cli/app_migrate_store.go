@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appMigrateStoreNamePrompt     = "Which application would you like to migrate?"
+	appMigrateStoreNameHelpPrompt = "The application, its environments and workloads are copied to the destination store."
+)
+
+type migrateStoreAppVars struct {
+	name string
+	from string
+	to   string
+}
+
+type migrateStoreAppOpts struct {
+	migrateStoreAppVars
+
+	src store
+	dst store
+	sel appSelector
+}
+
+func newMigrateStoreAppOpts(vars migrateStoreAppVars) (*migrateStoreAppOpts, error) {
+	src, err := config.NewStoreWithBackend(vars.from)
+	if err != nil {
+		return nil, fmt.Errorf("new %s config store: %w", vars.from, err)
+	}
+	dst, err := config.NewStoreWithBackend(vars.to)
+	if err != nil {
+		return nil, fmt.Errorf("new %s config store: %w", vars.to, err)
+	}
+	return &migrateStoreAppOpts{
+		migrateStoreAppVars: vars,
+		src:                 src,
+		dst:                 dst,
+		sel:                 selector.NewSelect(prompt.New(), src),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *migrateStoreAppOpts) Validate() error {
+	if config.BackendName(o.from) == config.BackendName(o.to) {
+		return fmt.Errorf("--%s and --%s must be different config store backends", fromFlag, toFlag)
+	}
+	if o.name != "" {
+		if _, err := o.src.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *migrateStoreAppOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appMigrateStoreNamePrompt, appMigrateStoreNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute copies the application, its environments and its workloads from the source to the destination store.
+// Configuration that already exists in the destination store is left untouched.
+func (o *migrateStoreAppOpts) Execute() error {
+	app, err := o.src.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	envs, err := o.src.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	svcs, err := o.src.ListServices(o.name)
+	if err != nil {
+		return fmt.Errorf("list services in application %s: %w", o.name, err)
+	}
+	jobs, err := o.src.ListJobs(o.name)
+	if err != nil {
+		return fmt.Errorf("list jobs in application %s: %w", o.name, err)
+	}
+
+	if err := o.dst.CreateApplication(app); err != nil {
+		return fmt.Errorf("create application %s in the %s store: %w", o.name, o.to, err)
+	}
+	for _, env := range envs {
+		if err := o.dst.CreateEnvironment(env); err != nil {
+			return fmt.Errorf("create environment %s in the %s store: %w", env.Name, o.to, err)
+		}
+	}
+	for _, svc := range svcs {
+		if err := o.dst.CreateService(svc); err != nil {
+			return fmt.Errorf("create service %s in the %s store: %w", svc.Name, o.to, err)
+		}
+	}
+	for _, job := range jobs {
+		if err := o.dst.CreateJob(job); err != nil {
+			return fmt.Errorf("create job %s in the %s store: %w", job.Name, o.to, err)
+		}
+	}
+	log.Successf("Copied application %s with %d environment(s) and %d workload(s) from the %s store to the %s store.\n",
+		color.HighlightUserInput(o.name), len(envs), len(svcs)+len(jobs), o.from, o.to)
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *migrateStoreAppOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to use the %s store in subsequent commands.",
+			color.HighlightCode(fmt.Sprintf("export %s=%s", config.BackendEnvVar, o.to)), o.to),
+	})
+	return nil
+}
+
+// buildAppMigrateStoreCmd builds the command for copying an application between config store backends.
+func buildAppMigrateStoreCmd() *cobra.Command {
+	vars := migrateStoreAppVars{}
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Copies an application's configuration between config store backends.",
+		Long: `Copies an application's configuration between config store backends.
+The application, its environments and its workloads are copied. Configuration that already exists in the destination store is not overwritten.`,
+		Example: `
+  Copy the application "my-app" from SSM Parameter Store to the local store under .copilot/state.
+  /code $ copilot app migrate-store -n my-app --from ssm --to local`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMigrateStoreAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.from, fromFlag, config.SSMBackend, fromStoreFlagDescription)
+	cmd.Flags().StringVar(&vars.to, toFlag, config.LocalBackend, toStoreFlagDescription)
+	return cmd
+}
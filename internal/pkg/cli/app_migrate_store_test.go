@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type migrateStoreAppMocks struct {
+	src *mocks.Mockstore
+	dst *mocks.Mockstore
+	sel *mocks.MockappSelector
+}
+
+func TestMigrateStoreAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		inFrom     string
+		inTo       string
+		setupMocks func(m migrateStoreAppMocks)
+
+		wantedError error
+	}{
+		"should error if the source and destination backends are the same": {
+			inFrom:     "local",
+			inTo:       "local",
+			setupMocks: func(m migrateStoreAppMocks) {},
+
+			wantedError: errors.New("--from and --to must be different config store backends"),
+		},
+		"should error if the empty source backend resolves to the destination backend": {
+			inFrom:     "",
+			inTo:       "ssm",
+			setupMocks: func(m migrateStoreAppMocks) {},
+
+			wantedError: errors.New("--from and --to must be different config store backends"),
+		},
+		"should error if the application does not exist in the source store": {
+			inName: "phonetool",
+			inFrom: "ssm",
+			inTo:   "local",
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.src.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"success": {
+			inName: "phonetool",
+			inFrom: "ssm",
+			inTo:   "local",
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.src.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := migrateStoreAppMocks{
+				src: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &migrateStoreAppOpts{
+				migrateStoreAppVars: migrateStoreAppVars{
+					name: tc.inName,
+					from: tc.inFrom,
+					to:   tc.inTo,
+				},
+				src: m.src,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMigrateStoreAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m migrateStoreAppMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"should not prompt if the application name is provided": {
+			inName:     "phonetool",
+			setupMocks: func(m migrateStoreAppMocks) {},
+
+			wantedName: "phonetool",
+		},
+		"should wrap error if the selection fails": {
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.sel.EXPECT().Application(appMigrateStoreNamePrompt, appMigrateStoreNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select application: some error"),
+		},
+		"should select an application from the source store": {
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.sel.EXPECT().Application(appMigrateStoreNamePrompt, appMigrateStoreNameHelpPrompt).Return("phonetool", nil)
+			},
+
+			wantedName: "phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := migrateStoreAppMocks{
+				sel: mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &migrateStoreAppOpts{
+				migrateStoreAppVars: migrateStoreAppVars{
+					name: tc.inName,
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestMigrateStoreAppOpts_Execute(t *testing.T) {
+	app := &config.Application{Name: "phonetool", AccountID: "1234"}
+	env := &config.Environment{App: "phonetool", Name: "test"}
+	svc := &config.Workload{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"}
+	job := &config.Workload{App: "phonetool", Name: "report", Type: "Scheduled Job"}
+	testCases := map[string]struct {
+		setupMocks func(m migrateStoreAppMocks)
+
+		wantedError error
+	}{
+		"should wrap error if the application cannot be read from the source store": {
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.src.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"should wrap error if environments cannot be listed": {
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.src.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.src.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list environments in application phonetool: some error"),
+		},
+		"should wrap error if an environment cannot be created in the destination store": {
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.src.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.src.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{env}, nil)
+				m.src.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.src.EXPECT().ListJobs("phonetool").Return(nil, nil)
+				m.dst.EXPECT().CreateApplication(app).Return(nil)
+				m.dst.EXPECT().CreateEnvironment(env).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("create environment test in the local store: some error"),
+		},
+		"should copy the application, environments and workloads in order": {
+			setupMocks: func(m migrateStoreAppMocks) {
+				m.src.EXPECT().GetApplication("phonetool").Return(app, nil)
+				m.src.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{env}, nil)
+				m.src.EXPECT().ListServices("phonetool").Return([]*config.Workload{svc}, nil)
+				m.src.EXPECT().ListJobs("phonetool").Return([]*config.Workload{job}, nil)
+				gomock.InOrder(
+					m.dst.EXPECT().CreateApplication(app).Return(nil),
+					m.dst.EXPECT().CreateEnvironment(env).Return(nil),
+					m.dst.EXPECT().CreateService(svc).Return(nil),
+					m.dst.EXPECT().CreateJob(job).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := migrateStoreAppMocks{
+				src: mocks.NewMockstore(ctrl),
+				dst: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &migrateStoreAppOpts{
+				migrateStoreAppVars: migrateStoreAppVars{
+					name: "phonetool",
+					from: "ssm",
+					to:   "local",
+				},
+				src: m.src,
+				dst: m.dst,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
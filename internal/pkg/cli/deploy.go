@@ -4,23 +4,33 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -29,11 +39,39 @@ const (
 	jobWkldType = "job"
 )
 
+const (
+	deployAllEnvPrompt = "Select an environment to deploy your services and jobs to"
+
+	// maxConcurrentImageBuilds is the number of images that are built and pushed at the same time with --all.
+	maxConcurrentImageBuilds = 4
+)
+
+// workloadDeployer is a deploy command whose container image can be built separately from the deployment of the workload.
+type workloadDeployer interface {
+	actionCommand
+	prepare() error
+	manifest() (interface{}, error)
+	loginToRegistry(loggedIn map[string]bool) error
+	buildImage(out io.Writer) error
+	scanImage() error
+	signImage() error
+	deployWorkload(out termprogress.FileWriter) error
+}
+
+// topicSubscriber is a workload manifest that subscribes to the topics published by other workloads.
+type topicSubscriber interface {
+	Subscriptions() []manifest.TopicSubscription
+}
+
 type deployOpts struct {
 	deployWkldVars
 
+	deployAll bool
+
 	deployWkld     actionCommand
 	setupDeployCmd func(*deployOpts, string)
+	renderProgress func(r termprogress.DynamicRenderer) error
+	spinnerWriter  *mutableWriter // Where the spinners of the workloads write, discarded while they're deployed in parallel.
 
 	sel    wsSelector
 	store  store
@@ -44,7 +82,7 @@ type deployOpts struct {
 	wlType string
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+func newDeployOpts(vars deployWkldVars, deployAll bool) (*deployOpts, error) {
 	store, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
@@ -60,10 +98,12 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	prompter := prompt.New()
 	return &deployOpts{
 		deployWkldVars: vars,
+		deployAll:      deployAll,
 		store:          store,
 		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
 		ws:             ws,
 		prompt:         prompter,
+		spinnerWriter:  &mutableWriter{w: log.DiagnosticWriter},
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
 			switch {
@@ -71,14 +111,16 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 				o.deployWkld = &deployJobOpts{
 					deployWkldVars: o.deployWkldVars,
 
-					store:        o.store,
-					ws:           o.ws,
-					unmarshal:    manifest.UnmarshalWorkload,
-					spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
-					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
-					prompt:       o.prompt,
-					cmd:          exec.NewCmd(),
-					sessProvider: sessions.NewProvider(),
+					store:          o.store,
+					ws:             o.ws,
+					unmarshal:      manifest.UnmarshalWorkload,
+					spinner:        termprogress.NewSpinner(o.spinnerWriter),
+					sel:            selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
+					prompt:         o.prompt,
+					cmd:            exec.NewCmd(),
+					dockerEngine:   dockerengine.New(exec.NewCmd()),
+					sessProvider:   sessions.NewProvider(),
+					snsTopicGetter: deployStore,
 				}
 			case contains(workloadType, manifest.ServiceTypes):
 				opts := &deploySvcOpts{
@@ -87,10 +129,11 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					store:        o.store,
 					ws:           o.ws,
 					unmarshal:    manifest.UnmarshalWorkload,
-					spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
+					spinner:      termprogress.NewSpinner(o.spinnerWriter),
 					sel:          selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
 					prompt:       o.prompt,
 					cmd:          exec.NewCmd(),
+					dockerEngine: dockerengine.New(exec.NewCmd()),
 					sessProvider: sessions.NewProvider(),
 					newAppVersionGetter: func(appName string) (versionGetter, error) {
 						return describe.NewAppDescriber(appName)
@@ -101,10 +144,16 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 				o.deployWkld = opts
 			}
 		},
+		renderProgress: func(r termprogress.DynamicRenderer) error {
+			return termprogress.Render(context.Background(), termprogress.NewTabbedFileWriter(os.Stderr), r)
+		},
 	}, nil
 }
 
 func (o *deployOpts) Run() error {
+	if o.deployAll {
+		return o.runAll()
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -120,6 +169,325 @@ func (o *deployOpts) Run() error {
 	return nil
 }
 
+// runAll deploys every workload in the workspace to the same environment.
+// The images of the workloads are built concurrently. Then, the workloads are deployed so that the publishers of topics
+// are deployed before their subscribers, and workloads that don't depend on each other are deployed in parallel.
+func (o *deployOpts) runAll() error {
+	if o.name != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s flags", allFlag, nameFlag)
+	}
+	names, err := o.ws.WorkloadNames()
+	if err != nil {
+		return fmt.Errorf("list services and jobs in workspace: %w", err)
+	}
+	if len(names) == 0 {
+		return errors.New("no service or job found in the workspace")
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(deployAllEnvPrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	deployers := make([]workloadDeployer, len(names))
+	mfts := make([]interface{}, len(names))
+	for i, name := range names {
+		o.name = name
+		if err := o.loadWkld(); err != nil {
+			return fmt.Errorf("load %s: %w", name, err)
+		}
+		deployer, ok := o.deployWkld.(workloadDeployer)
+		if !ok {
+			return fmt.Errorf("%s %s cannot be deployed with --%s", o.wlType, name, allFlag)
+		}
+		if err := deployer.prepare(); err != nil {
+			return fmt.Errorf("prepare %s deploy of %s: %w", o.wlType, name, err)
+		}
+		mft, err := deployer.manifest()
+		if err != nil {
+			return err
+		}
+		deployers[i] = deployer
+		mfts[i] = mft
+	}
+	deps, levels, err := deploymentOrder(names, mfts)
+	if err != nil {
+		return err
+	}
+	if err := o.buildImages(names, deployers); err != nil {
+		return err
+	}
+	for i, deployer := range deployers {
+		if err := deployer.scanImage(); err != nil {
+			return fmt.Errorf("scan image of %s: %w", names[i], err)
+		}
+		if err := deployer.signImage(); err != nil {
+			return fmt.Errorf("sign image of %s: %w", names[i], err)
+		}
+	}
+	summary, err := o.deployInOrder(names, deployers, deps, levels)
+	if err != nil {
+		return err
+	}
+	log.Infoln()
+	log.Infoln(summary.HumanString())
+	if failed := summary.Failed(); len(failed) != 0 {
+		return fmt.Errorf("deploy %s", english.WordSeries(failed, "and"))
+	}
+	log.Successf("Deployed %s to environment %s.\n", english.WordSeries(names, "and"), color.HighlightUserInput(o.envName))
+	return nil
+}
+
+// deploymentOrder returns the dependencies of each workload, which are the workloads publishing the topics it subscribes to.
+// It also groups the workloads in levels so that every workload is in a later level than its dependencies.
+func deploymentOrder(names []string, mfts []interface{}) (deps [][]int, levels [][]int, err error) {
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	deps = make([][]int, len(names))
+	for i, mft := range mfts {
+		subscriber, ok := mft.(topicSubscriber)
+		if !ok {
+			continue
+		}
+		seen := make(map[int]bool)
+		for _, sub := range subscriber.Subscriptions() {
+			publisher, ok := index[sub.Service]
+			if !ok || publisher == i || seen[publisher] {
+				continue
+			}
+			seen[publisher] = true
+			deps[i] = append(deps[i], publisher)
+		}
+	}
+
+	ordered := make([]bool, len(names))
+	for remaining := len(names); remaining > 0; {
+		var level []int
+		for i := range names {
+			if ordered[i] {
+				continue
+			}
+			ready := true
+			for _, dep := range deps[i] {
+				if !ordered[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				level = append(level, i)
+			}
+		}
+		if len(level) == 0 {
+			var cycle []string
+			for i, name := range names {
+				if !ordered[i] {
+					cycle = append(cycle, name)
+				}
+			}
+			return nil, nil, fmt.Errorf("cannot order the deployments of %s: their topic subscriptions depend on each other", english.WordSeries(cycle, "and"))
+		}
+		for _, i := range level {
+			ordered[i] = true
+		}
+		remaining -= len(level)
+		levels = append(levels, level)
+	}
+	return deps, levels, nil
+}
+
+// buildImages builds and pushes the images of the workloads with at most maxConcurrentImageBuilds builds at a time.
+// The output of the builds that failed is written to the diagnostic writer.
+func (o *deployOpts) buildImages(names []string, deployers []workloadDeployer) error {
+	// Logging in to a registry writes to the docker config file, so each registry is logged in to once before the builds start.
+	loggedIn := make(map[string]bool)
+	for i, deployer := range deployers {
+		if err := deployer.loginToRegistry(loggedIn); err != nil {
+			return fmt.Errorf("log in to the registry of %s: %w", names[i], err)
+		}
+	}
+	builds := termprogress.NewImageBuilds(names, termprogress.RenderOptions{})
+	outputs := make([]bytes.Buffer, len(deployers))
+	errs := make([]error, len(deployers))
+	sem := make(chan struct{}, maxConcurrentImageBuilds)
+	var wg sync.WaitGroup
+	for i := range deployers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			builds.Start(names[i])
+			errs[i] = deployers[i].buildImage(&outputs[i])
+			builds.Stop(names[i], errs[i])
+		}(i)
+	}
+	renderErr := o.renderProgress(builds)
+	wg.Wait()
+	if renderErr != nil {
+		return fmt.Errorf("render image builds: %w", renderErr)
+	}
+
+	var failed []string
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed = append(failed, names[i])
+		log.Errorf("Failed to build the image of %s: %v\n", names[i], err)
+		log.Infoln(outputs[i].String())
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("build images of %s", english.WordSeries(failed, "and"))
+	}
+	return nil
+}
+
+// loginToRegistry logs in to the registry of the repository unless it's in loggedIn, and adds it to loggedIn.
+// The output of docker is only shown if it fails to log in.
+func loginToRegistry(repo registryLoginer, loggedIn map[string]bool) error {
+	registry := strings.SplitN(repo.URI(), "/", 2)[0]
+	if loggedIn[registry] {
+		repo.SkipLogin()
+		return nil
+	}
+	var out bytes.Buffer
+	if err := repo.Login(dockerengine.NewWithOutput(exec.NewCmd(), &out)); err != nil {
+		if out.Len() == 0 {
+			return err
+		}
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(out.String()))
+	}
+	loggedIn[registry] = true
+	return nil
+}
+
+// deployInOrder deploys the workloads level by level, the workloads of a level are deployed in parallel.
+// A workload is skipped if one of its dependencies failed to deploy or was skipped.
+func (o *deployOpts) deployInOrder(names []string, deployers []workloadDeployer, deps [][]int, levels [][]int) (describe.WorkloadDeployments, error) {
+	// The progress of each stack would be garbled when deploying in parallel, so only the status of each deployment is rendered.
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", os.DevNull, err)
+	}
+	defer devNull.Close()
+	// Similarly, the spinners of the deployments are discarded and their messages are held back until every deployment is done.
+	o.spinnerWriter.mute()
+	defer o.spinnerWriter.unmute()
+	logs := &syncBuffer{}
+	diagnostic, output := log.DiagnosticWriter, log.OutputWriter
+	log.DiagnosticWriter, log.OutputWriter = logs, logs
+	defer func() {
+		log.DiagnosticWriter, log.OutputWriter = diagnostic, output
+		fmt.Fprint(log.DiagnosticWriter, logs.String())
+	}()
+
+	deployments := termprogress.NewDeployments(names, termprogress.RenderOptions{})
+	summary := make(describe.WorkloadDeployments, len(names))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, level := range levels {
+			var wg sync.WaitGroup
+			for _, i := range level {
+				summary[i].Name = names[i]
+				if failed := failedDependency(summary, deps[i]); failed != "" {
+					summary[i].Status = describe.DeploymentStatusSkipped
+					summary[i].Details = fmt.Sprintf("%s failed to deploy", failed)
+					deployments.Skip(names[i])
+					continue
+				}
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					deployments.Start(names[i])
+					err := deployers[i].deployWorkload(devNull)
+					var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+					switch {
+					case errors.As(err, &errEmptyCS):
+						summary[i].Status = describe.DeploymentStatusDeployed
+						summary[i].Details = "no changes"
+						err = nil
+					case err != nil:
+						summary[i].Status = describe.DeploymentStatusFailed
+						summary[i].Details = err.Error()
+					default:
+						summary[i].Status = describe.DeploymentStatusDeployed
+					}
+					deployments.Stop(names[i], err)
+				}(i)
+			}
+			wg.Wait()
+		}
+	}()
+	renderErr := o.renderProgress(deployments)
+	<-done
+	if renderErr != nil {
+		return nil, fmt.Errorf("render deployments: %w", renderErr)
+	}
+	return summary, nil
+}
+
+// mutableWriter is a writer whose writes can be discarded for a while.
+type mutableWriter struct {
+	mu    sync.Mutex
+	w     io.Writer
+	muted bool
+}
+
+func (w *mutableWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.muted {
+		return len(p), nil
+	}
+	return w.w.Write(p)
+}
+
+func (w *mutableWriter) mute() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.muted = true
+}
+
+func (w *mutableWriter) unmute() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.muted = false
+}
+
+// syncBuffer is a buffer that can be written to by several deployments at the same time.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// failedDependency returns the name of the first dependency that failed to deploy or was skipped, if any.
+func failedDependency(summary describe.WorkloadDeployments, deps []int) string {
+	for _, dep := range deps {
+		if summary[dep].Status == describe.DeploymentStatusFailed || summary[dep].Status == describe.DeploymentStatusSkipped {
+			return summary[dep].Name
+		}
+	}
+	return ""
+}
+
 func (o *deployOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -162,6 +530,7 @@ func (o *deployOpts) loadWkldCmd() error {
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
 	vars := deployWkldVars{}
+	var deployAll bool
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
@@ -170,9 +539,11 @@ func BuildDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys every service and job in the workspace to a "test" environment.
+  /code $ copilot deploy --all --env test`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeployOpts(vars)
+			opts, err := newDeployOpts(vars, deployAll)
 			if err != nil {
 				return err
 			}
@@ -188,6 +559,7 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&deployAll, allFlag, false, deployAllFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
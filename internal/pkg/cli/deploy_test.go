@@ -4,11 +4,21 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
 	"testing"
 
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -164,3 +174,404 @@ func TestDeployOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+// fakeWorkloadDeployer records the calls made by deployOpts when deploying every workload in the workspace.
+type fakeWorkloadDeployer struct {
+	name      string
+	mft       interface{}
+	loginErr  error
+	buildErr  error
+	scanErr   error
+	signErr   error
+	deployErr error
+	deployLog string
+	spinner   io.Writer
+	calls     *[]string
+	mu        *sync.Mutex
+}
+
+func (d *fakeWorkloadDeployer) record(call string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	*d.calls = append(*d.calls, fmt.Sprintf("%s %s", call, d.name))
+}
+
+func (d *fakeWorkloadDeployer) Ask() error              { return nil }
+func (d *fakeWorkloadDeployer) Validate() error         { return nil }
+func (d *fakeWorkloadDeployer) Execute() error          { return nil }
+func (d *fakeWorkloadDeployer) RecommendActions() error { return nil }
+func (d *fakeWorkloadDeployer) prepare() error {
+	d.record("prepare")
+	return nil
+}
+func (d *fakeWorkloadDeployer) manifest() (interface{}, error) {
+	return d.mft, nil
+}
+func (d *fakeWorkloadDeployer) loginToRegistry(loggedIn map[string]bool) error {
+	return loginToRegistry(d, loggedIn)
+}
+func (d *fakeWorkloadDeployer) URI() string {
+	return "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/" + d.name
+}
+func (d *fakeWorkloadDeployer) Login(docker repository.ContainerLoginBuildPusher) error {
+	d.record("login")
+	return d.loginErr
+}
+func (d *fakeWorkloadDeployer) SkipLogin() {
+	d.record("skip login")
+}
+func (d *fakeWorkloadDeployer) buildImage(out io.Writer) error {
+	d.record("build")
+	fmt.Fprintf(out, "building %s", d.name)
+	return d.buildErr
+}
+func (d *fakeWorkloadDeployer) scanImage() error {
+	d.record("scan")
+	return d.scanErr
+}
+func (d *fakeWorkloadDeployer) signImage() error {
+	d.record("sign")
+	return d.signErr
+}
+func (d *fakeWorkloadDeployer) deployWorkload(out termprogress.FileWriter) error {
+	d.record("deploy")
+	if d.deployLog != "" {
+		fmt.Fprintf(d.spinner, "deploying %s", d.name)
+		log.Infoln(d.deployLog)
+	}
+	return d.deployErr
+}
+
+func TestDeployOpts_RunAll(t *testing.T) {
+	mockWorkloads := map[string]*config.Workload{
+		"fe": {
+			App:  "app",
+			Name: "fe",
+			Type: manifest.LoadBalancedWebServiceType,
+		},
+		"worker": {
+			App:  "app",
+			Name: "worker",
+			Type: manifest.WorkerServiceType,
+		},
+		"mailer": {
+			App:  "app",
+			Name: "mailer",
+			Type: manifest.ScheduledJobType,
+		},
+	}
+	subscriberOf := func(publishers ...string) *manifest.WorkerService {
+		var topics []manifest.TopicSubscription
+		for _, publisher := range publishers {
+			topics = append(topics, manifest.TopicSubscription{
+				Name:    "events",
+				Service: publisher,
+			})
+		}
+		return &manifest.WorkerService{
+			WorkerServiceConfig: manifest.WorkerServiceConfig{
+				Subscribe: &manifest.SubscribeConfig{
+					Topics: topics,
+				},
+			},
+		}
+	}
+	mockAllWorkloads := func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector, store *mocks.Mockstore) {
+		ws.EXPECT().WorkloadNames().Return([]string{"fe", "worker", "mailer"}, nil)
+		for _, name := range []string{"fe", "worker", "mailer"} {
+			store.EXPECT().GetWorkload("app", name).Return(mockWorkloads[name], nil)
+		}
+	}
+	testCases := map[string]struct {
+		inName    string
+		inEnvName string
+		mfts      map[string]interface{}
+		loginErrs map[string]error
+		buildErrs map[string]error
+		scanErrs  map[string]error
+		signErrs  map[string]error
+		deployErr map[string]error
+
+		setupMocks func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector, store *mocks.Mockstore)
+
+		wantedCalls []string
+		wantedOrder [][2]string // wantedOrder lists pairs of calls where the first call must happen before the second one.
+		wantedErr   string
+	}{
+		"errors if a name is specified": {
+			inName:     "fe",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector, store *mocks.Mockstore) {},
+			wantedErr:  "cannot specify both --all and --name flags",
+		},
+		"errors if there is no workload in the workspace": {
+			setupMocks: func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector, store *mocks.Mockstore) {
+				ws.EXPECT().WorkloadNames().Return(nil, nil)
+			},
+			wantedErr: "no service or job found in the workspace",
+		},
+		"errors if failed to select an environment": {
+			setupMocks: func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector, store *mocks.Mockstore) {
+				ws.EXPECT().WorkloadNames().Return([]string{"fe"}, nil)
+				sel.EXPECT().Environment(deployAllEnvPrompt, "", "app").Return("", errors.New("some error"))
+			},
+			wantedErr: "select environment: some error",
+		},
+		"errors if topic subscriptions depend on each other": {
+			inEnvName: "test",
+			mfts: map[string]interface{}{
+				"fe":     subscriberOf("worker"),
+				"worker": subscriberOf("fe"),
+			},
+			setupMocks:  mockAllWorkloads,
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer"},
+			wantedErr:   "cannot order the deployments of fe and worker: their topic subscriptions depend on each other",
+		},
+		"does not build any image if failed to log in to the registry": {
+			inEnvName: "test",
+			loginErrs: map[string]error{
+				"fe": errors.New("some error"),
+			},
+			setupMocks:  mockAllWorkloads,
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer", "login fe"},
+			wantedErr:   "log in to the registry of fe: some error",
+		},
+		"does not deploy any workload if an image fails to build": {
+			inEnvName: "test",
+			buildErrs: map[string]error{
+				"mailer": errors.New("some error"),
+			},
+			setupMocks: mockAllWorkloads,
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer",
+				"login fe", "skip login worker", "skip login mailer", "build fe", "build worker", "build mailer"},
+			wantedErr: "build images of mailer",
+		},
+		"does not deploy any workload if an image scan fails": {
+			inEnvName: "test",
+			scanErrs: map[string]error{
+				"fe": errors.New("some error"),
+			},
+			setupMocks: mockAllWorkloads,
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer",
+				"login fe", "skip login worker", "skip login mailer", "build fe", "build worker", "build mailer", "scan fe"},
+			wantedErr: "scan image of fe: some error",
+		},
+		"does not deploy any workload if an image fails to be signed": {
+			inEnvName: "test",
+			signErrs: map[string]error{
+				"worker": errors.New("some error"),
+			},
+			setupMocks: mockAllWorkloads,
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer",
+				"login fe", "skip login worker", "skip login mailer", "build fe", "build worker", "build mailer",
+				"scan fe", "sign fe", "scan worker", "sign worker"},
+			wantedErr: "sign image of worker: some error",
+		},
+		"skips the subscribers of a publisher that failed to deploy": {
+			inEnvName: "test",
+			mfts: map[string]interface{}{
+				"worker": subscriberOf("fe"),
+			},
+			deployErr: map[string]error{
+				"fe": errors.New("some error"),
+			},
+			setupMocks: mockAllWorkloads,
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer",
+				"login fe", "skip login worker", "skip login mailer", "build fe", "build worker", "build mailer",
+				"scan fe", "sign fe", "scan worker", "sign worker", "scan mailer", "sign mailer", "deploy fe", "deploy mailer"},
+			wantedErr: "deploy fe",
+		},
+		"deploys publishers before their subscribers": {
+			mfts: map[string]interface{}{
+				"worker": subscriberOf("fe", "mailer", "fe"),
+			},
+			deployErr: map[string]error{
+				"mailer": awscloudformation.NewMockErrChangeSetEmpty(),
+			},
+			setupMocks: func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector, store *mocks.Mockstore) {
+				sel.EXPECT().Environment(deployAllEnvPrompt, "", "app").Return("test", nil)
+				mockAllWorkloads(ws, sel, store)
+			},
+			wantedCalls: []string{"prepare fe", "prepare worker", "prepare mailer",
+				"login fe", "skip login worker", "skip login mailer", "build fe", "build worker", "build mailer",
+				"scan fe", "sign fe", "scan worker", "sign worker", "scan mailer", "sign mailer", "deploy fe", "deploy worker", "deploy mailer"},
+			wantedOrder: [][2]string{
+				{"login fe", "build fe"},
+				{"skip login worker", "build fe"},
+				{"skip login mailer", "build fe"},
+				{"build fe", "scan fe"},
+				{"build worker", "scan fe"},
+				{"build mailer", "scan fe"},
+				{"scan fe", "sign fe"},
+				{"sign mailer", "deploy fe"},
+				{"deploy fe", "deploy worker"},
+				{"deploy mailer", "deploy worker"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsWlDirReader(ctrl)
+			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockWs, mockSel, mockStore)
+
+			var calls []string
+			var mu sync.Mutex
+			opts := &deployOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "app",
+					name:    tc.inName,
+					envName: tc.inEnvName,
+				},
+				deployAll:     true,
+				ws:            mockWs,
+				sel:           mockSel,
+				store:         mockStore,
+				spinnerWriter: &mutableWriter{w: ioutil.Discard},
+
+				setupDeployCmd: func(o *deployOpts, wlType string) {
+					require.Equal(t, "test", o.envName, "expected every workload to be deployed to the same environment")
+					o.deployWkld = &fakeWorkloadDeployer{
+						name:      o.name,
+						mft:       tc.mfts[o.name],
+						loginErr:  tc.loginErrs[o.name],
+						buildErr:  tc.buildErrs[o.name],
+						scanErr:   tc.scanErrs[o.name],
+						signErr:   tc.signErrs[o.name],
+						deployErr: tc.deployErr[o.name],
+						calls:     &calls,
+						mu:        &mu,
+					}
+				},
+				renderProgress: func(r termprogress.DynamicRenderer) error {
+					<-r.Done()
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			// Images are built and independent workloads are deployed concurrently, so only some calls are ordered.
+			require.ElementsMatch(t, tc.wantedCalls, calls)
+			position := make(map[string]int)
+			for i, call := range calls {
+				position[call] = i
+			}
+			for _, pair := range tc.wantedOrder {
+				require.Less(t, position[pair[0]], position[pair[1]], "expected %q to happen before %q", pair[0], pair[1])
+			}
+		})
+	}
+}
+
+func TestDeployOpts_deployInOrderOutput(t *testing.T) {
+	// GIVEN
+	var stderr bytes.Buffer
+	diagnostic := log.DiagnosticWriter
+	log.DiagnosticWriter = &stderr
+	defer func() { log.DiagnosticWriter = diagnostic }()
+
+	var calls []string
+	var mu sync.Mutex
+	opts := &deployOpts{
+		spinnerWriter: &mutableWriter{w: &stderr},
+		renderProgress: func(r termprogress.DynamicRenderer) error {
+			<-r.Done()
+			fmt.Fprintln(&stderr, "rendered")
+			return nil
+		},
+	}
+	deployer := &fakeWorkloadDeployer{
+		name:      "fe",
+		deployLog: "Failed to record the deployment of service fe",
+		spinner:   opts.spinnerWriter,
+		calls:     &calls,
+		mu:        &mu,
+	}
+
+	// WHEN
+	_, err := opts.deployInOrder([]string{"fe"}, []workloadDeployer{deployer}, [][]int{nil}, [][]int{{0}})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "rendered\nFailed to record the deployment of service fe\n", stderr.String())
+	fmt.Fprint(opts.spinnerWriter, "scanning fe")
+	require.Contains(t, stderr.String(), "scanning fe", "expected spinners to write once the deployments are done")
+}
+
+func TestDeploymentOrder(t *testing.T) {
+	subscriber := func(publishers ...string) *manifest.ScheduledJob {
+		var topics []manifest.TopicSubscription
+		for _, publisher := range publishers {
+			topics = append(topics, manifest.TopicSubscription{
+				Name:    "events",
+				Service: publisher,
+			})
+		}
+		return &manifest.ScheduledJob{
+			ScheduledJobConfig: manifest.ScheduledJobConfig{
+				On: manifest.JobTriggerConfig{
+					Topics: topics,
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		names []string
+		mfts  []interface{}
+
+		wantedDeps   [][]int
+		wantedLevels [][]int
+		wantedErr    string
+	}{
+		"deploys everything at once without subscriptions": {
+			names:        []string{"api", "fe"},
+			mfts:         []interface{}{&manifest.LoadBalancedWebService{}, nil},
+			wantedDeps:   [][]int{nil, nil},
+			wantedLevels: [][]int{{0, 1}},
+		},
+		"ignores subscriptions to workloads that are not deployed and to itself": {
+			names:        []string{"api", "worker"},
+			mfts:         []interface{}{nil, subscriber("other", "worker")},
+			wantedDeps:   [][]int{nil, nil},
+			wantedLevels: [][]int{{0, 1}},
+		},
+		"deploys publishers first": {
+			names:        []string{"report", "worker", "api", "fe"},
+			mfts:         []interface{}{subscriber("worker"), subscriber("api", "api"), nil, nil},
+			wantedDeps:   [][]int{{1}, {2}, nil, nil},
+			wantedLevels: [][]int{{2, 3}, {1}, {0}},
+		},
+		"errors on circular subscriptions": {
+			names:     []string{"api", "worker", "report"},
+			mfts:      []interface{}{nil, subscriber("report"), subscriber("worker")},
+			wantedErr: "cannot order the deployments of worker and report: their topic subscriptions depend on each other",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			deps, levels, err := deploymentOrder(tc.names, tc.mfts)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeps, deps)
+			require.Equal(t, tc.wantedLevels, levels)
+		})
+	}
+}
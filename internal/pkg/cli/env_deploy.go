@@ -0,0 +1,399 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	envDeployNamePrompt = "Which environment do you want to deploy?"
+	envDeployNameHelp   = `Deploys the configuration of copilot/environments/<name>/manifest.yml
+to the AWS CloudFormation stack of the environment.`
+
+	fmtEnvDeployStart    = "Deploying the manifest of environment %s."
+	fmtEnvDeployFailed   = "Failed to deploy the manifest of environment %s.\n"
+	fmtEnvDeployComplete = "Deployed the manifest of environment %s.\n"
+)
+
+type deployEnvVars struct {
+	appName string
+	name    string
+}
+
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store    store
+	ws       wsEnvironmentReader
+	sel      appEnvSelector
+	prog     progress
+	appCFN   appResourcesGetter
+	uploader customResourcesUploader
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newEnvUpgrader      func(conf *config.Environment) (envUpgrader, error)
+	newLBDescriber      func(conf *config.Environment) (loadBalancerDescriber, error)
+	newS3               func(region string) (zipAndUploader, error)
+
+	// Cached variables.
+	targetEnv *config.Environment
+}
+
+func newDeployEnvOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+
+		store:    store,
+		ws:       ws,
+		sel:      selector.NewSelect(prompt.New(), store),
+		prog:     termprogress.NewSpinner(log.DiagnosticWriter),
+		appCFN:   cloudformation.New(defaultSession),
+		uploader: template.New(),
+
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+			}
+			return d, nil
+		},
+		newEnvUpgrader: func(conf *config.Environment) (envUpgrader, error) {
+			sess, err := sessions.NewProvider().FromRole(conf.ManagerRoleARN, conf.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %v", conf.ManagerRoleARN, conf.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+		newLBDescriber: func(conf *config.Environment) (loadBalancerDescriber, error) {
+			sess, err := sessions.NewProvider().FromRole(conf.ManagerRoleARN, conf.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %v", conf.ManagerRoleARN, conf.Region, err)
+			}
+			return elbv2.New(sess), nil
+		},
+		newS3: func(region string) (zipAndUploader, error) {
+			sess, err := sessions.NewProvider().DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %v", region, err)
+			}
+			return s3.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the environment does not exist in the application.
+func (o *deployEnvOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.getTargetEnv(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Ask prompts for the environment to deploy if it wasn't provided.
+func (o *deployEnvOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Environment(envDeployNamePrompt, envDeployNameHelp, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute updates the stack of the environment with the configuration in its manifest,
+// and stores the configuration so that later upgrades of the environment keep it.
+func (o *deployEnvOpts) Execute() error {
+	env, err := o.getTargetEnv()
+	if err != nil {
+		return err
+	}
+	mft, err := o.readManifest()
+	if err != nil {
+		return err
+	}
+	if err := o.validateEnvVersion(); err != nil {
+		return err
+	}
+	customConfig, err := customizeEnvFromManifest(mft, env.CustomConfig)
+	if err != nil {
+		return err
+	}
+	if lbARN := mft.HTTPConfig.Public.LoadBalancer; lbARN != nil {
+		if customConfig.ImportALB, err = o.importALB(env, aws.StringValue(lbARN), customConfig.ImportVPC.ID); err != nil {
+			return err
+		}
+	}
+	urls, err := o.uploadCustomResources(env)
+	if err != nil {
+		return err
+	}
+	if err := o.deploy(env, customConfig, urls); err != nil {
+		return err
+	}
+	env.CustomConfig = customConfig
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("update configuration of environment %s: %w", env.Name, err)
+	}
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *deployEnvOpts) RecommendActions() error {
+	return nil
+}
+
+func (o *deployEnvOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	o.targetEnv = env
+	return env, nil
+}
+
+func (o *deployEnvOpts) readManifest() (*manifest.Environment, error) {
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for environment %s: %w", o.name, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for environment %s: %w", o.name, err)
+	}
+	if err := mft.Validate(); err != nil {
+		return nil, fmt.Errorf("validate manifest for environment %s: %w", o.name, err)
+	}
+	if name := aws.StringValue(mft.Name); name != o.name {
+		return nil, fmt.Errorf(`name %q in the manifest does not match environment %s`, name, o.name)
+	}
+	return mft, nil
+}
+
+// validateEnvVersion returns an error if the environment stack is not on the template version of this CLI.
+// Older templates must first be upgraded, since "env upgrade" knows how to migrate legacy stacks.
+func (o *deployEnvOpts) validateEnvVersion() error {
+	getter, err := o.newEnvVersionGetter(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %w", o.name, o.appName, err)
+	}
+	switch diff := semver.Compare(version, deploy.LatestEnvTemplateVersion); {
+	case diff < 0:
+		log.Errorf("Environment %s is on version %s. Please run %s first.\n",
+			o.name, version, color.HighlightCode(fmt.Sprintf("copilot env upgrade -n %s", o.name)))
+		return fmt.Errorf("environment %s is not on the latest version %s", o.name, deploy.LatestEnvTemplateVersion)
+	case diff > 0:
+		return fmt.Errorf("environment %s is on version %s which is newer than %s: are you using the latest version of AWS Copilot?",
+			o.name, version, deploy.LatestEnvTemplateVersion)
+	}
+	return nil
+}
+
+// importALB returns the configuration of the load balancer imported by the environment.
+// The listeners on the load balancer are only checked the first time it is imported, since they are created by Copilot afterwards.
+func (o *deployEnvOpts) importALB(env *config.Environment, lbARN, vpcID string) (*config.ImportALB, error) {
+	lbs, err := o.newLBDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	alreadyImported := env.CustomConfig != nil && env.CustomConfig.ImportALB != nil && env.CustomConfig.ImportALB.ARN == lbARN
+	return importedALBConfig(lbs, lbARN, vpcID, !alreadyImported)
+}
+
+func (o *deployEnvOpts) uploadCustomResources(env *config.Environment) (map[string]string, error) {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get app resources: %w", err)
+	}
+	s3Client, err := o.newS3(env.Region)
+	if err != nil {
+		return nil, err
+	}
+	urls, err := o.uploader.UploadEnvironmentCustomResources(s3.CompressAndUploadFunc(func(key string, objects ...s3.NamedBinary) (string, error) {
+		return s3Client.ZipAndUpload(resources.S3Bucket, key, objects...)
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("upload custom resources to bucket %s: %w", resources.S3Bucket, err)
+	}
+	return urls, nil
+}
+
+func (o *deployEnvOpts) deploy(env *config.Environment, customConfig *config.CustomizeEnv, customResourcesURLs map[string]string) (err error) {
+	upgrader, err := o.newEnvUpgrader(env)
+	if err != nil {
+		return err
+	}
+	in := &deploy.CreateEnvironmentInput{
+		Version: deploy.LatestEnvTemplateVersion,
+		App: deploy.AppInformation{
+			Name: env.App,
+		},
+		Name:                env.Name,
+		CustomResourcesURLs: customResourcesURLs,
+		CFNServiceRoleARN:   env.ExecutionRoleARN,
+	}
+	if customConfig != nil {
+		in.ImportVPCConfig = customConfig.ImportVPC
+		in.AdjustVPCConfig = customConfig.VPCConfig
+		in.ImportCertARNs = customConfig.ImportCertARNs
+		in.ImportALB = customConfig.ImportALB
+		in.Telemetry = customConfig.Telemetry
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtEnvDeployStart, color.HighlightUserInput(env.Name)))
+	defer func() {
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtEnvDeployFailed, color.HighlightUserInput(env.Name)))
+			return
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployComplete, color.HighlightUserInput(env.Name)))
+	}()
+	if err := upgrader.UpgradeEnvironment(in); err != nil {
+		return fmt.Errorf("deploy environment %s: %w", env.Name, err)
+	}
+	return nil
+}
+
+// customizeEnvFromManifest merges an environment manifest into the custom configuration stored for the environment.
+// Settings that the manifest leaves out keep their stored value, and the VPC of the environment can't be replaced.
+// It returns nil if the environment uses the default configuration.
+func customizeEnvFromManifest(mft *manifest.Environment, stored *config.CustomizeEnv) (*config.CustomizeEnv, error) {
+	var conf config.CustomizeEnv
+	if stored != nil {
+		conf = *stored
+	}
+	name := aws.StringValue(mft.Name)
+	vpc := mft.Network.VPC
+	switch {
+	case vpc.IsImported():
+		id := aws.StringValue(vpc.ID)
+		if conf.ImportVPC == nil {
+			return nil, fmt.Errorf("cannot import VPC %s in environment %s: the environment uses a VPC created by Copilot", id, name)
+		}
+		if conf.ImportVPC.ID != id {
+			return nil, fmt.Errorf("cannot import VPC %s in environment %s: the environment uses VPC %s", id, name, conf.ImportVPC.ID)
+		}
+		conf.ImportVPC = &config.ImportVPC{
+			ID:               id,
+			PublicSubnetIDs:  vpc.Subnets.PublicSubnetIDs(),
+			PrivateSubnetIDs: vpc.Subnets.PrivateSubnetIDs(),
+		}
+	case vpc.IsAdjusted():
+		if conf.ImportVPC != nil {
+			return nil, fmt.Errorf("cannot create a VPC for environment %s: the environment uses VPC %s", name, conf.ImportVPC.ID)
+		}
+		adjusted := config.AdjustVPC{
+			CIDR:               stack.DefaultVPCCIDR,
+			PublicSubnetCIDRs:  strings.Split(stack.DefaultPublicSubnetCIDRs, ","),
+			PrivateSubnetCIDRs: strings.Split(stack.DefaultPrivateSubnetCIDRs, ","),
+		}
+		if conf.VPCConfig != nil {
+			adjusted = *conf.VPCConfig
+		}
+		if vpc.CIDR != nil {
+			if cidr := aws.StringValue(vpc.CIDR); cidr != adjusted.CIDR {
+				return nil, fmt.Errorf("cannot change the CIDR range of the VPC of environment %s from %s to %s", name, adjusted.CIDR, cidr)
+			}
+			adjusted.PublicSubnetCIDRs = vpc.Subnets.PublicSubnetCIDRs()
+			adjusted.PrivateSubnetCIDRs = vpc.Subnets.PrivateSubnetCIDRs()
+		}
+		adjusted.ForceNATGateways = aws.BoolValue(vpc.NATGateways)
+		conf.VPCConfig = &adjusted
+	}
+	if certs := mft.HTTPConfig.Public.Certificates; len(certs) != 0 {
+		conf.ImportCertARNs = certs
+	}
+	if mft.Observability.ContainerInsights != nil {
+		conf.Telemetry = &config.Telemetry{
+			EnableContainerInsights: aws.BoolValue(mft.Observability.ContainerInsights),
+		}
+	}
+	if conf.ImportVPC == nil && conf.VPCConfig == nil && len(conf.ImportCertARNs) == 0 && conf.ImportALB == nil && conf.Telemetry == nil {
+		return nil, nil
+	}
+	return &conf, nil
+}
+
+// buildEnvDeployCmd builds the command for deploying the manifest of an environment.
+func buildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the manifest of an environment.",
+		Long: `Deploys the manifest of an environment.
+Updates the VPC, load balancer, and observability settings of the environment
+to match copilot/environments/<name>/manifest.yml.`,
+		Example: `
+  Deploy the manifest of the "test" environment.
+  /code $ copilot env deploy --name test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeployEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	return cmd
+}
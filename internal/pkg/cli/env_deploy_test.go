@@ -0,0 +1,566 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deployEnvMocks struct {
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsEnvironmentReader
+	prog     *mocks.Mockprogress
+	appCFN   *mocks.MockappResourcesGetter
+	uploader *mocks.MockcustomResourcesUploader
+	version  *mocks.MockversionGetter
+	upgrader *mocks.MockenvUpgrader
+	lbs      *mocks.MockloadBalancerDescriber
+}
+
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inName    string
+		setupMock func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"errors if there is no application": {
+			setupMock: func(m *mocks.Mockstore) {},
+			wantedErr: errNoAppInWorkspace,
+		},
+		"skips the environment check if the name is not provided": {
+			inAppName: "phonetool",
+			setupMock: func(m *mocks.Mockstore) {},
+		},
+		"errors if the environment does not exist": {
+			inAppName: "phonetool",
+			inName:    "test",
+			setupMock: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test configuration: some error"),
+		},
+		"succeeds if the environment exists": {
+			inAppName: "phonetool",
+			inName:    "test",
+			setupMock: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstore(ctrl)
+			tc.setupMock(m)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inName,
+				},
+				store: m,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName    string
+		setupMock func(m *mocks.MockappEnvSelector)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"does not prompt if the name is provided": {
+			inName:     "test",
+			setupMock:  func(m *mocks.MockappEnvSelector) {},
+			wantedName: "test",
+		},
+		"prompts for the environment": {
+			setupMock: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(envDeployNamePrompt, envDeployNameHelp, "phonetool").Return("prod", nil)
+			},
+			wantedName: "prod",
+		},
+		"wraps the error of the selector": {
+			setupMock: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockappEnvSelector(ctrl)
+			tc.setupMock(m)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				sel: m,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	const mft = `name: test
+type: Environment
+network:
+  vpc:
+    nat_gateways: true
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/abc
+observability:
+  container_insights: true`
+	mockEnv := func() *config.Environment {
+		return &config.Environment{
+			App:              "phonetool",
+			Name:             "test",
+			Region:           "us-west-2",
+			ExecutionRoleARN: "execARN",
+		}
+	}
+	wantedConfig := &config.CustomizeEnv{
+		VPCConfig: &config.AdjustVPC{
+			CIDR:               stack.DefaultVPCCIDR,
+			PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+			ForceNATGateways:   true,
+		},
+		ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+		Telemetry: &config.Telemetry{
+			EnableContainerInsights: true,
+		},
+	}
+	const albARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	const albMft = `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1
+    subnets:
+      public:
+        - id: subnet-1
+        - id: subnet-2
+      private:
+        - id: subnet-3
+        - id: subnet-4
+http:
+  public:
+    load_balancer: ` + albARN
+	uploadCustomResources := func(m *deployEnvMocks) {
+		m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+		m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+			Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+		m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"EnvControllerFunction": "url"}, nil)
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m *deployEnvMocks)
+
+		wantedErr error
+	}{
+		"errors if the manifest cannot be read": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest for environment test: some error"),
+		},
+		"errors if the manifest is invalid": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.0.0.0/16`), nil)
+			},
+			wantedErr: errors.New(`validate manifest for environment test: validate "network.vpc": must specify both "subnets.public" and "subnets.private" when "cidr" is specified`),
+		},
+		"errors if the name in the manifest does not match": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: prod
+type: Environment`), nil)
+			},
+			wantedErr: errors.New(`name "prod" in the manifest does not match environment test`),
+		},
+		"errors if the environment must be upgraded first": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mft), nil)
+				m.version.EXPECT().Version().Return("v1.0.0", nil)
+			},
+			wantedErr: errors.New("environment test is not on the latest version " + deploy.LatestEnvTemplateVersion),
+		},
+		"errors if the environment is on a newer version": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mft), nil)
+				m.version.EXPECT().Version().Return("v100.0.0", nil)
+			},
+			wantedErr: errors.New("environment test is on version v100.0.0 which is newer than " + deploy.LatestEnvTemplateVersion + ": are you using the latest version of AWS Copilot?"),
+		},
+		"errors if the stack fails to update": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mft), nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				uploadCustomResources(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deploy environment test: some error"),
+		},
+		"errors if the manifest replaces the VPC of the environment": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(albMft), nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+			},
+			wantedErr: errors.New("cannot import VPC vpc-1 in environment test: the environment uses a VPC created by Copilot"),
+		},
+		"errors if the imported load balancer cannot be used": {
+			setupMocks: func(m *deployEnvMocks) {
+				env := mockEnv()
+				env.CustomConfig = &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{ID: "vpc-1"},
+				}
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(albMft), nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				m.lbs.EXPECT().LoadBalancer(albARN).Return(&elbv2.LoadBalancer{
+					ARN:           albARN,
+					Type:          elbv2.LoadBalancerTypeApplication,
+					Scheme:        elbv2.LoadBalancerSchemeInternetFacing,
+					VPCID:         "vpc-1",
+					ListenerPorts: []int64{80},
+				}, nil)
+			},
+			wantedErr: errors.New("load balancer " + albARN + " already has a listener on port 80"),
+		},
+		"keeps the listeners of a load balancer that is already imported": {
+			setupMocks: func(m *deployEnvMocks) {
+				env := mockEnv()
+				env.CustomConfig = &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{ID: "vpc-1"},
+					ImportALB: &config.ImportALB{ARN: albARN},
+				}
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(albMft), nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				uploadCustomResources(m)
+				m.lbs.EXPECT().LoadBalancer(albARN).Return(&elbv2.LoadBalancer{
+					ARN:            albARN,
+					Type:           elbv2.LoadBalancerTypeApplication,
+					Scheme:         elbv2.LoadBalancerSchemeInternetFacing,
+					DNSName:        "my-lb-1234.us-west-2.elb.amazonaws.com",
+					VPCID:          "vpc-1",
+					SecurityGroups: []string{"sg-1"},
+					ListenerPorts:  []int64{80, 443},
+				}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Do(func(in *deploy.CreateEnvironmentInput) {
+					require.Equal(t, &config.ImportALB{
+						ARN:              albARN,
+						DNSName:          "my-lb-1234.us-west-2.elb.amazonaws.com",
+						SecurityGroupIDs: []string{"sg-1"},
+					}, in.ImportALB)
+				}).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"updates the stack and stores the configuration of the manifest": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mft), nil)
+				m.version.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+				uploadCustomResources(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					Version: deploy.LatestEnvTemplateVersion,
+					App: deploy.AppInformation{
+						Name: "phonetool",
+					},
+					Name:                "test",
+					AdjustVPCConfig:     wantedConfig.VPCConfig,
+					ImportCertARNs:      wantedConfig.ImportCertARNs,
+					Telemetry:           wantedConfig.Telemetry,
+					CustomResourcesURLs: map[string]string{"EnvControllerFunction": "url"},
+					CFNServiceRoleARN:   "execARN",
+				}).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				env := mockEnv()
+				env.CustomConfig = wantedConfig
+				m.store.EXPECT().UpdateEnvironment(env).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployEnvMocks{
+				store:    mocks.NewMockstore(ctrl),
+				ws:       mocks.NewMockwsEnvironmentReader(ctrl),
+				prog:     mocks.NewMockprogress(ctrl),
+				appCFN:   mocks.NewMockappResourcesGetter(ctrl),
+				uploader: mocks.NewMockcustomResourcesUploader(ctrl),
+				version:  mocks.NewMockversionGetter(ctrl),
+				upgrader: mocks.NewMockenvUpgrader(ctrl),
+				lbs:      mocks.NewMockloadBalancerDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:    m.store,
+				ws:       m.ws,
+				prog:     m.prog,
+				appCFN:   m.appCFN,
+				uploader: m.uploader,
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return m.version, nil
+				},
+				newEnvUpgrader: func(_ *config.Environment) (envUpgrader, error) {
+					return m.upgrader, nil
+				},
+				newLBDescriber: func(_ *config.Environment) (loadBalancerDescriber, error) {
+					return m.lbs, nil
+				},
+				newS3: func(_ string) (zipAndUploader, error) {
+					return mocks.NewMockzipAndUploader(ctrl), nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomizeEnvFromManifest(t *testing.T) {
+	importedVPC := manifest.EnvironmentConfig{
+		Network: manifest.EnvironmentNetworkConfig{
+			VPC: manifest.EnvironmentVPCConfig{
+				ID: aws.String("vpc-1"),
+				Subnets: manifest.SubnetsConfiguration{
+					Public:  []manifest.SubnetConfiguration{{ID: aws.String("subnet-1")}, {ID: aws.String("subnet-2")}},
+					Private: []manifest.SubnetConfiguration{{ID: aws.String("subnet-3")}, {ID: aws.String("subnet-4")}},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		in     manifest.EnvironmentConfig
+		stored *config.CustomizeEnv
+
+		wanted    *config.CustomizeEnv
+		wantedErr error
+	}{
+		"returns nil for the default configuration": {},
+		"keeps the imported VPC": {
+			in: importedVPC,
+			stored: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-1",
+					PublicSubnetIDs:  []string{"subnet-1"},
+					PrivateSubnetIDs: []string{"subnet-3"},
+				},
+			},
+			wanted: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-1",
+					PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				},
+			},
+		},
+		"keeps the stored configuration that the manifest leaves out": {
+			in: manifest.EnvironmentConfig{
+				Observability: manifest.EnvironmentObservability{
+					ContainerInsights: aws.Bool(true),
+				},
+			},
+			stored: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-1",
+					PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				},
+				ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+			},
+			wanted: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-1",
+					PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				},
+				ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+				Telemetry: &config.Telemetry{
+					EnableContainerInsights: true,
+				},
+			},
+		},
+		"errors if the environment imports a different VPC": {
+			in: importedVPC,
+			stored: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{ID: "vpc-2"},
+			},
+			wantedErr: errors.New("cannot import VPC vpc-1 in environment test: the environment uses VPC vpc-2"),
+		},
+		"errors if the environment uses a VPC created by Copilot": {
+			in:        importedVPC,
+			wantedErr: errors.New("cannot import VPC vpc-1 in environment test: the environment uses a VPC created by Copilot"),
+		},
+		"errors if a VPC is created for an environment that imports one": {
+			in: manifest.EnvironmentConfig{
+				Network: manifest.EnvironmentNetworkConfig{
+					VPC: manifest.EnvironmentVPCConfig{
+						NATGateways: aws.Bool(true),
+					},
+				},
+			},
+			stored: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{ID: "vpc-1"},
+			},
+			wantedErr: errors.New("cannot create a VPC for environment test: the environment uses VPC vpc-1"),
+		},
+		"adjusts the subnets of the VPC": {
+			in: manifest.EnvironmentConfig{
+				Network: manifest.EnvironmentNetworkConfig{
+					VPC: manifest.EnvironmentVPCConfig{
+						CIDR: aws.String("10.1.0.0/16"),
+						Subnets: manifest.SubnetsConfiguration{
+							Public:  []manifest.SubnetConfiguration{{CIDR: aws.String("10.1.0.0/24")}},
+							Private: []manifest.SubnetConfiguration{{CIDR: aws.String("10.1.1.0/24")}},
+						},
+					},
+				},
+				Observability: manifest.EnvironmentObservability{
+					ContainerInsights: aws.Bool(false),
+				},
+			},
+			stored: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.2.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.1.0/24", "10.1.3.0/24"},
+				},
+			},
+			wanted: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.1.0/24"},
+				},
+				Telemetry: &config.Telemetry{},
+			},
+		},
+		"keeps the CIDR ranges of the VPC if only NAT gateways are forced": {
+			in: manifest.EnvironmentConfig{
+				Network: manifest.EnvironmentNetworkConfig{
+					VPC: manifest.EnvironmentVPCConfig{
+						NATGateways: aws.Bool(true),
+					},
+				},
+			},
+			stored: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.1.0/24"},
+				},
+			},
+			wanted: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.1.0/24"},
+					ForceNATGateways:   true,
+				},
+			},
+		},
+		"errors if the CIDR range of the VPC changes": {
+			in: manifest.EnvironmentConfig{
+				Network: manifest.EnvironmentNetworkConfig{
+					VPC: manifest.EnvironmentVPCConfig{
+						CIDR: aws.String("10.1.0.0/16"),
+						Subnets: manifest.SubnetsConfiguration{
+							Public:  []manifest.SubnetConfiguration{{CIDR: aws.String("10.1.0.0/24")}},
+							Private: []manifest.SubnetConfiguration{{CIDR: aws.String("10.1.1.0/24")}},
+						},
+					},
+				},
+			},
+			wantedErr: errors.New("cannot change the CIDR range of the VPC of environment test from " + stack.DefaultVPCCIDR + " to 10.1.0.0/16"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := customizeEnvFromManifest(&manifest.Environment{
+				Name:              aws.String("test"),
+				EnvironmentConfig: tc.in,
+			}, tc.stored)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -11,9 +11,11 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
@@ -123,6 +125,9 @@ type initEnvVars struct {
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 
+	importCertARNs []string // Existing ACM certificates to attach to the HTTPS listener of the load balancer.
+	importALBARN   string   // Existing Application Load Balancer to use instead of creating a new one.
+
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
 }
@@ -138,6 +143,7 @@ type initEnvOpts struct {
 	identity     identityService
 	envIdentity  identityService
 	ec2Client    ec2Client
+	elbv2        loadBalancerDescriber
 	iam          roleManager
 	cfn          stackExistChecker
 	prog         progress
@@ -149,7 +155,8 @@ type initEnvOpts struct {
 	newS3        func(string) (zipAndUploader, error)
 	uploader     customResourcesUploader
 
-	sess *session.Session // Session pointing to environment's AWS account and region.
+	sess        *session.Session  // Session pointing to environment's AWS account and region.
+	importedALB *config.ImportALB // Cached configuration of the imported load balancer.
 }
 
 func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
@@ -260,7 +267,16 @@ func (o *initEnvOpts) Execute() error {
 		return err
 	}
 
-	// 3. Upload environment custom resource scripts to the S3 bucket, because of the 4096 characters limit (see
+	// 3. Make sure that the imported load balancer can serve the workloads of the environment.
+	if o.importALBARN != "" {
+		alb, err := importedALBConfig(o.elbv2, o.importALBARN, o.importVPC.ID, true)
+		if err != nil {
+			return err
+		}
+		o.importedALB = alb
+	}
+
+	// 4. Upload environment custom resource scripts to the S3 bucket, because of the 4096 characters limit (see
 	// https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-lambda-function-code.html#cfn-lambda-function-code-zipfile)
 	envRegion := aws.StringValue(o.sess.Config.Region)
 	resources, err := o.appCFN.GetAppResourcesByRegion(app, envRegion)
@@ -278,20 +294,20 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("upload custom resources to bucket %s: %w", resources.S3Bucket, err)
 	}
 
-	// 4. Start creating the CloudFormation stack for the environment.
+	// 5. Start creating the CloudFormation stack for the environment.
 	if err := o.deployEnv(app, urls); err != nil {
 		return err
 	}
 
-	// 5. Get the environment
+	// 6. Get the environment
 	env, err := o.envDeployer.GetEnvironment(o.appName, o.name)
 	if err != nil {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = o.customizeEnvConfig()
 
-	// 6. Store the environment in SSM.
+	// 7. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
 		return fmt.Errorf("store environment: %w", err)
 	}
@@ -319,6 +335,9 @@ func (o *initEnvOpts) initRuntimeClients() {
 	if o.iam == nil {
 		o.iam = iam.New(o.sess)
 	}
+	if o.elbv2 == nil {
+		o.elbv2 = elbv2.New(o.sess)
+	}
 }
 
 func (o *initEnvOpts) validateCustomizedResources() error {
@@ -328,6 +347,14 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	if o.importALBARN != "" && (o.adjustVPC.isSet() || o.defaultConfig) {
+		return fmt.Errorf("cannot import a load balancer without importing its VPC with --%s", vpcIDFlag)
+	}
+	for _, certARN := range o.importCertARNs {
+		if err := validateCertARN(certARN); err != nil {
+			return err
+		}
+	}
 	if o.importVPC.isSet() {
 		// We allow 0 or 2+ public subnets.
 		if len(o.importVPC.PublicSubnetIDs) == 1 {
@@ -412,7 +439,7 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	if o.defaultConfig {
 		return nil
 	}
-	if o.importVPC.isSet() {
+	if o.importVPC.isSet() || o.importALBARN != "" {
 		return o.askImportResources()
 	}
 	if o.adjustVPC.isSet() {
@@ -555,6 +582,60 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	}
 }
 
+func (o *initEnvOpts) customizeEnvConfig() *config.CustomizeEnv {
+	conf := config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	if len(o.importCertARNs) == 0 && o.importedALB == nil {
+		return conf
+	}
+	if conf == nil {
+		conf = &config.CustomizeEnv{}
+	}
+	conf.ImportCertARNs = o.importCertARNs
+	conf.ImportALB = o.importedALB
+	return conf
+}
+
+// importedALBConfig describes an existing load balancer and returns its configuration
+// if it can serve the workloads of an environment in the VPC.
+// If checkListeners is true, the ports of the listeners created by Copilot must be free.
+func importedALBConfig(lbs loadBalancerDescriber, arn, vpcID string, checkListeners bool) (*config.ImportALB, error) {
+	lb, err := lbs.LoadBalancer(arn)
+	if err != nil {
+		return nil, fmt.Errorf("get load balancer %s: %w", arn, err)
+	}
+	if lb.Type != elbv2.LoadBalancerTypeApplication {
+		return nil, fmt.Errorf("load balancer %s must be an Application Load Balancer", arn)
+	}
+	if lb.Scheme != elbv2.LoadBalancerSchemeInternetFacing {
+		return nil, fmt.Errorf("load balancer %s must be internet-facing", arn)
+	}
+	if lb.VPCID != vpcID {
+		return nil, fmt.Errorf("load balancer %s is in VPC %s instead of the VPC %s of the environment", arn, lb.VPCID, vpcID)
+	}
+	if checkListeners {
+		for _, port := range lb.ListenerPorts {
+			if port == 80 || port == 443 {
+				return nil, fmt.Errorf("load balancer %s already has a listener on port %d", arn, port)
+			}
+		}
+	}
+	return &config.ImportALB{
+		ARN:              lb.ARN,
+		DNSName:          lb.DNSName,
+		HostedZoneID:     lb.HostedZoneID,
+		SecurityGroupIDs: lb.SecurityGroups,
+	}, nil
+}
+
+// validateCertARN returns an error if the ARN is not the ARN of an ACM certificate.
+func validateCertARN(certARN string) error {
+	parsed, err := arn.Parse(certARN)
+	if err != nil || parsed.Service != "acm" {
+		return fmt.Errorf("%s is not the ARN of an ACM certificate", certARN)
+	}
+	return nil
+}
+
 func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map[string]string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
@@ -572,6 +653,8 @@ func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map
 		CustomResourcesURLs: customResourcesURLs,
 		AdjustVPCConfig:     o.adjustVPCConfig(),
 		ImportVPCConfig:     o.importVPCConfig(),
+		ImportCertARNs:      o.importCertARNs,
+		ImportALB:           o.importedALB,
 		Version:             deploy.LatestEnvTemplateVersion,
 	}
 
@@ -688,6 +771,9 @@ func buildEnvInitCmd() *cobra.Command {
   /code --import-public-subnets subnet-013e8b691862966cf,subnet -014661ebb7ab8681a \
   /code --import-private-subnets subnet-055fafef48fb3c547,subnet-00c9e76f288363e7f
 
+  Creates an environment that serves HTTPS traffic with an existing certificate.
+  /code $ copilot env init --import-cert-arns arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012
+
   Creates an environment with overridden CIDRs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
@@ -713,6 +799,8 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importCertARNs, certARNsFlag, nil, certARNsFlagDescription)
+	cmd.Flags().StringVar(&vars.importALBARN, albARNFlag, "", albARNFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
@@ -735,6 +823,8 @@ func buildEnvInitCmd() *cobra.Command {
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(certARNsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(albARNFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -44,6 +45,8 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inPrivateIDs  []string
 		inVPCCIDR     net.IPNet
 		inPublicCIDRs []string
+		inCertARNs    []string
+		inALBARN      string
 
 		inProfileName     string
 		inAccessKeyID     string
@@ -130,6 +133,24 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			inPublicIDs:  []string{"mockID", "anotherMockID", "yetAnotherMockID"},
 			inPrivateIDs: []string{"mockID", "anotherMockID"},
 		},
+		"should err if a certificate is not an ACM certificate": {
+			inCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc", "arn:aws:iam::123456789012:server-certificate/abc"},
+
+			wantedErrMsg: "arn:aws:iam::123456789012:server-certificate/abc is not the ARN of an ACM certificate",
+		},
+		"should err if a load balancer is imported without its VPC": {
+			inDefault: true,
+			inALBARN:  "mockALBARN",
+
+			wantedErrMsg: "cannot import a load balancer without importing its VPC with --import-vpc-id",
+		},
+		"valid certificate and load balancer import": {
+			inVPCID:      "mockID",
+			inPublicIDs:  []string{"mockID", "anotherMockID"},
+			inPrivateIDs: []string{"mockID", "anotherMockID"},
+			inCertARNs:   []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+			inALBARN:     "mockALBARN",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -148,8 +169,10 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						PrivateSubnetIDs: tc.inPrivateIDs,
 						ID:               tc.inVPCID,
 					},
-					appName: tc.inAppName,
-					profile: tc.inProfileName,
+					importCertARNs: tc.inCertARNs,
+					importALBARN:   tc.inALBARN,
+					appName:        tc.inAppName,
+					profile:        tc.inProfileName,
 					tempCreds: tempCredsVars{
 						AccessKeyID:     tc.inAccessKeyID,
 						SecretAccessKey: tc.inSecretAccessKey,
@@ -1145,3 +1168,103 @@ func TestInitEnvOpts_delegateDNSFromApp(t *testing.T) {
 		})
 	}
 }
+
+func TestImportedALBConfig(t *testing.T) {
+	const mockARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	mockLB := func() *elbv2.LoadBalancer {
+		return &elbv2.LoadBalancer{
+			ARN:            mockARN,
+			Type:           elbv2.LoadBalancerTypeApplication,
+			Scheme:         elbv2.LoadBalancerSchemeInternetFacing,
+			DNSName:        "my-lb-1234.us-west-2.elb.amazonaws.com",
+			HostedZoneID:   "Z1H1FL5HABSF5",
+			VPCID:          "vpc-1",
+			SecurityGroups: []string{"sg-1"},
+			ListenerPorts:  []int64{8080},
+		}
+	}
+	testCases := map[string]struct {
+		inCheckListeners bool
+		mockLB           func(lb *elbv2.LoadBalancer)
+		mockErr          error
+
+		wanted    *config.ImportALB
+		wantedErr string
+	}{
+		"wraps the error of the describer": {
+			mockErr:   errors.New("some error"),
+			wantedErr: "get load balancer " + mockARN + ": some error",
+		},
+		"errors if the load balancer is a Network Load Balancer": {
+			mockLB: func(lb *elbv2.LoadBalancer) {
+				lb.Type = "network"
+			},
+			wantedErr: "load balancer " + mockARN + " must be an Application Load Balancer",
+		},
+		"errors if the load balancer is internal": {
+			mockLB: func(lb *elbv2.LoadBalancer) {
+				lb.Scheme = "internal"
+			},
+			wantedErr: "load balancer " + mockARN + " must be internet-facing",
+		},
+		"errors if the load balancer is in another VPC": {
+			mockLB: func(lb *elbv2.LoadBalancer) {
+				lb.VPCID = "vpc-2"
+			},
+			wantedErr: "load balancer " + mockARN + " is in VPC vpc-2 instead of the VPC vpc-1 of the environment",
+		},
+		"errors if the port of a listener is taken": {
+			inCheckListeners: true,
+			mockLB: func(lb *elbv2.LoadBalancer) {
+				lb.ListenerPorts = []int64{8080, 443}
+			},
+			wantedErr: "load balancer " + mockARN + " already has a listener on port 443",
+		},
+		"ignores the listeners if they are not checked": {
+			mockLB: func(lb *elbv2.LoadBalancer) {
+				lb.ListenerPorts = []int64{80, 443}
+			},
+			wanted: &config.ImportALB{
+				ARN:              mockARN,
+				DNSName:          "my-lb-1234.us-west-2.elb.amazonaws.com",
+				HostedZoneID:     "Z1H1FL5HABSF5",
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+		},
+		"returns the configuration of the load balancer": {
+			inCheckListeners: true,
+			wanted: &config.ImportALB{
+				ARN:              mockARN,
+				DNSName:          "my-lb-1234.us-west-2.elb.amazonaws.com",
+				HostedZoneID:     "Z1H1FL5HABSF5",
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockloadBalancerDescriber(ctrl)
+			if tc.mockErr != nil {
+				m.EXPECT().LoadBalancer(mockARN).Return(nil, tc.mockErr)
+			} else {
+				lb := mockLB()
+				if tc.mockLB != nil {
+					tc.mockLB(lb)
+				}
+				m.EXPECT().LoadBalancer(mockARN).Return(lb, nil)
+			}
+
+			got, err := importedALBConfig(m, mockARN, "vpc-1", tc.inCheckListeners)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -268,24 +268,24 @@ Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplate
 
 func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.Environment,
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
-	var importedVPC *config.ImportVPC
-	var adjustedVPC *config.AdjustVPC
-	if conf.CustomConfig != nil {
-		importedVPC = conf.CustomConfig.ImportVPC
-		adjustedVPC = conf.CustomConfig.VPCConfig
-	}
-
-	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+	in := &deploy.CreateEnvironmentInput{
 		Version: toVersion,
 		App: deploy.AppInformation{
 			Name: conf.App,
 		},
 		Name:                conf.Name,
 		CustomResourcesURLs: customResourcesURLs,
-		ImportVPCConfig:     importedVPC,
-		AdjustVPCConfig:     adjustedVPC,
 		CFNServiceRoleARN:   conf.ExecutionRoleARN,
-	}); err != nil {
+	}
+	if conf.CustomConfig != nil {
+		in.ImportVPCConfig = conf.CustomConfig.ImportVPC
+		in.AdjustVPCConfig = conf.CustomConfig.VPCConfig
+		in.ImportCertARNs = conf.CustomConfig.ImportCertARNs
+		in.ImportALB = conf.CustomConfig.ImportALB
+		in.Telemetry = conf.CustomConfig.Telemetry
+	}
+
+	if err := upgrader.UpgradeEnvironment(in); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 	}
 	return nil
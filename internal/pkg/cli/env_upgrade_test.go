@@ -266,6 +266,10 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 							ImportVPC: &config.ImportVPC{
 								ID: "abc",
 							},
+							ImportCertARNs: []string{"mockCertARN"},
+							Telemetry: &config.Telemetry{
+								EnableContainerInsights: true,
+							},
 						},
 					}, nil)
 				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
@@ -287,6 +291,10 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 					ImportVPCConfig: &config.ImportVPC{
 						ID: "abc",
 					},
+					ImportCertARNs: []string{"mockCertARN"},
+					Telemetry: &config.Telemetry{
+						EnableContainerInsights: true,
+					},
 					CFNServiceRoleARN:   "execARN",
 					CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
 				}).Return(nil)
@@ -51,6 +51,7 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	formatFlag            = "format"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
@@ -85,6 +86,8 @@ const (
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
 	privateSubnetsFlag = "import-private-subnets"
+	certARNsFlag       = "import-cert-arns"
+	albARNFlag         = "import-alb-arn"
 
 	vpcCIDRFlag            = "override-vpc-cidr"
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
@@ -109,6 +112,16 @@ const (
 	inputFilePathFlag = "cli-input-yaml"
 
 	includeStateMachineLogsFlag = "include-state-machine"
+
+	secretsFileFlag = "secrets-file"
+
+	diffFlag   = "diff"
+	dryRunFlag = "dry-run"
+
+	fromFlag = "from"
+	toFlag   = "to"
+
+	stageFlag = "stage"
 )
 
 // Short flag names.
@@ -171,7 +184,9 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
-Supported providers are: %s`, strings.Join(manifest.PipelineProviders, ", "))
+Supported providers are: %s
+For ECR, the URI of the repository of a service or job, optionally followed by the image tag.
+For S3, the URI of the object, such as s3://my-bucket/workspace.zip.`, strings.Join(manifest.PipelineProviders, ", "))
 )
 
 const (
@@ -261,6 +276,9 @@ Cannot be specified with any other flags.`
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
+	certARNsFlagDescription       = "Optional. Attach existing ACM certificates to the HTTPS listener of the load balancer."
+	albARNFlagDescription         = `Optional. Use an existing internet-facing Application Load Balancer.
+Requires --import-vpc-id, and the load balancer must have no listener on port 80 or 443.`
 
 	vpcCIDRFlagDescription            = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
@@ -283,10 +301,37 @@ AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021
 are also accepted.`
 
 	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
+	deployAllFlagDescription  = "Optional. Deploy all services and jobs in the workspace."
+
+	appGraphEnvFlagDescription    = "Optional. Name of the environment whose overrides and service discovery endpoints are used."
+	appGraphFormatFlagDescription = `Optional. Format of the graph. Must be one of "text", "dot", "mermaid", or "json".`
+
+	pipelineBuildTagFlagDescription = `Optional. The container image tag.
+Defaults to the ID of the CodeBuild build, or the git commit when run outside of CodeBuild.`
+	pipelineHistoryLimitFlagDescription = "Optional. The maximum number of executions to show, up to 100. Defaults to 10."
+	pipelineStageFlagDescription        = "Name of the stage to retry."
 
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
+	jobRunCommandFlagDescription = `Optional. The command that overrides the default command of the job's container for this run.`
+	jobRunEnvVarsFlagDescription = "Optional. Environment variables specified by key=value separated by commas that are added to the job's container for this run."
+
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+
+	localRunFlagDescription    = "Run the service on your local machine with Docker."
+	secretsFileFlagDescription = `Optional. A YAML file of secret names to stub values.
+By default secrets are retrieved from SSM Parameter Store or AWS Secrets Manager.`
+
+	diffFlagDescription   = "Optional. Preview the infrastructure changes and confirm them before deploying."
+	dryRunFlagDescription = `Optional. Preview the infrastructure changes without deploying them.
+The image is not built or pushed, and the environment and addons are not updated.`
+
+	svcHistoryLimitFlagDescription = "Optional. The maximum number of deployments to show. Defaults to 10."
+	svcRollbackToFlagDescription   = `Optional. The revision to roll back to, as listed by "svc history".
+Defaults to selecting a previous deployment.`
+
+	fromStoreFlagDescription = "Config store backend to copy the application from. Must be one of ssm or local."
+	toStoreFlagDescription   = "Config store backend to copy the application to. Must be one of ssm or local."
 )
@@ -167,6 +167,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		sel:            sel,
 		spinner:        spin,
 		cmd:            exec.NewCmd(),
+		dockerEngine:   dockerengine.New(exec.NewCmd()),
 		sessProvider:   sessProvider,
 		snsTopicGetter: deployStore,
 
@@ -180,14 +181,16 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 			imageTag: vars.imageTag,
 			appName:  vars.appName,
 		},
-		store:        ssm,
-		prompt:       prompt,
-		ws:           ws,
-		unmarshal:    manifest.UnmarshalWorkload,
-		sel:          sel,
-		spinner:      spin,
-		cmd:          exec.NewCmd(),
-		sessProvider: sessProvider,
+		store:          ssm,
+		prompt:         prompt,
+		ws:             ws,
+		unmarshal:      manifest.UnmarshalWorkload,
+		sel:            sel,
+		spinner:        spin,
+		cmd:            exec.NewCmd(),
+		dockerEngine:   dockerengine.New(exec.NewCmd()),
+		sessProvider:   sessProvider,
+		snsTopicGetter: deployStore,
 	}
 	fs := &afero.Afero{Fs: afero.NewOsFs()}
 	cmd := exec.NewCmd()
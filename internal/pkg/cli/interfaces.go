@@ -14,8 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -108,6 +111,7 @@ type environmentStore interface {
 	environmentCreator
 	environmentGetter
 	environmentLister
+	environmentUpdater
 	environmentDeleter
 }
 
@@ -123,6 +127,10 @@ type environmentLister interface {
 	ListEnvironments(appName string) ([]*config.Environment, error)
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentDeleter interface {
 	DeleteEnvironment(appName, environmentName string) error
 }
@@ -142,6 +150,11 @@ type deployedEnvironmentLister interface {
 	ListSNSTopics(appName string, envName string) ([]deploy.Topic, error)
 }
 
+type deployedWorkloadsLister interface {
+	ListDeployedServices(appName, envName string) ([]string, error)
+	ListDeployedJobs(appName, envName string) ([]string, error)
+}
+
 // Secretsmanager interface.
 
 type secretsManager interface {
@@ -161,6 +174,14 @@ type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error)
 }
 
+type imageScanner interface {
+	ScanImage(repoName, digest string) (*ecr.ImageScanFindings, error)
+}
+
+type imageSigner interface {
+	Sign(image, signingProfileARN string) error
+}
+
 type repositoryURIGetter interface {
 	URI() string
 }
@@ -170,6 +191,12 @@ type repositoryService interface {
 	imageBuilderPusher
 }
 
+type registryLoginer interface {
+	repositoryURIGetter
+	Login(docker repository.ContainerLoginBuildPusher) error
+	SkipLogin()
+}
+
 type logEventsWriter interface {
 	WriteLogEvents(opts logging.WriteLogEventsOpts) error
 }
@@ -191,6 +218,16 @@ type eventsWriter interface {
 	WriteEventsUntilStopped() error
 }
 
+type stateMachineGetter interface {
+	StateMachineARN(app, env, job string) (string, error)
+}
+
+type stateMachineExecutor interface {
+	StartExecution(stateMachineARN, input string) (string, error)
+	Execution(executionARN string) (*stepfunctions.Execution, error)
+	ExecutionTasks(executionARN string) ([]*stepfunctions.ExecutionTask, error)
+}
+
 type defaultSessionProvider interface {
 	Default() (*session.Session, error)
 }
@@ -248,6 +285,10 @@ type wsPipelineWriter interface {
 	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
 }
 
+type wsEnvironmentReader interface {
+	ReadEnvironmentManifest(name string) ([]byte, error)
+}
+
 type wsServiceLister interface {
 	ServiceNames() ([]string, error)
 }
@@ -289,6 +330,11 @@ type wsWlDirReader interface {
 	Summary() (*workspace.Summary, error)
 }
 
+type wsPipelineBuildReader interface {
+	wsPipelineManifestReader
+	wsWlDirReader
+}
+
 type wsPipelineReader interface {
 	wsPipelineManifestReader
 	WorkloadNames() ([]string, error)
@@ -413,6 +459,20 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type serviceRevisionDescriber interface {
+	Revisions() ([]describe.ServiceRevision, error)
+	Revision(revision int) (*describe.ServiceRevision, error)
+}
+
+type serviceRevisionStore interface {
+	PutObject(bucket, key string, data io.Reader) (string, error)
+	GetObject(bucket, key string) ([]byte, error)
+}
+
+type taskDefRevisionGetter interface {
+	ServiceTaskDefinitionRevision(app, env, svc string) (int, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
@@ -453,6 +513,14 @@ type pipelineGetter interface {
 	GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error)
 }
 
+type pipelineExecutionStarter interface {
+	StartPipelineExecution(pipelineName string) (string, error)
+}
+
+type pipelineStageRetrier interface {
+	RetryStageExecution(pipelineName, stageName string) error
+}
+
 type executor interface {
 	Execute() error
 }
@@ -486,6 +554,11 @@ type pipelineSelector interface {
 	Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error)
 }
 
+type deployedPipelineSelector interface {
+	appSelector
+	DeployedPipeline(prompt, help, app string) (string, error)
+}
+
 type wsSelector interface {
 	appEnvSelector
 	Service(prompt, help string) (string, error)
@@ -524,6 +597,10 @@ type ec2Client interface {
 	HasDNSSupport(vpcID string) (bool, error)
 }
 
+type loadBalancerDescriber interface {
+	LoadBalancer(arn string) (*elbv2.LoadBalancer, error)
+}
+
 type serviceResumer interface {
 	ResumeService(string) error
 }
@@ -548,8 +625,24 @@ type serviceUpdater interface {
 	ForceUpdateService(app, env, svc string) error
 }
 
+type serviceRollbacker interface {
+	DeployService(out termprogress.FileWriter, conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
+}
+
 type serviceDeployer interface {
 	DeployService(out termprogress.FileWriter, conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
+	DiffService(out termprogress.FileWriter, conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) (*cloudformation.StackDiff, error)
+	ExecuteStackDiff(out termprogress.FileWriter, diff *cloudformation.StackDiff) error
+	DiscardStackDiff(diff *cloudformation.StackDiff) error
+	PreviewService(out termprogress.FileWriter, conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) (*cloudformation.StackDiff, error)
+}
+
+type trafficWeightsGetter interface {
+	TrafficWeights(stackName string) (*stack.TrafficWeights, error)
+}
+
+type stoppedTasksGetter interface {
+	StoppedServiceTasks(cluster, service string) ([]*awsecs.Task, error)
 }
 
 type apprunnerServiceDescriber interface {
@@ -598,6 +691,15 @@ type dockerEngine interface {
 	RedirectPlatform(string) (*string, error)
 }
 
+type containerRunner interface {
+	CheckDockerEngineRunning() error
+	Build(args *dockerengine.BuildArguments) error
+	Run(options *dockerengine.RunOptions) error
+	Logs(containerName string, w io.Writer) error
+	Wait(containerName string) (int, error)
+	Remove(containerNames ...string) error
+}
+
 type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
@@ -614,6 +716,10 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretValueGetter interface {
+	GetSecretValue(name string) (string, error)
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
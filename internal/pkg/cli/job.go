@@ -25,6 +25,9 @@ Jobs are tasks that are triggered by events.`,
 	cmd.AddCommand(buildJobDeployCmd())
 	cmd.AddCommand(buildJobDeleteCmd())
 	cmd.AddCommand(buildJobLogsCmd())
+	cmd.AddCommand(buildJobRunCmd())
+	cmd.AddCommand(buildJobStatusCmd())
+	cmd.AddCommand(buildJobValidateCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -45,12 +46,18 @@ type deployJobOpts struct {
 	cmd                runner
 	addons             templater
 	appCFN             appResourcesGetter
-	jobCFN             cloudformation.CloudFormation
+	jobCFN             serviceDeployer
 	imageBuilderPusher imageBuilderPusher
+	registryLogin      registryLoginer
+	imageScanner       imageScanner
+	imageSigner        imageSigner
+	dockerEngine       repository.ContainerLoginBuildPusher
 	sessProvider       sessionProvider
 	s3                 artifactUploader
 	envUpgradeCmd      actionCommand
+	envVersionGetter   versionGetter
 	endpointGetter     endpointGetter
+	snsTopicGetter     deployedEnvironmentLister
 
 	spinner progress
 	sel     wsSelector
@@ -61,6 +68,7 @@ type deployJobOpts struct {
 	targetJob         *config.Workload
 	imageDigest       string
 	buildRequired     bool
+	addonsSkipped     bool
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -68,6 +76,10 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 
 	ws, err := workspace.New()
 	if err != nil {
@@ -80,14 +92,16 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 	return &deployJobOpts{
 		deployWkldVars: vars,
 
-		store:        store,
-		ws:           ws,
-		unmarshal:    manifest.UnmarshalWorkload,
-		spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
-		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
-		prompt:       prompter,
-		cmd:          exec.NewCmd(),
-		sessProvider: sessions.NewProvider(),
+		store:          store,
+		ws:             ws,
+		unmarshal:      manifest.UnmarshalWorkload,
+		spinner:        termprogress.NewSpinner(log.DiagnosticWriter),
+		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:         prompter,
+		cmd:            exec.NewCmd(),
+		dockerEngine:   dockerengine.New(exec.NewCmd()),
+		sessProvider:   sessions.NewProvider(),
+		snsTopicGetter: deployStore,
 	}, nil
 }
 
@@ -122,6 +136,30 @@ func (o *deployJobOpts) Ask() error {
 
 // Execute builds and pushes the container image for the job.
 func (o *deployJobOpts) Execute() error {
+	if err := o.prepare(); err != nil {
+		return err
+	}
+	if err := o.configureContainerImage(); err != nil {
+		return err
+	}
+	if err := o.scanImage(); err != nil {
+		return err
+	}
+	if err := o.signImage(); err != nil {
+		return err
+	}
+	if err := o.deployWorkload(os.Stderr); err != nil {
+		return err
+	}
+	if o.dryRun {
+		return nil
+	}
+	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// prepare retrieves the configuration of the app, environment and job, and upgrades the environment if needed.
+func (o *deployJobOpts) prepare() error {
 	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
@@ -144,21 +182,48 @@ func (o *deployJobOpts) Execute() error {
 	if err := o.configureClients(); err != nil {
 		return err
 	}
+	return o.upgradeEnv()
+}
 
+// upgradeEnv upgrades the environment to the latest version if needed.
+// A dry run leaves the environment untouched and only warns if it would be upgraded.
+func (o *deployJobOpts) upgradeEnv() error {
+	if o.dryRun {
+		return warnEnvUpgradeSkipped(o.envVersionGetter, o.envName)
+	}
 	if err := o.envUpgradeCmd.Execute(); err != nil {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
+	return nil
+}
 
-	if err := o.configureContainerImage(); err != nil {
+// loginToRegistry logs in to the registry that the image of the job is pushed to if it's built,
+// unless the registry is in loggedIn.
+func (o *deployJobOpts) loginToRegistry(loggedIn map[string]bool) error {
+	job, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	required, err := manifest.JobDockerfileBuildRequired(job)
+	if err != nil || !required {
 		return err
 	}
+	return loginToRegistry(o.registryLogin, loggedIn)
+}
 
+// buildImage builds and pushes the container image, and writes the output of docker to out.
+func (o *deployJobOpts) buildImage(out io.Writer) error {
+	o.dockerEngine = dockerengine.NewWithOutput(exec.NewCmd(), out)
+	return o.configureContainerImage()
+}
+
+// deployWorkload uploads the addons of the job and deploys the job stack, the progress of the stack is written to out.
+func (o *deployJobOpts) deployWorkload(out termprogress.FileWriter) error {
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
 		return err
 	}
-
-	return o.deployJob(addonsURL)
+	return o.deployJob(addonsURL, out)
 }
 
 // pushAddonsTemplateToS3Bucket generates the addons template for the job and pushes it to S3.
@@ -174,6 +239,11 @@ func (o *deployJobOpts) pushAddonsTemplateToS3Bucket() (string, error) {
 		}
 		return "", fmt.Errorf("retrieve addons template: %w", err)
 	}
+	if o.dryRun {
+		log.Infof(fmtDryRunAddonsSkipped, color.HighlightUserInput(o.name))
+		o.addonsSkipped = true
+		return "", nil
+	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
 	if err != nil {
 		return "", fmt.Errorf("get app resources: %w", err)
@@ -201,16 +271,20 @@ func (o *deployJobOpts) configureClients() error {
 	// ECR client against tools account profile AND target environment region
 	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
 	registry := ecr.New(defaultSessEnvRegion)
-	o.imageBuilderPusher, err = repository.New(repoName, registry)
+	repo, err := repository.New(repoName, registry)
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.imageBuilderPusher = repo
+	o.registryLogin = repo
+	o.imageScanner = registry
+	o.imageSigner = exec.NewNotationCommand()
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -218,6 +292,8 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate environment describer: %w", err)
 	}
+	o.endpointGetter = envDescriber
+	o.envVersionGetter = envDescriber
 
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
@@ -255,12 +331,17 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if !required {
 		return nil
 	}
+	if o.dryRun {
+		logDryRunImageSkipped(o.name, o.imageTag)
+		o.buildRequired = true
+		return nil
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArg, err := o.dfBuildArgs(job)
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	digest, err := o.imageBuilderPusher.BuildAndPush(o.dockerEngine, buildArg)
 	if err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
@@ -277,15 +358,33 @@ func (o *deployJobOpts) dfBuildArgs(job interface{}) (*dockerengine.BuildArgumen
 	return buildArgs(o.name, o.imageTag, copilotDir, job)
 }
 
-func (o *deployJobOpts) deployJob(addonsURL string) error {
+func (o *deployJobOpts) deployJob(addonsURL string, out termprogress.FileWriter) error {
 	conf, err := o.stackConfiguration(addonsURL)
 	if err != nil {
 		return err
 	}
-	if err := o.jobCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	if o.showDiff || o.dryRun {
+		err = deployStackDiff(deployStackDiffInput{
+			deployer: o.jobCFN,
+			prompt:   o.prompt,
+			conf:     conf,
+			roleARN:  o.targetEnvironment.ExecutionRoleARN,
+			wkldName: o.name,
+			envName:  o.envName,
+			dryRun:   o.dryRun,
+
+			keepDeployedAddons: o.addonsSkipped,
+		})
+	} else {
+		err = o.jobCFN.DeployService(out, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	}
+	if err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if o.dryRun && errors.As(err, &errEmptyCS) {
+			return nil
+		}
 		return fmt.Errorf("deploy job: %w", err)
 	}
-	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
 	return nil
 }
 
@@ -301,6 +400,9 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.ScheduledJob:
+		if err = o.validateSubscriptions(t.Subscriptions()); err != nil {
+			return nil, err
+		}
 		conf, err = stack.NewScheduledJob(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
@@ -311,6 +413,22 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	return conf, nil
 }
 
+// validateSubscriptions returns an error if a job is triggered by a topic that is not published in the environment.
+func (o *deployJobOpts) validateSubscriptions(subscriptions []manifest.TopicSubscription) error {
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	topics, err := o.snsTopicGetter.ListSNSTopics(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get SNS topics for app %s and environment %s: %w", o.appName, o.envName, err)
+	}
+	var topicARNs []string
+	for _, topic := range topics {
+		topicARNs = append(topicARNs, topic.ARN())
+	}
+	return validateTopicsExist(subscriptions, topicARNs, o.appName, o.envName)
+}
+
 func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
 	endpoint, err := o.endpointGetter.ServiceDiscoveryEndpoint()
 	if err != nil {
@@ -351,6 +469,38 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	}, nil
 }
 
+// scanImage scans the image pushed to the ECR repository if the manifest has an "image.scan" section.
+func (o *deployJobOpts) scanImage() error {
+	if !o.buildRequired || o.dryRun {
+		return nil
+	}
+	job, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	mft, ok := job.(imageScanConfigurer)
+	if !ok {
+		return nil
+	}
+	return scanWorkloadImage(o.imageScanner, o.spinner, mft.ImageScan(), o.appName, o.name, o.imageDigest)
+}
+
+// signImage signs the image pushed to the ECR repository if the manifest has an "image.sign" section.
+func (o *deployJobOpts) signImage() error {
+	if !o.buildRequired || o.dryRun {
+		return nil
+	}
+	job, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	mft, ok := job.(imageSigningConfigurer)
+	if !ok {
+		return nil
+	}
+	return signWorkloadImage(o.imageSigner, o.spinner, mft.ImageSigning(), o.registryLogin, o.name, o.imageDigest)
+}
+
 func (o *deployJobOpts) manifest() (interface{}, error) {
 	raw, err := o.ws.ReadJobManifest(o.name)
 	if err != nil {
@@ -369,6 +519,12 @@ func (o *deployJobOpts) manifest() (interface{}, error) {
 
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployJobOpts) RecommendActions() error {
+	if o.dryRun {
+		logRecommendedActions([]string{
+			fmt.Sprintf("Run %s to deploy the changes.",
+				color.HighlightCode(fmt.Sprintf("copilot job deploy --name %s --env %s", o.name, o.envName))),
+		})
+	}
 	return nil
 }
 
@@ -429,7 +585,9 @@ func buildJobDeployCmd() *cobra.Command {
   Deploys a job named "report-gen" to a "test" environment.
   /code $ copilot job deploy --name report-gen --env test
   Deploys a job with additional resource tags.
-  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Previews the infrastructure changes to a job and confirms them before deploying.
+  /code $ copilot job deploy --name report-gen --env test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobDeployOpts(vars)
 			if err != nil {
@@ -443,6 +601,8 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, dryRunFlagDescription)
 
 	return cmd
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -342,3 +343,128 @@ image:
 		})
 	}
 }
+
+func TestJobDeployOpts_dryRun(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ws := mocks.NewMockwsJobDirReader(ctrl)
+	ws.EXPECT().ReadJobManifest("mailer").Return([]byte(`name: mailer
+type: Scheduled Job
+on:
+  schedule: "@daily"
+image:
+  build: ./Dockerfile
+  scan:
+    fail_on: high
+  sign:
+    profile: arn:aws:signer:us-west-2:123456789012:/signing-profiles/phonetool`), nil).AnyTimes()
+	envUpgrade := mocks.NewMockactionCommand(ctrl)
+	envUpgrade.EXPECT().Execute().Times(0)
+	versions := mocks.NewMockversionGetter(ctrl)
+	versions.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+	builder := mocks.NewMockimageBuilderPusher(ctrl)
+	builder.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+	scanner := mocks.NewMockimageScanner(ctrl)
+	scanner.EXPECT().ScanImage(gomock.Any(), gomock.Any()).Times(0)
+	signer := mocks.NewMockimageSigner(ctrl)
+	signer.EXPECT().Sign(gomock.Any(), gomock.Any()).Times(0)
+	addons := mocks.NewMocktemplater(ctrl)
+	addons.EXPECT().Template().Return("some data", nil)
+	uploader := mocks.NewMockartifactUploader(ctrl)
+	uploader.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	opts := deployJobOpts{
+		deployWkldVars: deployWkldVars{
+			name:    "mailer",
+			envName: "test",
+			dryRun:  true,
+		},
+		ws:                 ws,
+		unmarshal:          manifest.UnmarshalWorkload,
+		envUpgradeCmd:      envUpgrade,
+		envVersionGetter:   versions,
+		imageBuilderPusher: builder,
+		imageScanner:       scanner,
+		imageSigner:        signer,
+		addons:             addons,
+		s3:                 uploader,
+	}
+
+	// WHEN
+	require.NoError(t, opts.upgradeEnv())
+	require.NoError(t, opts.configureContainerImage())
+	require.NoError(t, opts.scanImage())
+	require.NoError(t, opts.signImage())
+	addonsURL, err := opts.pushAddonsTemplateToS3Bucket()
+
+	// THEN
+	require.NoError(t, err)
+	require.Empty(t, addonsURL)
+	require.True(t, opts.buildRequired, "expected the changes to be previewed with the image of the ECR repository")
+	require.True(t, opts.addonsSkipped, "expected the deployed addons template to be kept")
+}
+
+func TestJobDeployOpts_validateSubscriptions(t *testing.T) {
+	const (
+		mockAppName = "mockApp"
+		mockEnvName = "mockEnv"
+	)
+	topic, _ := deploy.NewTopic("arn:aws:sns:us-west-2:0123456789012:mockApp-mockEnv-api-orders", mockAppName, mockEnvName, "api")
+	testCases := map[string]struct {
+		inSubscriptions []manifest.TopicSubscription
+		mockDeployStore func(m *mocks.MockdeployedEnvironmentLister)
+
+		wantedError error
+	}{
+		"skips listing topics when the job has no subscriptions": {
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+		},
+		"error if fail to list topics": {
+			inSubscriptions: []manifest.TopicSubscription{{Name: "orders", Service: "api"}},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get SNS topics for app mockApp and environment mockEnv: some error"),
+		},
+		"error if a topic is not published in the environment": {
+			inSubscriptions: []manifest.TopicSubscription{{Name: "payments", Service: "api"}},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return([]deploy.Topic{*topic}, nil)
+			},
+			wantedError: errors.New("SNS topic mockApp-mockEnv-api-payments does not exist in environment mockEnv"),
+		},
+		"success": {
+			inSubscriptions: []manifest.TopicSubscription{{Name: "orders", Service: "api"}},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return([]deploy.Topic{*topic}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			tc.mockDeployStore(mockDeployStore)
+			opts := deployJobOpts{
+				deployWkldVars: deployWkldVars{
+					appName: mockAppName,
+					envName: mockEnvName,
+				},
+				snsTopicGetter: mockDeployStore,
+			}
+
+			// WHEN
+			err := opts.validateSubscriptions(tc.inSubscriptions)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -50,6 +51,10 @@ type packageJobOpts struct {
 	// Subcommand implementing svc_package's Execute()
 	packageCmd    actionCommand
 	newPackageCmd func(*packageJobOpts)
+
+	// Set by "pipeline build" after it uploaded the addons and pushed the image of the job.
+	addonsURL   string
+	imageDigest string
 }
 
 func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
@@ -61,6 +66,10 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 	p := sessions.NewProvider()
 	sess, err := p.Default()
 	if err != nil {
@@ -79,6 +88,19 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 	opts.stackSerializer = func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
 		var serializer stackSerializer
 		jobMft := mft.(*manifest.ScheduledJob)
+		if subscriptions := jobMft.Subscriptions(); len(subscriptions) > 0 {
+			topics, err := deployStore.ListSNSTopics(app.Name, env.Name)
+			if err != nil {
+				return nil, fmt.Errorf("get SNS topics for app %s and environment %s: %w", app.Name, env.Name, err)
+			}
+			var topicARNs []string
+			for _, topic := range topics {
+				topicARNs = append(topicARNs, topic.ARN())
+			}
+			if err := validateTopicsExist(subscriptions, topicARNs, app.Name, env.Name); err != nil {
+				return nil, err
+			}
+		}
 		serializer, err := stack.NewScheduledJob(jobMft, env.Name, app.Name, rc)
 		if err != nil {
 			return nil, fmt.Errorf("init scheduled job stack serializer: %w", err)
@@ -105,6 +127,8 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			addonsWriter:     ioutil.Discard,
 			fs:               &afero.Afero{Fs: afero.NewOsFs()},
 			stackSerializer:  o.stackSerializer,
+			addonsURL:        o.addonsURL,
+			imageDigest:      o.imageDigest,
 			newEndpointGetter: func(app, env string) (endpointGetter, error) {
 				d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 					App:         app,
@@ -0,0 +1,309 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobRunAppNamePrompt = "Which application's job would you like to run?"
+	jobRunJobNamePrompt = "Which job would you like to run?"
+	jobRunEnvNamePrompt = "Which environment would you like to run the job in?"
+
+	fmtJobRunStart    = "Starting an execution of job %s in environment %s."
+	fmtJobRunFailed   = "Failed to start an execution of job %s in environment %s.\n"
+	fmtJobRunComplete = "Started an execution of job %s in environment %s.\n"
+
+	jobExecutionPollInterval = 3 * time.Second
+)
+
+type runJobVars struct {
+	appName string
+	envName string
+	name    string
+	envVars map[string]string
+	command string
+}
+
+type runJobOpts struct {
+	runJobVars
+
+	// Interfaces to dependencies.
+	store   store
+	sel     configSelector
+	spinner progress
+
+	// Clients initialized once the environment is known.
+	initClients     func() error
+	stateMachine    stateMachineGetter
+	executor        stateMachineExecutor
+	newEventsWriter func(tasks []*task.Task) eventsWriter
+
+	// Replaced in tests.
+	sleep func()
+}
+
+func newRunJobOpts(vars runJobVars) (*runJobOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &runJobOpts{
+		runJobVars: vars,
+
+		store:   store,
+		sel:     selector.NewConfigSelect(prompt.New(), store),
+		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
+		sleep: func() {
+			time.Sleep(jobExecutionPollInterval)
+		},
+	}
+	opts.initClients = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.stateMachine = ecs.New(sess)
+		opts.executor = stepfunctions.New(sess)
+		opts.newEventsWriter = func(tasks []*task.Task) eventsWriter {
+			return logging.NewJobTaskClient(sess, opts.appName, opts.envName, opts.name, tasks)
+		}
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *runJobOpts) Validate() error {
+	if _, err := shlex.Split(o.command); err != nil {
+		return fmt.Errorf(`parse "--%s" flag: %w`, commandFlag, err)
+	}
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return err
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *runJobOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askJobName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute starts an execution of the job's state machine and streams the logs of its tasks until the execution finishes.
+func (o *runJobOpts) Execute() error {
+	input, err := o.executionInput()
+	if err != nil {
+		return err
+	}
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	stateMachineARN, err := o.stateMachine.StateMachineARN(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("get state machine of job %s: %w", o.name, err)
+	}
+	o.spinner.Start(fmt.Sprintf(fmtJobRunStart, o.name, o.envName))
+	executionARN, err := o.executor.StartExecution(stateMachineARN, input)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtJobRunFailed, o.name, o.envName))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtJobRunComplete, o.name, o.envName))
+	return o.streamExecution(executionARN)
+}
+
+// streamExecution writes the logs of the tasks started by the execution, including retries,
+// and returns an error if the execution did not succeed.
+func (o *runJobOpts) streamExecution(executionARN string) error {
+	streamed := make(map[string]bool)
+	for {
+		// Describe the execution before listing its tasks so that no task is missed once it has finished.
+		execution, err := o.executor.Execution(executionARN)
+		if err != nil {
+			return err
+		}
+		submitted, err := o.executor.ExecutionTasks(executionARN)
+		if err != nil {
+			return err
+		}
+		var tasks []*task.Task
+		for _, t := range submitted {
+			if streamed[t.TaskARN] {
+				continue
+			}
+			streamed[t.TaskARN] = true
+			tasks = append(tasks, &task.Task{
+				TaskARN:    t.TaskARN,
+				ClusterARN: t.ClusterARN,
+			})
+		}
+		if len(tasks) > 0 {
+			if err := o.newEventsWriter(tasks).WriteEventsUntilStopped(); err != nil {
+				return fmt.Errorf("write logs of job %s: %w", o.name, err)
+			}
+			continue
+		}
+		if !execution.IsRunning() {
+			if execution.Status != stepfunctions.ExecutionStatusSucceeded {
+				return fmt.Errorf("execution %s of job %s finished with status %s", executionARN, o.name, execution.Status)
+			}
+			log.Successf("Job %s finished successfully.\n", color.HighlightUserInput(o.name))
+			return nil
+		}
+		o.sleep()
+	}
+}
+
+// executionInput returns the JSON input of the job's state machine that overrides the job's container.
+// The input is empty if there is nothing to override.
+func (o *runJobOpts) executionInput() (string, error) {
+	if len(o.envVars) == 0 && o.command == "" {
+		return "", nil
+	}
+	type keyValuePair struct {
+		Name  string `json:"Name"`
+		Value string `json:"Value"`
+	}
+	type containerOverride struct {
+		Name        string         `json:"Name"`
+		Command     []string       `json:"Command,omitempty"`
+		Environment []keyValuePair `json:"Environment,omitempty"`
+	}
+	override := containerOverride{
+		Name: o.name,
+	}
+	command, err := shlex.Split(o.command)
+	if err != nil {
+		return "", fmt.Errorf("split command %s into tokens using shell-style rules: %w", o.command, err)
+	}
+	override.Command = command
+	names := make([]string, 0, len(o.envVars))
+	for name := range o.envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		override.Environment = append(override.Environment, keyValuePair{
+			Name:  name,
+			Value: o.envVars[name],
+		})
+	}
+	var input struct {
+		Overrides struct {
+			ContainerOverrides []containerOverride `json:"ContainerOverrides"`
+		} `json:"Overrides"`
+	}
+	input.Overrides.ContainerOverrides = []containerOverride{override}
+	out, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("marshal execution input: %w", err)
+	}
+	return string(out), nil
+}
+
+func (o *runJobOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	name, err := o.sel.Application(jobRunAppNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select application name: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+func (o *runJobOpts) askJobName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Job(jobRunJobNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select job: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *runJobOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment(jobRunEnvNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// buildJobRunCmd builds the command to invoke a deployed job on demand.
+func buildJobRunCmd() *cobra.Command {
+	vars := runJobVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Invokes a deployed job and streams its logs until it finishes.",
+		Long: `Invokes a deployed job and streams its logs until it finishes.
+The command exits with an error if the job does not succeed.`,
+		Example: `
+  Run the job "report" in the "test" environment.
+  /code $ copilot job run -n report -e test
+  Run the job with a different command and additional environment variables.
+  /code $ copilot job run -n report -e test --command "python report.py --full" --env-vars DRY_RUN=true`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunJobOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, jobRunEnvVarsFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, "", jobRunCommandFlagDescription)
+	return cmd
+}
@@ -0,0 +1,305 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunJobOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     runJobVars
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"skip validation if app flag is not set": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"invalid command": {
+			inVars: runJobVars{
+				command: `echo "hello`,
+			},
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New(`parse "--command" flag: EOF found when expecting closing quote`),
+		},
+		"invalid job name": {
+			inVars: runJobVars{
+				appName: "phonetool",
+				name:    "report",
+			},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("phonetool", "report").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"valid flags": {
+			inVars: runJobVars{
+				appName: "phonetool",
+				name:    "report",
+				envName: "test",
+				command: "python report.py",
+			},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("phonetool", "report").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := runJobOpts{
+				runJobVars: tc.inVars,
+				store:      mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunJobOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     runJobVars
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedVars  runJobVars
+		wantedError error
+	}{
+		"prompts for all missing fields": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(jobRunAppNamePrompt, "").Return("phonetool", nil)
+				m.EXPECT().Job(jobRunJobNamePrompt, "", "phonetool").Return("report", nil)
+				m.EXPECT().Environment(jobRunEnvNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedVars: runJobVars{
+				appName: "phonetool",
+				name:    "report",
+				envName: "test",
+			},
+		},
+		"error if fail to select job": {
+			inVars: runJobVars{
+				appName: "phonetool",
+			},
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Job(jobRunJobNamePrompt, "", "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select job: some error"),
+		},
+		"skips prompts when flags are provided": {
+			inVars: runJobVars{
+				appName: "phonetool",
+				name:    "report",
+				envName: "test",
+			},
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+			wantedVars: runJobVars{
+				appName: "phonetool",
+				name:    "report",
+				envName: "test",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := runJobOpts{
+				runJobVars: tc.inVars,
+				sel:        mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVars, opts.runJobVars)
+			}
+		})
+	}
+}
+
+type runJobMocks struct {
+	stateMachine *mocks.MockstateMachineGetter
+	executor     *mocks.MockstateMachineExecutor
+	eventsWriter *mocks.MockeventsWriter
+	spinner      *mocks.Mockprogress
+}
+
+func TestRunJobOpts_Execute(t *testing.T) {
+	const (
+		mockStateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report"
+		mockExecutionARN    = "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:1234"
+	)
+	running := &stepfunctions.Execution{ARN: mockExecutionARN, Status: stepfunctions.ExecutionStatusRunning}
+	succeeded := &stepfunctions.Execution{ARN: mockExecutionARN, Status: stepfunctions.ExecutionStatusSucceeded}
+	failed := &stepfunctions.Execution{ARN: mockExecutionARN, Status: "FAILED"}
+	firstAttempt := &stepfunctions.ExecutionTask{TaskARN: "task1", ClusterARN: "cluster"}
+	secondAttempt := &stepfunctions.ExecutionTask{TaskARN: "task2", ClusterARN: "cluster"}
+
+	testCases := map[string]struct {
+		inVars     runJobVars
+		setupMocks func(m runJobMocks)
+
+		wantedTasks [][]*task.Task
+		wantedError error
+	}{
+		"error if fail to get the state machine": {
+			setupMocks: func(m runJobMocks) {
+				m.stateMachine.EXPECT().StateMachineARN("phonetool", "test", "report").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get state machine of job report: some error"),
+		},
+		"error if fail to start the execution": {
+			setupMocks: func(m runJobMocks) {
+				m.stateMachine.EXPECT().StateMachineARN("phonetool", "test", "report").Return(mockStateMachineARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.executor.EXPECT().StartExecution(mockStateMachineARN, "").Return("", errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("some error"),
+		},
+		"passes overrides as execution input and streams logs until the execution succeeds": {
+			inVars: runJobVars{
+				command: `python report.py --name "weekly report"`,
+				envVars: map[string]string{
+					"FULL":    "true",
+					"DRY_RUN": "false",
+				},
+			},
+			setupMocks: func(m runJobMocks) {
+				m.stateMachine.EXPECT().StateMachineARN("phonetool", "test", "report").Return(mockStateMachineARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.executor.EXPECT().StartExecution(mockStateMachineARN, `{"Overrides":{"ContainerOverrides":[{"Name":"report","Command":["python","report.py","--name","weekly report"],"Environment":[{"Name":"DRY_RUN","Value":"false"},{"Name":"FULL","Value":"true"}]}]}}`).
+					Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.executor.EXPECT().Execution(mockExecutionARN).Return(running, nil),
+					m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return(nil, nil),
+					m.executor.EXPECT().Execution(mockExecutionARN).Return(running, nil),
+					m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return([]*stepfunctions.ExecutionTask{firstAttempt}, nil),
+					m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil),
+					m.executor.EXPECT().Execution(mockExecutionARN).Return(succeeded, nil),
+					m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return([]*stepfunctions.ExecutionTask{firstAttempt}, nil),
+				)
+			},
+			wantedTasks: [][]*task.Task{
+				{{TaskARN: "task1", ClusterARN: "cluster"}},
+			},
+		},
+		"streams logs of retried tasks and returns an error if the execution fails": {
+			setupMocks: func(m runJobMocks) {
+				m.stateMachine.EXPECT().StateMachineARN("phonetool", "test", "report").Return(mockStateMachineARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.executor.EXPECT().StartExecution(mockStateMachineARN, "").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.executor.EXPECT().Execution(mockExecutionARN).Return(running, nil),
+					m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return([]*stepfunctions.ExecutionTask{firstAttempt}, nil),
+					m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil),
+					m.executor.EXPECT().Execution(mockExecutionARN).Return(failed, nil),
+					m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return([]*stepfunctions.ExecutionTask{firstAttempt, secondAttempt}, nil),
+					m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil),
+					m.executor.EXPECT().Execution(mockExecutionARN).Return(failed, nil),
+					m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return([]*stepfunctions.ExecutionTask{firstAttempt, secondAttempt}, nil),
+				)
+			},
+			wantedTasks: [][]*task.Task{
+				{{TaskARN: "task1", ClusterARN: "cluster"}},
+				{{TaskARN: "task2", ClusterARN: "cluster"}},
+			},
+			wantedError: errors.New("execution " + mockExecutionARN + " of job report finished with status FAILED"),
+		},
+		"error if fail to write logs": {
+			setupMocks: func(m runJobMocks) {
+				m.stateMachine.EXPECT().StateMachineARN("phonetool", "test", "report").Return(mockStateMachineARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.executor.EXPECT().StartExecution(mockStateMachineARN, "").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.executor.EXPECT().Execution(mockExecutionARN).Return(running, nil)
+				m.executor.EXPECT().ExecutionTasks(mockExecutionARN).Return([]*stepfunctions.ExecutionTask{firstAttempt}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(errors.New("some error"))
+			},
+			wantedTasks: [][]*task.Task{
+				{{TaskARN: "task1", ClusterARN: "cluster"}},
+			},
+			wantedError: errors.New("write logs of job report: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runJobMocks{
+				stateMachine: mocks.NewMockstateMachineGetter(ctrl),
+				executor:     mocks.NewMockstateMachineExecutor(ctrl),
+				eventsWriter: mocks.NewMockeventsWriter(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			var streamedTasks [][]*task.Task
+			tc.inVars.appName = "phonetool"
+			tc.inVars.envName = "test"
+			tc.inVars.name = "report"
+			opts := runJobOpts{
+				runJobVars:   tc.inVars,
+				spinner:      m.spinner,
+				stateMachine: m.stateMachine,
+				executor:     m.executor,
+				initClients:  func() error { return nil },
+				newEventsWriter: func(tasks []*task.Task) eventsWriter {
+					streamedTasks = append(streamedTasks, tasks)
+					return m.eventsWriter
+				},
+				sleep: func() {},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedTasks, streamedTasks)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobStatusAppNamePrompt = "Which application's job status would you like to show?"
+	jobStatusJobNamePrompt = "Which job's status would you like to show?"
+	jobStatusEnvNamePrompt = "Which environment is the job deployed to?"
+)
+
+type jobStatusVars struct {
+	shouldOutputJSON bool
+	appName          string
+	envName          string
+	name             string
+}
+
+type jobStatusOpts struct {
+	jobStatusVars
+
+	w                   io.Writer
+	store               store
+	sel                 configSelector
+	statusDescriber     statusDescriber
+	initStatusDescriber func(*jobStatusOpts) error
+}
+
+func newJobStatusOpts(vars jobStatusVars) (*jobStatusOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	return &jobStatusOpts{
+		jobStatusVars: vars,
+		store:         configStore,
+		w:             log.OutputWriter,
+		sel:           selector.NewConfigSelect(prompt.New(), configStore),
+		initStatusDescriber: func(o *jobStatusOpts) error {
+			d, err := describe.NewJobStatusDescriber(&describe.NewJobStatusConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Job:         o.name,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating status describer for job %s in application %s: %w", o.name, o.appName, err)
+			}
+			o.statusDescriber = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *jobStatusOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return err
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *jobStatusOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askJobName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute displays the recent executions of the job.
+func (o *jobStatusOpts) Execute() error {
+	if err := o.initStatusDescriber(o); err != nil {
+		return err
+	}
+	jobStatus, err := o.statusDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe status of job %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := jobStatus.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, jobStatus.HumanString())
+	}
+	return nil
+}
+
+func (o *jobStatusOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	name, err := o.sel.Application(jobStatusAppNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+func (o *jobStatusOpts) askJobName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Job(jobStatusJobNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select job: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *jobStatusOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment(jobStatusEnvNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// buildJobStatusCmd builds the command for showing the status of a deployed job.
+func buildJobStatusCmd() *cobra.Command {
+	vars := jobStatusVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of a deployed job.",
+		Long:  "Shows the recent executions of a deployed job, its next scheduled run and alarm statuses.",
+
+		Example: `
+  Shows the status of the job "report" in the "test" environment.
+  /code $ copilot job status -n report -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
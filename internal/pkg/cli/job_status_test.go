@@ -0,0 +1,205 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStatusOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     jobStatusVars
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"skip validation if app flag is not set": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"invalid environment name": {
+			inVars: jobStatusVars{
+				appName: "phonetool",
+				name:    "report",
+				envName: "test",
+			},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("phonetool", "report").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"valid flags": {
+			inVars: jobStatusVars{
+				appName: "phonetool",
+				name:    "report",
+			},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("phonetool", "report").Return(&config.Workload{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := jobStatusOpts{
+				jobStatusVars: tc.inVars,
+				store:         mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobStatusOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     jobStatusVars
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedVars  jobStatusVars
+		wantedError error
+	}{
+		"prompts for all missing fields": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(jobStatusAppNamePrompt, "").Return("phonetool", nil)
+				m.EXPECT().Job(jobStatusJobNamePrompt, "", "phonetool").Return("report", nil)
+				m.EXPECT().Environment(jobStatusEnvNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedVars: jobStatusVars{
+				appName: "phonetool",
+				name:    "report",
+				envName: "test",
+			},
+		},
+		"error if fail to select environment": {
+			inVars: jobStatusVars{
+				appName: "phonetool",
+				name:    "report",
+			},
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(jobStatusEnvNamePrompt, "", "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := jobStatusOpts{
+				jobStatusVars: tc.inVars,
+				sel:           mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVars, opts.jobStatusVars)
+			}
+		})
+	}
+}
+
+type mockJobStatus struct{}
+
+func (mockJobStatus) HumanString() string {
+	return "human"
+}
+
+func (mockJobStatus) JSONString() (string, error) {
+	return "json", nil
+}
+
+func TestJobStatusOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON    bool
+		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to describe the status of the job": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe status of job report: some error"),
+		},
+		"writes human output": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(mockJobStatus{}, nil)
+			},
+			wantedContent: "human",
+		},
+		"writes json output": {
+			shouldOutputJSON: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(mockJobStatus{}, nil)
+			},
+			wantedContent: "json",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockStatusDescriber(mockStatusDescriber)
+			opts := &jobStatusOpts{
+				jobStatusVars: jobStatusVars{
+					appName:          "phonetool",
+					envName:          "test",
+					name:             "report",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				statusDescriber:     mockStatusDescriber,
+				initStatusDescriber: func(*jobStatusOpts) error { return nil },
+				w:                   b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
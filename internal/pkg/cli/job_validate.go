@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobValidateNamePrompt = "Which job's manifest would you like to validate?"
+)
+
+type validateJobVars struct {
+	name string
+}
+
+type validateJobOpts struct {
+	validateJobVars
+
+	ws  wsJobReader
+	sel wsSelector
+}
+
+func newValidateJobOpts(vars validateJobVars) (*validateJobOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	return &validateJobOpts{
+		validateJobVars: vars,
+		ws:              ws,
+		sel:             selector.NewWorkspaceSelect(prompt.New(), store, ws),
+	}, nil
+}
+
+// Validate returns an error if the job does not exist in the workspace.
+func (o *validateJobOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.JobNames()
+	if err != nil {
+		return fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("job '%s' does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+// Ask prompts the user for the job to validate if it wasn't provided.
+func (o *validateJobOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Job(jobValidateNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select job: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute lints the manifest of the job and its environment overrides.
+func (o *validateJobOpts) Execute() error {
+	mft, err := o.ws.ReadJobManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest for job %s: %w", o.name, err)
+	}
+	return validateManifest(mft, "job", o.name)
+}
+
+// buildJobValidateCmd builds the command for linting the manifest of a job.
+func buildJobValidateCmd() *cobra.Command {
+	vars := validateJobVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the manifest of a job.",
+		Long: `Validates the manifest of a job without connecting to AWS.
+The manifest is checked against its JSON Schema, then the overrides of each environment are applied and checked.`,
+		Example: `
+  Validate the manifest of the "report-generator" job.
+  /code $ copilot job validate -n report-generator`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateJobOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	return cmd
+}
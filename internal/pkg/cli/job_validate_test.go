@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type validateJobMocks struct {
+	ws  *mocks.MockwsJobReader
+	sel *mocks.MockwsSelector
+}
+
+func TestValidateJobOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m validateJobMocks)
+
+		wantedErr string
+	}{
+		"no name": {
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().JobNames().Times(0)
+			},
+		},
+		"job exists in the workspace": {
+			inName: "report",
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+			},
+		},
+		"job does not exist in the workspace": {
+			inName: "report",
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().JobNames().Return([]string{"cleanup"}, nil)
+			},
+			wantedErr: "job 'report' does not exist in the workspace",
+		},
+		"fails to list jobs": {
+			inName: "report",
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().JobNames().Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list jobs in the workspace: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateJobMocks{
+				ws: mocks.NewMockwsJobReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateJobOpts{
+				validateJobVars: validateJobVars{
+					name: tc.inName,
+				},
+				ws: m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateJobOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m validateJobMocks)
+
+		wantedName string
+		wantedErr  string
+	}{
+		"does not prompt if the name is provided": {
+			inName: "report",
+			setupMocks: func(m validateJobMocks) {
+				m.sel.EXPECT().Job(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedName: "report",
+		},
+		"selects a job in the workspace": {
+			setupMocks: func(m validateJobMocks) {
+				m.sel.EXPECT().Job(jobValidateNamePrompt, "").Return("report", nil)
+			},
+			wantedName: "report",
+		},
+		"wraps the selection error": {
+			setupMocks: func(m validateJobMocks) {
+				m.sel.EXPECT().Job(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "select job: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateJobMocks{
+				sel: mocks.NewMockwsSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateJobOpts{
+				validateJobVars: validateJobVars{
+					name: tc.inName,
+				},
+				sel: m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestValidateJobOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m validateJobMocks)
+
+		wantedErr string
+	}{
+		"fails to read the manifest": {
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().ReadJobManifest("report").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest for job report: some error",
+		},
+		"manifest is not YAML": {
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte("name: ["), nil)
+			},
+			wantedErr: "validate manifest for job report: unmarshal manifest: yaml: line 1: did not find expected node content",
+		},
+		"manifest is invalid": {
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte(`name: report
+type: Scheduled Job
+on:
+  schedule: "@daily"
+image:
+  location: nginx
+retries: three
+memroy: 512`), nil)
+			},
+			wantedErr: "manifest for job report has 2 errors",
+		},
+		"manifest is valid": {
+			setupMocks: func(m validateJobMocks) {
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte(`name: report
+type: Scheduled Job
+on:
+  schedule: "@daily"
+image:
+  location: nginx
+retries: 3`), nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateJobMocks{
+				ws: mocks.NewMockwsJobReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateJobOpts{
+				validateJobVars: validateJobVars{
+					name: "report",
+				},
+				ws: m.ws,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -12,9 +12,12 @@ import (
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -793,6 +796,20 @@ func (mr *MockenvironmentStoreMockRecorder) ListEnvironments(appName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentStore)(nil).ListEnvironments), appName)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentCreator is a mock of environmentCreator interface.
 type MockenvironmentCreator struct {
 	ctrl     *gomock.Controller
@@ -906,6 +923,43 @@ func (mr *MockenvironmentListerMockRecorder) ListEnvironments(appName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentLister)(nil).ListEnvironments), appName)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface.
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater.
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance.
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentDeleter is a mock of environmentDeleter interface.
 type MockenvironmentDeleter struct {
 	ctrl     *gomock.Controller
@@ -1242,6 +1296,20 @@ func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface.
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -1325,6 +1393,59 @@ func (mr *MockdeployedEnvironmentListerMockRecorder) ListSNSTopics(appName, envN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSNSTopics", reflect.TypeOf((*MockdeployedEnvironmentLister)(nil).ListSNSTopics), appName, envName)
 }
 
+// MockdeployedWorkloadsLister is a mock of deployedWorkloadsLister interface.
+type MockdeployedWorkloadsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedWorkloadsListerMockRecorder
+}
+
+// MockdeployedWorkloadsListerMockRecorder is the mock recorder for MockdeployedWorkloadsLister.
+type MockdeployedWorkloadsListerMockRecorder struct {
+	mock *MockdeployedWorkloadsLister
+}
+
+// NewMockdeployedWorkloadsLister creates a new mock instance.
+func NewMockdeployedWorkloadsLister(ctrl *gomock.Controller) *MockdeployedWorkloadsLister {
+	mock := &MockdeployedWorkloadsLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedWorkloadsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedWorkloadsLister) EXPECT() *MockdeployedWorkloadsListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedJobs mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedJobs), appName, envName)
+}
+
+// ListDeployedServices mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedServices(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedServices", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedServices indicates an expected call of ListDeployedServices.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedServices(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedServices", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedServices), appName, envName)
+}
+
 // MocksecretsManager is a mock of secretsManager interface.
 type MocksecretsManager struct {
 	ctrl     *gomock.Controller
@@ -1490,6 +1611,81 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
+// MockimageScanner is a mock of imageScanner interface.
+type MockimageScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageScannerMockRecorder
+}
+
+// MockimageScannerMockRecorder is the mock recorder for MockimageScanner.
+type MockimageScannerMockRecorder struct {
+	mock *MockimageScanner
+}
+
+// NewMockimageScanner creates a new mock instance.
+func NewMockimageScanner(ctrl *gomock.Controller) *MockimageScanner {
+	mock := &MockimageScanner{ctrl: ctrl}
+	mock.recorder = &MockimageScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageScanner) EXPECT() *MockimageScannerMockRecorder {
+	return m.recorder
+}
+
+// ScanImage mocks base method.
+func (m *MockimageScanner) ScanImage(repoName, digest string) (*ecr.ImageScanFindings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanImage", repoName, digest)
+	ret0, _ := ret[0].(*ecr.ImageScanFindings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanImage indicates an expected call of ScanImage.
+func (mr *MockimageScannerMockRecorder) ScanImage(repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanImage", reflect.TypeOf((*MockimageScanner)(nil).ScanImage), repoName, digest)
+}
+
+// MockimageSigner is a mock of imageSigner interface.
+type MockimageSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageSignerMockRecorder
+}
+
+// MockimageSignerMockRecorder is the mock recorder for MockimageSigner.
+type MockimageSignerMockRecorder struct {
+	mock *MockimageSigner
+}
+
+// NewMockimageSigner creates a new mock instance.
+func NewMockimageSigner(ctrl *gomock.Controller) *MockimageSigner {
+	mock := &MockimageSigner{ctrl: ctrl}
+	mock.recorder = &MockimageSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageSigner) EXPECT() *MockimageSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockimageSigner) Sign(image, signingProfileARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", image, signingProfileARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockimageSignerMockRecorder) Sign(image, signingProfileARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockimageSigner)(nil).Sign), image, signingProfileARN)
+}
+
 // MockrepositoryURIGetter is a mock of repositoryURIGetter interface.
 type MockrepositoryURIGetter struct {
 	ctrl     *gomock.Controller
@@ -1579,6 +1775,69 @@ func (mr *MockrepositoryServiceMockRecorder) URI() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockrepositoryService)(nil).URI))
 }
 
+// MockregistryLoginer is a mock of registryLoginer interface.
+type MockregistryLoginer struct {
+	ctrl     *gomock.Controller
+	recorder *MockregistryLoginerMockRecorder
+}
+
+// MockregistryLoginerMockRecorder is the mock recorder for MockregistryLoginer.
+type MockregistryLoginerMockRecorder struct {
+	mock *MockregistryLoginer
+}
+
+// NewMockregistryLoginer creates a new mock instance.
+func NewMockregistryLoginer(ctrl *gomock.Controller) *MockregistryLoginer {
+	mock := &MockregistryLoginer{ctrl: ctrl}
+	mock.recorder = &MockregistryLoginerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockregistryLoginer) EXPECT() *MockregistryLoginerMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockregistryLoginer) Login(docker repository.ContainerLoginBuildPusher) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", docker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockregistryLoginerMockRecorder) Login(docker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockregistryLoginer)(nil).Login), docker)
+}
+
+// SkipLogin mocks base method.
+func (m *MockregistryLoginer) SkipLogin() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SkipLogin")
+}
+
+// SkipLogin indicates an expected call of SkipLogin.
+func (mr *MockregistryLoginerMockRecorder) SkipLogin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipLogin", reflect.TypeOf((*MockregistryLoginer)(nil).SkipLogin))
+}
+
+// URI mocks base method.
+func (m *MockregistryLoginer) URI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockregistryLoginerMockRecorder) URI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockregistryLoginer)(nil).URI))
+}
+
 // MocklogEventsWriter is a mock of logEventsWriter interface.
 type MocklogEventsWriter struct {
 	ctrl     *gomock.Controller
@@ -1786,6 +2045,112 @@ func (mr *MockeventsWriterMockRecorder) WriteEventsUntilStopped() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEventsUntilStopped", reflect.TypeOf((*MockeventsWriter)(nil).WriteEventsUntilStopped))
 }
 
+// MockstateMachineGetter is a mock of stateMachineGetter interface.
+type MockstateMachineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstateMachineGetterMockRecorder
+}
+
+// MockstateMachineGetterMockRecorder is the mock recorder for MockstateMachineGetter.
+type MockstateMachineGetterMockRecorder struct {
+	mock *MockstateMachineGetter
+}
+
+// NewMockstateMachineGetter creates a new mock instance.
+func NewMockstateMachineGetter(ctrl *gomock.Controller) *MockstateMachineGetter {
+	mock := &MockstateMachineGetter{ctrl: ctrl}
+	mock.recorder = &MockstateMachineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstateMachineGetter) EXPECT() *MockstateMachineGetterMockRecorder {
+	return m.recorder
+}
+
+// StateMachineARN mocks base method.
+func (m *MockstateMachineGetter) StateMachineARN(app, env, job string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineARN", app, env, job)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineARN indicates an expected call of StateMachineARN.
+func (mr *MockstateMachineGetterMockRecorder) StateMachineARN(app, env, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineARN", reflect.TypeOf((*MockstateMachineGetter)(nil).StateMachineARN), app, env, job)
+}
+
+// MockstateMachineExecutor is a mock of stateMachineExecutor interface.
+type MockstateMachineExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockstateMachineExecutorMockRecorder
+}
+
+// MockstateMachineExecutorMockRecorder is the mock recorder for MockstateMachineExecutor.
+type MockstateMachineExecutorMockRecorder struct {
+	mock *MockstateMachineExecutor
+}
+
+// NewMockstateMachineExecutor creates a new mock instance.
+func NewMockstateMachineExecutor(ctrl *gomock.Controller) *MockstateMachineExecutor {
+	mock := &MockstateMachineExecutor{ctrl: ctrl}
+	mock.recorder = &MockstateMachineExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstateMachineExecutor) EXPECT() *MockstateMachineExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execution mocks base method.
+func (m *MockstateMachineExecutor) Execution(executionARN string) (*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execution", executionARN)
+	ret0, _ := ret[0].(*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execution indicates an expected call of Execution.
+func (mr *MockstateMachineExecutorMockRecorder) Execution(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execution", reflect.TypeOf((*MockstateMachineExecutor)(nil).Execution), executionARN)
+}
+
+// ExecutionTasks mocks base method.
+func (m *MockstateMachineExecutor) ExecutionTasks(executionARN string) ([]*stepfunctions.ExecutionTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionTasks", executionARN)
+	ret0, _ := ret[0].([]*stepfunctions.ExecutionTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionTasks indicates an expected call of ExecutionTasks.
+func (mr *MockstateMachineExecutorMockRecorder) ExecutionTasks(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionTasks", reflect.TypeOf((*MockstateMachineExecutor)(nil).ExecutionTasks), executionARN)
+}
+
+// StartExecution mocks base method.
+func (m *MockstateMachineExecutor) StartExecution(stateMachineARN, input string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExecution", stateMachineARN, input)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExecution indicates an expected call of StartExecution.
+func (mr *MockstateMachineExecutorMockRecorder) StartExecution(stateMachineARN, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExecution", reflect.TypeOf((*MockstateMachineExecutor)(nil).StartExecution), stateMachineARN, input)
+}
+
 // MockdefaultSessionProvider is a mock of defaultSessionProvider interface.
 type MockdefaultSessionProvider struct {
 	ctrl     *gomock.Controller
@@ -2354,6 +2719,44 @@ func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
 }
 
+// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
+type MockwsEnvironmentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentReaderMockRecorder
+}
+
+// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
+type MockwsEnvironmentReaderMockRecorder struct {
+	mock *MockwsEnvironmentReader
+}
+
+// NewMockwsEnvironmentReader creates a new mock instance.
+func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
+	mock := &MockwsEnvironmentReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsEnvironmentReader) ReadEnvironmentManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), name)
+}
+
 // MockwsServiceLister is a mock of wsServiceLister interface.
 type MockwsServiceLister struct {
 	ctrl     *gomock.Controller
@@ -2853,57 +3256,215 @@ func (mr *MockwsWlDirReaderMockRecorder) WorkloadNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsWlDirReader)(nil).WorkloadNames))
 }
 
-// MockwsPipelineReader is a mock of wsPipelineReader interface.
-type MockwsPipelineReader struct {
+// MockwsPipelineBuildReader is a mock of wsPipelineBuildReader interface.
+type MockwsPipelineBuildReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsPipelineReaderMockRecorder
+	recorder *MockwsPipelineBuildReaderMockRecorder
 }
 
-// MockwsPipelineReaderMockRecorder is the mock recorder for MockwsPipelineReader.
-type MockwsPipelineReaderMockRecorder struct {
-	mock *MockwsPipelineReader
+// MockwsPipelineBuildReaderMockRecorder is the mock recorder for MockwsPipelineBuildReader.
+type MockwsPipelineBuildReaderMockRecorder struct {
+	mock *MockwsPipelineBuildReader
 }
 
-// NewMockwsPipelineReader creates a new mock instance.
-func NewMockwsPipelineReader(ctrl *gomock.Controller) *MockwsPipelineReader {
-	mock := &MockwsPipelineReader{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineReaderMockRecorder{mock}
+// NewMockwsPipelineBuildReader creates a new mock instance.
+func NewMockwsPipelineBuildReader(ctrl *gomock.Controller) *MockwsPipelineBuildReader {
+	mock := &MockwsPipelineBuildReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineBuildReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
+func (m *MockwsPipelineBuildReader) EXPECT() *MockwsPipelineBuildReaderMockRecorder {
 	return m.recorder
 }
 
-// ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+// CopilotDirPath mocks base method.
+func (m *MockwsPipelineBuildReader) CopilotDirPath() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "CopilotDirPath")
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+// CopilotDirPath indicates an expected call of CopilotDirPath.
+func (mr *MockwsPipelineBuildReaderMockRecorder) CopilotDirPath() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).CopilotDirPath))
 }
 
-// WorkloadNames mocks base method.
-func (m *MockwsPipelineReader) WorkloadNames() ([]string, error) {
+// JobNames mocks base method.
+func (m *MockwsPipelineBuildReader) JobNames() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret := m.ctrl.Call(m, "JobNames")
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WorkloadNames indicates an expected call of WorkloadNames.
-func (mr *MockwsPipelineReaderMockRecorder) WorkloadNames() *gomock.Call {
+// JobNames indicates an expected call of JobNames.
+func (mr *MockwsPipelineBuildReaderMockRecorder) JobNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsPipelineReader)(nil).WorkloadNames))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).JobNames))
+}
+
+// ListDockerfiles mocks base method.
+func (m *MockwsPipelineBuildReader) ListDockerfiles() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDockerfiles")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDockerfiles indicates an expected call of ListDockerfiles.
+func (mr *MockwsPipelineBuildReaderMockRecorder) ListDockerfiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDockerfiles", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).ListDockerfiles))
+}
+
+// ReadJobManifest mocks base method.
+func (m *MockwsPipelineBuildReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest.
+func (mr *MockwsPipelineBuildReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).ReadJobManifest), jobName)
+}
+
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineBuildReader) ReadPipelineManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineBuildReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).ReadPipelineManifest))
+}
+
+// ReadServiceManifest mocks base method.
+func (m *MockwsPipelineBuildReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest.
+func (mr *MockwsPipelineBuildReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceNames mocks base method.
+func (m *MockwsPipelineBuildReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames.
+func (mr *MockwsPipelineBuildReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).ServiceNames))
+}
+
+// Summary mocks base method.
+func (m *MockwsPipelineBuildReader) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockwsPipelineBuildReaderMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).Summary))
+}
+
+// WorkloadNames mocks base method.
+func (m *MockwsPipelineBuildReader) WorkloadNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadNames indicates an expected call of WorkloadNames.
+func (mr *MockwsPipelineBuildReaderMockRecorder) WorkloadNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsPipelineBuildReader)(nil).WorkloadNames))
+}
+
+// MockwsPipelineReader is a mock of wsPipelineReader interface.
+type MockwsPipelineReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineReaderMockRecorder
+}
+
+// MockwsPipelineReaderMockRecorder is the mock recorder for MockwsPipelineReader.
+type MockwsPipelineReaderMockRecorder struct {
+	mock *MockwsPipelineReader
+}
+
+// NewMockwsPipelineReader creates a new mock instance.
+func NewMockwsPipelineReader(ctrl *gomock.Controller) *MockwsPipelineReader {
+	mock := &MockwsPipelineReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+}
+
+// WorkloadNames mocks base method.
+func (m *MockwsPipelineReader) WorkloadNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadNames indicates an expected call of WorkloadNames.
+func (mr *MockwsPipelineReaderMockRecorder) WorkloadNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsPipelineReader)(nil).WorkloadNames))
 }
 
 // MockwsAppManager is a mock of wsAppManager interface.
@@ -4376,6 +4937,150 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockserviceRevisionDescriber is a mock of serviceRevisionDescriber interface.
+type MockserviceRevisionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceRevisionDescriberMockRecorder
+}
+
+// MockserviceRevisionDescriberMockRecorder is the mock recorder for MockserviceRevisionDescriber.
+type MockserviceRevisionDescriberMockRecorder struct {
+	mock *MockserviceRevisionDescriber
+}
+
+// NewMockserviceRevisionDescriber creates a new mock instance.
+func NewMockserviceRevisionDescriber(ctrl *gomock.Controller) *MockserviceRevisionDescriber {
+	mock := &MockserviceRevisionDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceRevisionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceRevisionDescriber) EXPECT() *MockserviceRevisionDescriberMockRecorder {
+	return m.recorder
+}
+
+// Revision mocks base method.
+func (m *MockserviceRevisionDescriber) Revision(revision int) (*describe.ServiceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revision", revision)
+	ret0, _ := ret[0].(*describe.ServiceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revision indicates an expected call of Revision.
+func (mr *MockserviceRevisionDescriberMockRecorder) Revision(revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revision", reflect.TypeOf((*MockserviceRevisionDescriber)(nil).Revision), revision)
+}
+
+// Revisions mocks base method.
+func (m *MockserviceRevisionDescriber) Revisions() ([]describe.ServiceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revisions")
+	ret0, _ := ret[0].([]describe.ServiceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revisions indicates an expected call of Revisions.
+func (mr *MockserviceRevisionDescriberMockRecorder) Revisions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revisions", reflect.TypeOf((*MockserviceRevisionDescriber)(nil).Revisions))
+}
+
+// MockserviceRevisionStore is a mock of serviceRevisionStore interface.
+type MockserviceRevisionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceRevisionStoreMockRecorder
+}
+
+// MockserviceRevisionStoreMockRecorder is the mock recorder for MockserviceRevisionStore.
+type MockserviceRevisionStoreMockRecorder struct {
+	mock *MockserviceRevisionStore
+}
+
+// NewMockserviceRevisionStore creates a new mock instance.
+func NewMockserviceRevisionStore(ctrl *gomock.Controller) *MockserviceRevisionStore {
+	mock := &MockserviceRevisionStore{ctrl: ctrl}
+	mock.recorder = &MockserviceRevisionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceRevisionStore) EXPECT() *MockserviceRevisionStoreMockRecorder {
+	return m.recorder
+}
+
+// GetObject mocks base method.
+func (m *MockserviceRevisionStore) GetObject(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockserviceRevisionStoreMockRecorder) GetObject(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockserviceRevisionStore)(nil).GetObject), bucket, key)
+}
+
+// PutObject mocks base method.
+func (m *MockserviceRevisionStore) PutObject(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockserviceRevisionStoreMockRecorder) PutObject(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockserviceRevisionStore)(nil).PutObject), bucket, key, data)
+}
+
+// MocktaskDefRevisionGetter is a mock of taskDefRevisionGetter interface.
+type MocktaskDefRevisionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefRevisionGetterMockRecorder
+}
+
+// MocktaskDefRevisionGetterMockRecorder is the mock recorder for MocktaskDefRevisionGetter.
+type MocktaskDefRevisionGetterMockRecorder struct {
+	mock *MocktaskDefRevisionGetter
+}
+
+// NewMocktaskDefRevisionGetter creates a new mock instance.
+func NewMocktaskDefRevisionGetter(ctrl *gomock.Controller) *MocktaskDefRevisionGetter {
+	mock := &MocktaskDefRevisionGetter{ctrl: ctrl}
+	mock.recorder = &MocktaskDefRevisionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskDefRevisionGetter) EXPECT() *MocktaskDefRevisionGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceTaskDefinitionRevision mocks base method.
+func (m *MocktaskDefRevisionGetter) ServiceTaskDefinitionRevision(app, env, svc string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTaskDefinitionRevision", app, env, svc)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTaskDefinitionRevision indicates an expected call of ServiceTaskDefinitionRevision.
+func (mr *MocktaskDefRevisionGetterMockRecorder) ServiceTaskDefinitionRevision(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTaskDefinitionRevision", reflect.TypeOf((*MocktaskDefRevisionGetter)(nil).ServiceTaskDefinitionRevision), app, env, svc)
+}
+
 // MockenvDescriber is a mock of envDescriber interface.
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -4798,6 +5503,81 @@ func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
 }
 
+// MockpipelineExecutionStarter is a mock of pipelineExecutionStarter interface.
+type MockpipelineExecutionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionStarterMockRecorder
+}
+
+// MockpipelineExecutionStarterMockRecorder is the mock recorder for MockpipelineExecutionStarter.
+type MockpipelineExecutionStarterMockRecorder struct {
+	mock *MockpipelineExecutionStarter
+}
+
+// NewMockpipelineExecutionStarter creates a new mock instance.
+func NewMockpipelineExecutionStarter(ctrl *gomock.Controller) *MockpipelineExecutionStarter {
+	mock := &MockpipelineExecutionStarter{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutionStarter) EXPECT() *MockpipelineExecutionStarterMockRecorder {
+	return m.recorder
+}
+
+// StartPipelineExecution mocks base method.
+func (m *MockpipelineExecutionStarter) StartPipelineExecution(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPipelineExecution", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPipelineExecution indicates an expected call of StartPipelineExecution.
+func (mr *MockpipelineExecutionStarterMockRecorder) StartPipelineExecution(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPipelineExecution", reflect.TypeOf((*MockpipelineExecutionStarter)(nil).StartPipelineExecution), pipelineName)
+}
+
+// MockpipelineStageRetrier is a mock of pipelineStageRetrier interface.
+type MockpipelineStageRetrier struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineStageRetrierMockRecorder
+}
+
+// MockpipelineStageRetrierMockRecorder is the mock recorder for MockpipelineStageRetrier.
+type MockpipelineStageRetrierMockRecorder struct {
+	mock *MockpipelineStageRetrier
+}
+
+// NewMockpipelineStageRetrier creates a new mock instance.
+func NewMockpipelineStageRetrier(ctrl *gomock.Controller) *MockpipelineStageRetrier {
+	mock := &MockpipelineStageRetrier{ctrl: ctrl}
+	mock.recorder = &MockpipelineStageRetrierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineStageRetrier) EXPECT() *MockpipelineStageRetrierMockRecorder {
+	return m.recorder
+}
+
+// RetryStageExecution mocks base method.
+func (m *MockpipelineStageRetrier) RetryStageExecution(pipelineName, stageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryStageExecution", pipelineName, stageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryStageExecution indicates an expected call of RetryStageExecution.
+func (mr *MockpipelineStageRetrierMockRecorder) RetryStageExecution(pipelineName, stageName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*MockpipelineStageRetrier)(nil).RetryStageExecution), pipelineName, stageName)
+}
+
 // Mockexecutor is a mock of executor interface.
 type Mockexecutor struct {
 	ctrl     *gomock.Controller
@@ -5223,6 +6003,64 @@ func (mr *MockpipelineSelectorMockRecorder) Environments(prompt, help, app, fina
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
 }
 
+// MockdeployedPipelineSelector is a mock of deployedPipelineSelector interface.
+type MockdeployedPipelineSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedPipelineSelectorMockRecorder
+}
+
+// MockdeployedPipelineSelectorMockRecorder is the mock recorder for MockdeployedPipelineSelector.
+type MockdeployedPipelineSelectorMockRecorder struct {
+	mock *MockdeployedPipelineSelector
+}
+
+// NewMockdeployedPipelineSelector creates a new mock instance.
+func NewMockdeployedPipelineSelector(ctrl *gomock.Controller) *MockdeployedPipelineSelector {
+	mock := &MockdeployedPipelineSelector{ctrl: ctrl}
+	mock.recorder = &MockdeployedPipelineSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedPipelineSelector) EXPECT() *MockdeployedPipelineSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockdeployedPipelineSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockdeployedPipelineSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeployedPipelineSelector)(nil).Application), varargs...)
+}
+
+// DeployedPipeline mocks base method.
+func (m *MockdeployedPipelineSelector) DeployedPipeline(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployedPipeline", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedPipeline indicates an expected call of DeployedPipeline.
+func (mr *MockdeployedPipelineSelectorMockRecorder) DeployedPipeline(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedPipeline", reflect.TypeOf((*MockdeployedPipelineSelector)(nil).DeployedPipeline), prompt, help, app)
+}
+
 // MockwsSelector is a mock of wsSelector interface.
 type MockwsSelector struct {
 	ctrl     *gomock.Controller
@@ -5647,6 +6485,44 @@ func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
 }
 
+// MockloadBalancerDescriber is a mock of loadBalancerDescriber interface.
+type MockloadBalancerDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockloadBalancerDescriberMockRecorder
+}
+
+// MockloadBalancerDescriberMockRecorder is the mock recorder for MockloadBalancerDescriber.
+type MockloadBalancerDescriberMockRecorder struct {
+	mock *MockloadBalancerDescriber
+}
+
+// NewMockloadBalancerDescriber creates a new mock instance.
+func NewMockloadBalancerDescriber(ctrl *gomock.Controller) *MockloadBalancerDescriber {
+	mock := &MockloadBalancerDescriber{ctrl: ctrl}
+	mock.recorder = &MockloadBalancerDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloadBalancerDescriber) EXPECT() *MockloadBalancerDescriberMockRecorder {
+	return m.recorder
+}
+
+// LoadBalancer mocks base method.
+func (m *MockloadBalancerDescriber) LoadBalancer(arn string) (*elbv2.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancer", arn)
+	ret0, _ := ret[0].(*elbv2.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancer indicates an expected call of LoadBalancer.
+func (mr *MockloadBalancerDescriberMockRecorder) LoadBalancer(arn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MockloadBalancerDescriber)(nil).LoadBalancer), arn)
+}
+
 // MockserviceResumer is a mock of serviceResumer interface.
 type MockserviceResumer struct {
 	ctrl     *gomock.Controller
@@ -5872,6 +6748,48 @@ func (mr *MockserviceUpdaterMockRecorder) ForceUpdateService(app, env, svc inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUpdateService", reflect.TypeOf((*MockserviceUpdater)(nil).ForceUpdateService), app, env, svc)
 }
 
+// MockserviceRollbacker is a mock of serviceRollbacker interface.
+type MockserviceRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceRollbackerMockRecorder
+}
+
+// MockserviceRollbackerMockRecorder is the mock recorder for MockserviceRollbacker.
+type MockserviceRollbackerMockRecorder struct {
+	mock *MockserviceRollbacker
+}
+
+// NewMockserviceRollbacker creates a new mock instance.
+func NewMockserviceRollbacker(ctrl *gomock.Controller) *MockserviceRollbacker {
+	mock := &MockserviceRollbacker{ctrl: ctrl}
+	mock.recorder = &MockserviceRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceRollbacker) EXPECT() *MockserviceRollbackerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MockserviceRollbacker) DeployService(out progress.FileWriter, conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{out, conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MockserviceRollbackerMockRecorder) DeployService(out, conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{out, conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceRollbacker)(nil).DeployService), varargs...)
+}
+
 // MockserviceDeployer is a mock of serviceDeployer interface.
 type MockserviceDeployer struct {
 	ctrl     *gomock.Controller
@@ -5914,6 +6832,150 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(out, conf interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// DiffService mocks base method.
+func (m *MockserviceDeployer) DiffService(out progress.FileWriter, conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation0.StackDiff, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{out, conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiffService", varargs...)
+	ret0, _ := ret[0].(*cloudformation0.StackDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffService indicates an expected call of DiffService.
+func (mr *MockserviceDeployerMockRecorder) DiffService(out, conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{out, conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffService", reflect.TypeOf((*MockserviceDeployer)(nil).DiffService), varargs...)
+}
+
+// DiscardStackDiff mocks base method.
+func (m *MockserviceDeployer) DiscardStackDiff(diff *cloudformation0.StackDiff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardStackDiff", diff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DiscardStackDiff indicates an expected call of DiscardStackDiff.
+func (mr *MockserviceDeployerMockRecorder) DiscardStackDiff(diff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardStackDiff", reflect.TypeOf((*MockserviceDeployer)(nil).DiscardStackDiff), diff)
+}
+
+// ExecuteStackDiff mocks base method.
+func (m *MockserviceDeployer) ExecuteStackDiff(out progress.FileWriter, diff *cloudformation0.StackDiff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteStackDiff", out, diff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteStackDiff indicates an expected call of ExecuteStackDiff.
+func (mr *MockserviceDeployerMockRecorder) ExecuteStackDiff(out, diff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteStackDiff", reflect.TypeOf((*MockserviceDeployer)(nil).ExecuteStackDiff), out, diff)
+}
+
+// PreviewService mocks base method.
+func (m *MockserviceDeployer) PreviewService(out progress.FileWriter, conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation0.StackDiff, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{out, conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewService", varargs...)
+	ret0, _ := ret[0].(*cloudformation0.StackDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewService indicates an expected call of PreviewService.
+func (mr *MockserviceDeployerMockRecorder) PreviewService(out, conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{out, conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewService", reflect.TypeOf((*MockserviceDeployer)(nil).PreviewService), varargs...)
+}
+
+// MocktrafficWeightsGetter is a mock of trafficWeightsGetter interface.
+type MocktrafficWeightsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrafficWeightsGetterMockRecorder
+}
+
+// MocktrafficWeightsGetterMockRecorder is the mock recorder for MocktrafficWeightsGetter.
+type MocktrafficWeightsGetterMockRecorder struct {
+	mock *MocktrafficWeightsGetter
+}
+
+// NewMocktrafficWeightsGetter creates a new mock instance.
+func NewMocktrafficWeightsGetter(ctrl *gomock.Controller) *MocktrafficWeightsGetter {
+	mock := &MocktrafficWeightsGetter{ctrl: ctrl}
+	mock.recorder = &MocktrafficWeightsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrafficWeightsGetter) EXPECT() *MocktrafficWeightsGetterMockRecorder {
+	return m.recorder
+}
+
+// TrafficWeights mocks base method.
+func (m *MocktrafficWeightsGetter) TrafficWeights(stackName string) (*stack.TrafficWeights, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrafficWeights", stackName)
+	ret0, _ := ret[0].(*stack.TrafficWeights)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrafficWeights indicates an expected call of TrafficWeights.
+func (mr *MocktrafficWeightsGetterMockRecorder) TrafficWeights(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrafficWeights", reflect.TypeOf((*MocktrafficWeightsGetter)(nil).TrafficWeights), stackName)
+}
+
+// MockstoppedTasksGetter is a mock of stoppedTasksGetter interface.
+type MockstoppedTasksGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstoppedTasksGetterMockRecorder
+}
+
+// MockstoppedTasksGetterMockRecorder is the mock recorder for MockstoppedTasksGetter.
+type MockstoppedTasksGetterMockRecorder struct {
+	mock *MockstoppedTasksGetter
+}
+
+// NewMockstoppedTasksGetter creates a new mock instance.
+func NewMockstoppedTasksGetter(ctrl *gomock.Controller) *MockstoppedTasksGetter {
+	mock := &MockstoppedTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockstoppedTasksGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstoppedTasksGetter) EXPECT() *MockstoppedTasksGetterMockRecorder {
+	return m.recorder
+}
+
+// StoppedServiceTasks mocks base method.
+func (m *MockstoppedTasksGetter) StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedServiceTasks", cluster, service)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedServiceTasks indicates an expected call of StoppedServiceTasks.
+func (mr *MockstoppedTasksGetterMockRecorder) StoppedServiceTasks(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockstoppedTasksGetter)(nil).StoppedServiceTasks), cluster, service)
+}
+
 // MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
 type MockapprunnerServiceDescriber struct {
 	ctrl     *gomock.Controller
@@ -6379,6 +7441,118 @@ func (mr *MockdockerEngineMockRecorder) RedirectPlatform(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedirectPlatform", reflect.TypeOf((*MockdockerEngine)(nil).RedirectPlatform), arg0)
 }
 
+// MockcontainerRunner is a mock of containerRunner interface.
+type MockcontainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerRunnerMockRecorder
+}
+
+// MockcontainerRunnerMockRecorder is the mock recorder for MockcontainerRunner.
+type MockcontainerRunnerMockRecorder struct {
+	mock *MockcontainerRunner
+}
+
+// NewMockcontainerRunner creates a new mock instance.
+func NewMockcontainerRunner(ctrl *gomock.Controller) *MockcontainerRunner {
+	mock := &MockcontainerRunner{ctrl: ctrl}
+	mock.recorder = &MockcontainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontainerRunner) EXPECT() *MockcontainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockcontainerRunner) Build(args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockcontainerRunnerMockRecorder) Build(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockcontainerRunner)(nil).Build), args)
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MockcontainerRunner) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockcontainerRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockcontainerRunner)(nil).CheckDockerEngineRunning))
+}
+
+// Logs mocks base method.
+func (m *MockcontainerRunner) Logs(containerName string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", containerName, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockcontainerRunnerMockRecorder) Logs(containerName, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockcontainerRunner)(nil).Logs), containerName, w)
+}
+
+// Remove mocks base method.
+func (m *MockcontainerRunner) Remove(containerNames ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range containerNames {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Remove", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockcontainerRunnerMockRecorder) Remove(containerNames ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockcontainerRunner)(nil).Remove), containerNames...)
+}
+
+// Run mocks base method.
+func (m *MockcontainerRunner) Run(options *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockcontainerRunnerMockRecorder) Run(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockcontainerRunner)(nil).Run), options)
+}
+
+// Wait mocks base method.
+func (m *MockcontainerRunner) Wait(containerName string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", containerName)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockcontainerRunnerMockRecorder) Wait(containerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockcontainerRunner)(nil).Wait), containerName)
+}
+
 // Mockcodestar is a mock of codestar interface.
 type Mockcodestar struct {
 	ctrl     *gomock.Controller
@@ -6530,6 +7704,44 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretValueGetter is a mock of secretValueGetter interface.
+type MocksecretValueGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretValueGetterMockRecorder
+}
+
+// MocksecretValueGetterMockRecorder is the mock recorder for MocksecretValueGetter.
+type MocksecretValueGetterMockRecorder struct {
+	mock *MocksecretValueGetter
+}
+
+// NewMocksecretValueGetter creates a new mock instance.
+func NewMocksecretValueGetter(ctrl *gomock.Controller) *MocksecretValueGetter {
+	mock := &MocksecretValueGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretValueGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretValueGetter) EXPECT() *MocksecretValueGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MocksecretValueGetter) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MocksecretValueGetterMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretValueGetter)(nil).GetSecretValue), name)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -25,6 +25,10 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
 	cmd.AddCommand(buildPipelineListCmd())
+	cmd.AddCommand(buildPipelineBuildCmd())
+	cmd.AddCommand(buildPipelineRunCmd())
+	cmd.AddCommand(buildPipelineHistoryCmd())
+	cmd.AddCommand(buildPipelineRetryCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,423 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// codeBuildBuildIDEnvVar is the environment variable set by CodeBuild to the ID of the build.
+	codeBuildBuildIDEnvVar = "CODEBUILD_BUILD_ID"
+	// maxDockerTagLength is the maximum number of characters of a Docker tag.
+	// See https://docs.docker.com/engine/reference/commandline/tag/
+	maxDockerTagLength = 128
+
+	defaultPipelineBuildOutputDir = "infrastructure"
+)
+
+type pipelineBuildVars struct {
+	appName   string
+	tag       string
+	outputDir string
+}
+
+// workloadPackage holds what's needed to package a workload for a stage of the pipeline.
+type workloadPackage struct {
+	name        string
+	wkldType    string
+	env         string
+	addonsURL   string
+	imageDigest string
+}
+
+type pipelineBuildOpts struct {
+	pipelineBuildVars
+
+	ws           wsPipelineBuildReader
+	store        store
+	appCFN       appResourcesGetter
+	runner       runner
+	dockerEngine repository.ContainerLoginBuildPusher
+	unmarshal    func([]byte) (manifest.WorkloadManifest, error)
+	buildID      string
+
+	newAddons        func(wkld string) (templater, error)
+	newRepository    func(wkld, region string) (imageBuilderPusher, error)
+	newUploader      func(region string) (artifactUploader, error)
+	newEnvUpgradeCmd func(env string) (executor, error)
+	newPackageCmd    func(pkg workloadPackage) (executor, error)
+
+	// Cached resources of the application per region.
+	appResources map[string]*stack.AppRegionalResources
+}
+
+func newPipelineBuildOpts(vars pipelineBuildVars) (*pipelineBuildOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	p := sessions.NewProvider()
+	defaultSess, err := p.Default()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve default session: %w", err)
+	}
+	opts := &pipelineBuildOpts{
+		pipelineBuildVars: vars,
+		ws:                ws,
+		store:             store,
+		appCFN:            cloudformation.New(defaultSess),
+		runner:            exec.NewCmd(),
+		dockerEngine:      dockerengine.New(exec.NewCmd()),
+		unmarshal:         manifest.UnmarshalWorkload,
+		buildID:           os.Getenv(codeBuildBuildIDEnvVar),
+		newAddons: func(wkld string) (templater, error) {
+			return addon.New(wkld)
+		},
+		newRepository: func(wkld, region string) (imageBuilderPusher, error) {
+			sess, err := p.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			return repository.New(fmt.Sprintf("%s/%s", vars.appName, wkld), ecr.New(sess))
+		},
+		newUploader: func(region string) (artifactUploader, error) {
+			sess, err := p.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			return s3.New(sess), nil
+		},
+		newEnvUpgradeCmd: func(env string) (executor, error) {
+			return newEnvUpgradeOpts(envUpgradeVars{
+				appName: vars.appName,
+				name:    env,
+			})
+		},
+		appResources: make(map[string]*stack.AppRegionalResources),
+	}
+	opts.newPackageCmd = func(pkg workloadPackage) (executor, error) {
+		if contains(pkg.wkldType, manifest.JobTypes) {
+			cmd, err := newPackageJobOpts(packageJobVars{
+				name:      pkg.name,
+				envName:   pkg.env,
+				appName:   opts.appName,
+				tag:       opts.tag,
+				outputDir: opts.outputDir,
+			})
+			if err != nil {
+				return nil, err
+			}
+			cmd.addonsURL = pkg.addonsURL
+			cmd.imageDigest = pkg.imageDigest
+			return cmd, nil
+		}
+		cmd, err := newPackageSvcOpts(packageSvcVars{
+			name:      pkg.name,
+			envName:   pkg.env,
+			appName:   opts.appName,
+			tag:       opts.tag,
+			outputDir: opts.outputDir,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cmd.addonsURL = pkg.addonsURL
+		cmd.imageDigest = pkg.imageDigest
+		return cmd, nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineBuildOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *pipelineBuildOpts) Ask() error {
+	return nil
+}
+
+// Execute upgrades the environments of the pipeline stages, then for every workload in the workspace and every stage
+// it builds and pushes the container image, uploads the addons template and writes the CloudFormation template
+// and its configuration to the output directory.
+func (o *pipelineBuildOpts) Execute() error {
+	if o.tag == "" {
+		o.tag = pipelineImageTag(o.buildID)
+	}
+	o.tag = imageTagFromGit(o.runner, o.tag) // Best effort assign git tag.
+
+	envs, err := o.stageEnvironments()
+	if err != nil {
+		return err
+	}
+	for _, env := range envs {
+		cmd, err := o.newEnvUpgradeCmd(env.Name)
+		if err != nil {
+			return fmt.Errorf("new env upgrade command: %v", err)
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, env.Name, err)
+		}
+	}
+	names, err := o.ws.WorkloadNames()
+	if err != nil {
+		return fmt.Errorf("list services and jobs in workspace: %w", err)
+	}
+	for _, name := range names {
+		if err := o.buildWorkload(name, envs); err != nil {
+			return err
+		}
+	}
+	log.Successf("Packaged %s for %s in %s.\n", strings.Join(names, ", "),
+		strings.Join(envNames(envs), ", "), color.HighlightResource(o.outputDir))
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *pipelineBuildOpts) RecommendActions() error {
+	return nil
+}
+
+// stageEnvironments returns the environments of the stages of the pipeline in the workspace, in order.
+func (o *pipelineBuildOpts) stageEnvironments() ([]*config.Environment, error) {
+	data, err := o.ws.ReadPipelineManifest()
+	if err != nil {
+		return nil, fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	pipeline, err := manifest.UnmarshalPipeline(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pipeline manifest: %w", err)
+	}
+	var envs []*config.Environment
+	for _, stage := range pipeline.Stages {
+		for _, name := range stage.EnvironmentNames() {
+			env, err := o.store.GetEnvironment(o.appName, name)
+			if err != nil {
+				return nil, fmt.Errorf("get environment %s in application %s: %w", name, o.appName, err)
+			}
+			envs = append(envs, env)
+		}
+	}
+	return envs, nil
+}
+
+func (o *pipelineBuildOpts) buildWorkload(name string, envs []*config.Environment) error {
+	wkld, err := o.store.GetWorkload(o.appName, name)
+	if err != nil {
+		return fmt.Errorf("get workload %s in application %s: %w", name, o.appName, err)
+	}
+	isJob := contains(wkld.Type, manifest.JobTypes)
+	var raw []byte
+	if isJob {
+		raw, err = o.ws.ReadJobManifest(name)
+	} else {
+		raw, err = o.ws.ReadServiceManifest(name)
+	}
+	if err != nil {
+		return fmt.Errorf("read manifest of %s: %w", name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+	}
+	addonsTpl, err := o.addonsTemplate(name)
+	if err != nil {
+		return err
+	}
+	addonsURLs := make(map[string]string) // Addons are uploaded once per region.
+	for _, env := range envs {
+		envMft, err := mft.ApplyEnv(env.Name)
+		if err != nil {
+			return fmt.Errorf("apply environment %s override to manifest of %s: %w", env.Name, name, err)
+		}
+		var required bool
+		if isJob {
+			required, err = manifest.JobDockerfileBuildRequired(envMft)
+		} else {
+			required, err = manifest.ServiceDockerfileBuildRequired(envMft)
+		}
+		if err != nil {
+			return err
+		}
+		pkg := workloadPackage{
+			name:     name,
+			wkldType: wkld.Type,
+			env:      env.Name,
+		}
+		if required {
+			if pkg.imageDigest, err = o.buildAndPushImage(name, env, envMft); err != nil {
+				return err
+			}
+		}
+		if addonsTpl != "" {
+			if _, ok := addonsURLs[env.Region]; !ok {
+				if addonsURLs[env.Region], err = o.uploadAddons(name, env.Region, addonsTpl); err != nil {
+					return err
+				}
+			}
+			pkg.addonsURL = addonsURLs[env.Region]
+		}
+		log.Infof("Packaging %s for environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(env.Name))
+		cmd, err := o.newPackageCmd(pkg)
+		if err != nil {
+			return fmt.Errorf("new package command for %s: %w", name, err)
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("package %s for environment %s: %w", name, env.Name, err)
+		}
+	}
+	return nil
+}
+
+func (o *pipelineBuildOpts) buildAndPushImage(name string, env *config.Environment, mft interface{}) (string, error) {
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return "", fmt.Errorf("get copilot directory: %w", err)
+	}
+	args, err := buildArgs(name, o.tag, copilotDir, mft)
+	if err != nil {
+		return "", err
+	}
+	repo, err := o.newRepository(name, env.Region)
+	if err != nil {
+		return "", fmt.Errorf("initiate image builder pusher: %w", err)
+	}
+	log.Infof("Building the image of %s for environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(env.Name))
+	digest, err := repo.BuildAndPush(o.dockerEngine, args)
+	if err != nil {
+		return "", fmt.Errorf("build and push image of %s for environment %s: %w", name, env.Name, err)
+	}
+	return digest, nil
+}
+
+// addonsTemplate returns the addons template of the workload, or the empty string if the workload doesn't have addons.
+func (o *pipelineBuildOpts) addonsTemplate(name string) (string, error) {
+	addons, err := o.newAddons(name)
+	if err != nil {
+		return "", fmt.Errorf("new addons client for %s: %w", name, err)
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve addons template of %s: %w", name, err)
+	}
+	return tpl, nil
+}
+
+// uploadAddons uploads the addons template of the workload to the artifact bucket of the region and returns its URL.
+func (o *pipelineBuildOpts) uploadAddons(name, region, tpl string) (string, error) {
+	resources, err := o.regionalResources(region)
+	if err != nil {
+		return "", err
+	}
+	uploader, err := o.newUploader(region)
+	if err != nil {
+		return "", err
+	}
+	url, err := uploader.PutArtifact(resources.S3Bucket, fmt.Sprintf(deploy.AddonsCfnTemplateNameFormat, name), strings.NewReader(tpl))
+	if err != nil {
+		return "", fmt.Errorf("put addons artifact to bucket %s: %w", resources.S3Bucket, err)
+	}
+	return url, nil
+}
+
+func (o *pipelineBuildOpts) regionalResources(region string) (*stack.AppRegionalResources, error) {
+	if resources, ok := o.appResources[region]; ok {
+		return resources, nil
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", o.appName, region, err)
+	}
+	o.appResources[region] = resources
+	return resources, nil
+}
+
+// pipelineImageTag returns the tag of the images built by the pipeline from the ID of the CodeBuild build.
+// Colons aren't allowed in Docker tags so they're replaced with dashes, and the tag is truncated from the front
+// to the maximum length of a Docker tag.
+func pipelineImageTag(buildID string) string {
+	tag := strings.ReplaceAll(buildID, ":", "-")
+	if len(tag) > maxDockerTagLength {
+		tag = tag[len(tag)-maxDockerTagLength:]
+	}
+	return tag
+}
+
+func envNames(envs []*config.Environment) []string {
+	var names []string
+	for _, env := range envs {
+		names = append(names, env.Name)
+	}
+	return names
+}
+
+// buildPipelineBuildCmd builds the command that the build stage of a pipeline runs.
+func buildPipelineBuildCmd() *cobra.Command {
+	vars := pipelineBuildVars{}
+	cmd := &cobra.Command{
+		Use:   "build",
+		Short: "Builds and packages the services and jobs in your workspace for every stage of the pipeline.",
+		Long: `Builds and packages the services and jobs in your workspace for every stage of the pipeline.
+For each environment in the pipeline manifest, the environment is upgraded, the container images
+are built and pushed to ECR, the addons templates are uploaded to the artifact bucket of the region,
+and the CloudFormation templates and their configuration are written to the output directory.
+This command is run by the build stage of your pipeline.`,
+		Example: `
+  Package every workload in the workspace for the stages of the pipeline in an "infrastructure/" directory.
+  /code $ copilot pipeline build
+  Tag the images with a custom tag and write the templates to a "out/" directory.
+  /code $ copilot pipeline build --tag v1.2.0 --output-dir ./out`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineBuildOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", pipelineBuildTagFlagDescription)
+	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, defaultPipelineBuildOutputDir, stackOutputDirFlagDescription)
+	return cmd
+}
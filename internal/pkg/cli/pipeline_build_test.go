@@ -0,0 +1,249 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineBuildMocks struct {
+	ws         *mocks.MockwsPipelineBuildReader
+	store      *mocks.Mockstore
+	appCFN     *mocks.MockappResourcesGetter
+	envUpgrade *mocks.Mockexecutor
+	pkg        *mocks.Mockexecutor
+	addons     map[string]*mocks.Mocktemplater
+	repos      map[string]*mocks.MockimageBuilderPusher
+	uploader   *mocks.MockartifactUploader
+}
+
+const (
+	testPipelineBuildManifest = `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+    - name: prod
+`
+	testPipelineBuildSvcManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+`
+	testPipelineBuildJobManifest = `name: report
+type: Scheduled Job
+image:
+  location: public.ecr.aws/report:latest
+on:
+  schedule: "@daily"
+`
+)
+
+func TestPipelineBuildOpts_Execute(t *testing.T) {
+	testError := errors.New("some error")
+	app := &config.Application{Name: "phonetool"}
+	mockEnvironments := func(m pipelineBuildMocks) {
+		m.ws.EXPECT().ReadPipelineManifest().Return([]byte(testPipelineBuildManifest), nil)
+		m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+		m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod", Region: "us-east-1"}, nil)
+	}
+	mockService := func(m pipelineBuildMocks) {
+		m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifest.BackendServiceType}, nil)
+		m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(testPipelineBuildSvcManifest), nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m pipelineBuildMocks)
+
+		wantedPackages []workloadPackage
+		wantedError    error
+	}{
+		"fails to read the pipeline manifest": {
+			setupMocks: func(m pipelineBuildMocks) {
+				m.ws.EXPECT().ReadPipelineManifest().Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("read pipeline manifest: %w", testError),
+		},
+		"fails to upgrade an environment": {
+			setupMocks: func(m pipelineBuildMocks) {
+				mockEnvironments(m)
+				m.envUpgrade.EXPECT().Execute().Return(testError)
+			},
+
+			wantedError: errors.New(`execute "env upgrade --app phonetool --name test": some error`),
+		},
+		"fails to build the image of a service": {
+			setupMocks: func(m pipelineBuildMocks) {
+				mockEnvironments(m)
+				m.envUpgrade.EXPECT().Execute().Return(nil).Times(2)
+				m.ws.EXPECT().WorkloadNames().Return([]string{"api"}, nil)
+				mockService(m)
+				m.addons["api"].EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.repos["us-west-2"].EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("build and push image of api for environment test: %w", testError),
+		},
+		"fails to package a workload": {
+			setupMocks: func(m pipelineBuildMocks) {
+				mockEnvironments(m)
+				m.envUpgrade.EXPECT().Execute().Return(nil).Times(2)
+				m.ws.EXPECT().WorkloadNames().Return([]string{"report"}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "report").Return(&config.Workload{Name: "report", Type: manifest.ScheduledJobType}, nil)
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte(testPipelineBuildJobManifest), nil)
+				m.addons["report"].EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+				m.pkg.EXPECT().Execute().Return(testError)
+			},
+
+			wantedPackages: []workloadPackage{
+				{name: "report", wkldType: manifest.ScheduledJobType, env: "test"},
+			},
+			wantedError: fmt.Errorf("package report for environment test: %w", testError),
+		},
+		"builds, uploads the addons and packages every workload for every stage": {
+			setupMocks: func(m pipelineBuildMocks) {
+				mockEnvironments(m)
+				m.envUpgrade.EXPECT().Execute().Return(nil).Times(2)
+				m.ws.EXPECT().WorkloadNames().Return([]string{"api", "report"}, nil)
+				mockService(m)
+				m.addons["api"].EXPECT().Template().Return("Resources: {}", nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil).Times(2)
+				m.repos["us-west-2"].EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:west", nil)
+				m.repos["us-east-1"].EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:east", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(app, nil).Times(2)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "west-bucket"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(app, "us-east-1").Return(&stack.AppRegionalResources{S3Bucket: "east-bucket"}, nil)
+				m.uploader.EXPECT().PutArtifact("west-bucket", "api.addons.stack.yml", gomock.Any()).Return("https://west/api.addons.stack.yml", nil)
+				m.uploader.EXPECT().PutArtifact("east-bucket", "api.addons.stack.yml", gomock.Any()).Return("https://east/api.addons.stack.yml", nil)
+
+				m.store.EXPECT().GetWorkload("phonetool", "report").Return(&config.Workload{Name: "report", Type: manifest.ScheduledJobType}, nil)
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte(testPipelineBuildJobManifest), nil)
+				m.addons["report"].EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+
+				m.pkg.EXPECT().Execute().Return(nil).Times(4)
+			},
+
+			wantedPackages: []workloadPackage{
+				{name: "api", wkldType: manifest.BackendServiceType, env: "test", addonsURL: "https://west/api.addons.stack.yml", imageDigest: "sha256:west"},
+				{name: "api", wkldType: manifest.BackendServiceType, env: "prod", addonsURL: "https://east/api.addons.stack.yml", imageDigest: "sha256:east"},
+				{name: "report", wkldType: manifest.ScheduledJobType, env: "test"},
+				{name: "report", wkldType: manifest.ScheduledJobType, env: "prod"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineBuildMocks{
+				ws:         mocks.NewMockwsPipelineBuildReader(ctrl),
+				store:      mocks.NewMockstore(ctrl),
+				appCFN:     mocks.NewMockappResourcesGetter(ctrl),
+				envUpgrade: mocks.NewMockexecutor(ctrl),
+				pkg:        mocks.NewMockexecutor(ctrl),
+				addons: map[string]*mocks.Mocktemplater{
+					"api":    mocks.NewMocktemplater(ctrl),
+					"report": mocks.NewMocktemplater(ctrl),
+				},
+				repos: map[string]*mocks.MockimageBuilderPusher{
+					"us-west-2": mocks.NewMockimageBuilderPusher(ctrl),
+					"us-east-1": mocks.NewMockimageBuilderPusher(ctrl),
+				},
+				uploader: mocks.NewMockartifactUploader(ctrl),
+			}
+			tc.setupMocks(m)
+			var packages []workloadPackage
+
+			opts := &pipelineBuildOpts{
+				pipelineBuildVars: pipelineBuildVars{
+					appName:   "phonetool",
+					outputDir: "infrastructure",
+				},
+				ws:        m.ws,
+				store:     m.store,
+				appCFN:    m.appCFN,
+				unmarshal: manifest.UnmarshalWorkload,
+				buildID:   "phonetool-build:1234",
+				newAddons: func(wkld string) (templater, error) {
+					return m.addons[wkld], nil
+				},
+				newRepository: func(wkld, region string) (imageBuilderPusher, error) {
+					return m.repos[region], nil
+				},
+				newUploader: func(region string) (artifactUploader, error) {
+					return m.uploader, nil
+				},
+				newEnvUpgradeCmd: func(env string) (executor, error) {
+					return m.envUpgrade, nil
+				},
+				newPackageCmd: func(pkg workloadPackage) (executor, error) {
+					packages = append(packages, pkg)
+					return m.pkg, nil
+				},
+				appResources: make(map[string]*stack.AppRegionalResources),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, "phonetool-build-1234", opts.tag)
+			require.Equal(t, tc.wantedPackages, packages)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPipelineImageTag(t *testing.T) {
+	testCases := map[string]struct {
+		inBuildID string
+
+		wanted string
+	}{
+		"replaces colons with dashes": {
+			inBuildID: "pipepiper-BuildProject:3fd1c2a5-1a4e-4c9c-b2a1-2f4a9c0e8d7b",
+
+			wanted: "pipepiper-BuildProject-3fd1c2a5-1a4e-4c9c-b2a1-2f4a9c0e8d7b",
+		},
+		"truncates the tag from the front": {
+			inBuildID: strings.Repeat("a", 10) + strings.Repeat("b", 128),
+
+			wanted: strings.Repeat("b", 128),
+		},
+		"empty outside of CodeBuild": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, pipelineImageTag(tc.inBuildID))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineHistoryAppNamePrompt          = "Which application's pipeline history would you like to show?"
+	pipelineHistoryAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineHistoryPipelineNamePrompt  = "Which pipeline of %s would you like to show the history of?"
+	pipelineHistoryPipelineNameHelpPrompt = "Displays the commit, trigger, duration and failed actions of the pipeline's recent executions."
+)
+
+type pipelineHistoryVars struct {
+	appName          string
+	pipelineName     string
+	limit            int
+	shouldOutputJSON bool
+}
+
+type pipelineHistoryOpts struct {
+	pipelineHistoryVars
+
+	w             io.Writer
+	ws            wsPipelineReader
+	store         store
+	describer     describer
+	sel           deployedPipelineSelector
+	initDescriber func(*pipelineHistoryOpts) error
+}
+
+func newPipelineHistoryOpts(vars pipelineHistoryVars) (*pipelineHistoryOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	return &pipelineHistoryOpts{
+		pipelineHistoryVars: vars,
+		w:                   log.OutputWriter,
+		ws:                  ws,
+		store:               store,
+		sel:                 selector.NewPipelineSelect(prompt.New(), store, codepipeline.New(sess)),
+		initDescriber: func(o *pipelineHistoryOpts) error {
+			d, err := describe.NewPipelineHistoryDescriber(o.pipelineName, o.limit)
+			if err != nil {
+				return fmt.Errorf("new pipeline history describer: %w", err)
+			}
+			o.describer = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineHistoryOpts) Validate() error {
+	if o.limit < 1 || o.limit > describe.MaxPipelineHistoryLimit {
+		return fmt.Errorf("--%s must be between 1 and %d", limitFlag, describe.MaxPipelineHistoryLimit)
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *pipelineHistoryOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(pipelineHistoryAppNamePrompt, pipelineHistoryAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := askDeployedPipelineName(o.ws, o.sel, o.appName,
+		fmt.Sprintf(fmtPipelineHistoryPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineHistoryPipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// Execute displays the recent executions of the pipeline.
+func (o *pipelineHistoryOpts) Execute() error {
+	if err := o.initDescriber(o); err != nil {
+		return err
+	}
+	history, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe execution history of pipeline %s: %w", o.pipelineName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := history.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, history.HumanString())
+	}
+	return nil
+}
+
+// buildPipelineHistoryCmd builds the command for showing the recent executions of a pipeline.
+func buildPipelineHistoryCmd() *cobra.Command {
+	vars := pipelineHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows the recent executions of a pipeline.",
+		Long: `Shows the recent executions of a pipeline.
+Each execution lists its commit, what triggered it, how long it took and the actions that failed.`,
+
+		Example: `
+  Shows the last 10 executions of the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline history -n pipeline-myapp-myrepo
+  Shows the last 3 executions in JSON format.
+  /code $ copilot pipeline history -n pipeline-myapp-myrepo --limit 3 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, describe.DefaultPipelineHistoryLimit, pipelineHistoryLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineHistory_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp     string
+		inLimit   int
+		mockStore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"limit of zero": {
+			inLimit:   0,
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--limit must be between 1 and 100"),
+		},
+		"limit over the maximum": {
+			inLimit:   101,
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--limit must be between 1 and 100"),
+		},
+		"invalid application": {
+			inApp:   "phonetool",
+			inLimit: 10,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			inApp:   "phonetool",
+			inLimit: 3,
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+			opts := &pipelineHistoryOpts{
+				pipelineHistoryVars: pipelineHistoryVars{
+					appName: tc.inApp,
+					limit:   tc.inLimit,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPipelineHistory_Execute(t *testing.T) {
+	history := &describe.PipelineHistory{
+		Executions: []*codepipeline.Execution{
+			{
+				ID:     "1234",
+				Status: "Succeeded",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockDescriber    func(m *mocks.Mockdescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if fails to describe the history": {
+			mockDescriber: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe execution history of pipeline pipeline-phonetool-repo: some error"),
+		},
+		"writes json output": {
+			shouldOutputJSON: true,
+			mockDescriber: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(history, nil)
+			},
+			wantedContent: mustJSONString(t, history),
+		},
+		"writes human output": {
+			mockDescriber: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(history, nil)
+			},
+			wantedContent: history.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+			b := &bytes.Buffer{}
+			opts := &pipelineHistoryOpts{
+				pipelineHistoryVars: pipelineHistoryVars{
+					pipelineName:     "pipeline-phonetool-repo",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w: b,
+				initDescriber: func(o *pipelineHistoryOpts) error {
+					o.describer = mockDescriber
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func mustJSONString(t *testing.T, s describe.HumanJSONStringer) string {
+	data, err := s.JSONString()
+	require.NoError(t, err)
+	return data
+}
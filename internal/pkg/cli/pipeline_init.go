@@ -23,10 +23,11 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
-	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -42,6 +43,16 @@ const (
 	pipelineSelectURLHelpPrompt = `The repository linked to your pipeline.
 Pushing to this repository will trigger your pipeline build stage.
 Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`
+
+	pipelineECRSourceOption = "Amazon ECR repository"
+	pipelineS3SourceOption  = "Amazon S3 object"
+
+	pipelineECRURIPrompt     = "What is the URI of the Amazon ECR repository?"
+	pipelineECRURIHelpPrompt = `Pushing an image with the tag to this repository will trigger your pipeline, which deploys the image without a build stage.
+The repository must be the one of a service or job of the application, e.g. "123456789012.dkr.ecr.us-west-2.amazonaws.com/myApp/mySvc:latest".`
+	pipelineS3URIPrompt     = "What is the URI of the Amazon S3 object?"
+	pipelineS3URIHelpPrompt = `Uploading a zip archive of your workspace to this object will trigger your pipeline build stage.
+The bucket must be versioned, e.g. "s3://my-bucket/myApp/workspace.zip".`
 )
 
 const (
@@ -61,6 +72,11 @@ const (
 	bbURL           = "bitbucket.org"
 	defaultBBBranch = "main"
 	fmtBBRepoURL    = "https://%s/%s/%s" // Ex: "https://bitbucket.org/repoOwner/repoName"
+	// For an ECR repository.
+	ecrIdentifier = ".dkr.ecr."
+	defaultECRTag = "latest"
+	// For an S3 object.
+	s3URIPrefix = "s3://"
 )
 
 var (
@@ -84,17 +100,22 @@ type initPipelineOpts struct {
 	parser         template.Parser
 	runner         runner
 	sessProvider   sessionProvider
-	cfnClient      appResourcesGetter
 	store          store
 	prompt         prompter
 	sel            pipelineSelector
 
+	// Returns whether the application is deployed in the region, i.e. whether the stack of the application is in the region.
+	isAppRegion func(app, region string) (bool, error)
+
 	// Outputs stored on successful actions.
 	secret    string
 	provider  string
 	repoName  string
 	repoOwner string
 	ccRegion  string
+	ecrRepo   string
+	imageTag  string
+	objectKey string
 
 	// Caches variables
 	fs         *afero.Afero
@@ -102,12 +123,6 @@ type initPipelineOpts struct {
 	envConfigs []*config.Environment
 }
 
-type artifactBucket struct {
-	BucketName   string
-	Region       string
-	Environments []string
-}
-
 func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 	ws, err := workspace.New()
 	if err != nil {
@@ -119,12 +134,6 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		return nil, fmt.Errorf("new secretsmanager client: %w", err)
 	}
 
-	p := sessions.NewProvider()
-	defaultSession, err := p.Default()
-	if err != nil {
-		return nil, err
-	}
-
 	ssmStore, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store client: %w", err)
@@ -137,13 +146,26 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		workspace:        ws,
 		secretsmanager:   secretsmanager,
 		parser:           template.New(),
-		sessProvider:     p,
-		cfnClient:        cloudformation.New(defaultSession),
+		sessProvider:     sessions.NewProvider(),
 		store:            ssmStore,
 		prompt:           prompter,
 		sel:              selector.NewSelect(prompter, ssmStore),
 		runner:           exec.NewCmd(),
 		fs:               &afero.Afero{Fs: afero.NewOsFs()},
+		isAppRegion: func(app, region string) (bool, error) {
+			sess, err := sessions.NewProvider().DefaultWithRegion(region)
+			if err != nil {
+				return false, fmt.Errorf("retrieve session for region %s: %w", region, err)
+			}
+			if _, err := awscfn.New(sess).Describe(stack.NameForAppStack(app)); err != nil {
+				var errNotFound *awscfn.ErrStackNotFound
+				if errors.As(err, &errNotFound) {
+					return false, nil
+				}
+				return false, fmt.Errorf("describe stack of application %s in %s: %w", app, region, err)
+			}
+			return true, nil
+		},
 	}, nil
 }
 
@@ -200,6 +222,10 @@ func (o *initPipelineOpts) Execute() error {
 	if err := o.createPipelineManifest(); err != nil {
 		return err
 	}
+	if o.provider == manifest.ECRProviderName {
+		// The image is built outside of the pipeline, there is no build stage.
+		return nil
+	}
 	if err := o.createBuildspec(); err != nil {
 		return err
 	}
@@ -208,6 +234,12 @@ func (o *initPipelineOpts) Execute() error {
 
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
+	if o.provider == manifest.ECRProviderName {
+		return []string{
+			fmt.Sprintf("Commit and push the %s and %s files of your %s directory to your repository.", color.HighlightResource("pipeline.yml"), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
+			fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline update")),
+		}
+	}
 	return []string{
 		fmt.Sprintf("Commit and push the %s, %s, and %s files of your %s directory to your repository.", color.HighlightResource("buildspec.yml"), color.HighlightResource("pipeline.yml"), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
 		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline update")),
@@ -217,7 +249,8 @@ func (o *initPipelineOpts) RequiredActions() []string {
 func (o *initPipelineOpts) validateURL(url string) error {
 	// Note: no longer calling `validateDomainName` because if users use git-remote-codecommit
 	// (the HTTPS (GRC) protocol) to connect to CodeCommit, the url does not have any periods.
-	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) {
+	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) &&
+		!strings.Contains(url, ecrIdentifier) && !strings.HasPrefix(url, s3URIPrefix) {
 		return fmt.Errorf("must be a URL to a supported provider (%s)", strings.Join(manifest.PipelineProviders, ", "))
 	}
 	return nil
@@ -256,6 +289,10 @@ func (o *initPipelineOpts) askRepository() error {
 	}
 
 	switch {
+	case strings.Contains(o.repoURL, ecrIdentifier):
+		return o.parseECRRepoDetails()
+	case strings.HasPrefix(o.repoURL, s3URIPrefix):
+		return o.parseS3ObjectDetails()
 	case strings.Contains(o.repoURL, githubURL):
 		return o.askGitHubRepoDetails()
 	case strings.Contains(o.repoURL, ccIdentifier):
@@ -327,6 +364,38 @@ func (o *initPipelineOpts) parseBitbucketRepoDetails() error {
 	return nil
 }
 
+func (o *initPipelineOpts) parseECRRepoDetails() error {
+	o.provider = manifest.ECRProviderName
+	repoDetails, err := ecrRepoURI(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	// Pipelines are named after the workload whose image is stored in the repository.
+	o.repoName = repoDetails.name[strings.LastIndex(repoDetails.name, "/")+1:]
+	o.ecrRepo = repoDetails.name
+	o.imageTag = repoDetails.tag
+
+	ok, err := o.isAppRegion(o.appName, repoDetails.region)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("repository %s is in %s, but app %s is not; they must be in the same region", repoDetails.name, repoDetails.region, o.appName)
+	}
+	return nil
+}
+
+func (o *initPipelineOpts) parseS3ObjectDetails() error {
+	o.provider = manifest.S3ProviderName
+	objectDetails, err := s3ObjectURI(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = objectDetails.bucket
+	o.objectKey = objectDetails.key
+	return nil
+}
+
 func (o *initPipelineOpts) selectURL() error {
 	// Fetches and parses all remote repositories.
 	err := o.runner.Run("git", []string{"remote", "-v"}, exec.Stdout(&o.buffer))
@@ -343,12 +412,24 @@ func (o *initPipelineOpts) selectURL() error {
 	url, err := o.prompt.SelectOne(
 		pipelineSelectURLPrompt,
 		pipelineSelectURLHelpPrompt,
-		urls,
+		append(urls, pipelineECRSourceOption, pipelineS3SourceOption),
 		prompt.WithFinalMessage("Repository URL:"),
 	)
 	if err != nil {
 		return fmt.Errorf("select URL: %w", err)
 	}
+	switch url {
+	case pipelineECRSourceOption:
+		if url, err = o.prompt.Get(pipelineECRURIPrompt, pipelineECRURIHelpPrompt, validateECRRepositoryURI,
+			prompt.WithFinalMessage("Repository URI:")); err != nil {
+			return fmt.Errorf("get ECR repository URI: %w", err)
+		}
+	case pipelineS3SourceOption:
+		if url, err = o.prompt.Get(pipelineS3URIPrompt, pipelineS3URIHelpPrompt, validateS3ObjectURI,
+			prompt.WithFinalMessage("Object URI:")); err != nil {
+			return fmt.Errorf("get S3 object URI: %w", err)
+		}
+	}
 	if err := o.validateURL(url); err != nil {
 		return err
 	}
@@ -406,6 +487,19 @@ type bbRepoDetails struct {
 	owner string
 }
 
+type ecrRepoURI string
+type ecrRepoDetails struct {
+	name   string
+	region string
+	tag    string
+}
+
+type s3ObjectURI string
+type s3ObjectDetails struct {
+	bucket string
+	key    string
+}
+
 func (url ghRepoURL) parse() (ghRepoDetails, error) {
 	urlString := string(url)
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
@@ -474,6 +568,42 @@ func (url bbRepoURL) parse() (bbRepoDetails, error) {
 	}, nil
 }
 
+// ECR repository URIs look like:
+// 123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc
+// 123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:release
+func (uri ecrRepoURI) parse() (ecrRepoDetails, error) {
+	uriString := strings.TrimPrefix(string(uri), "https://")
+	parts := strings.SplitN(uriString, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return ecrRepoDetails{}, fmt.Errorf("unable to parse the ECR repository name from %s", uri)
+	}
+	// The registry is formatted as "{account}.dkr.ecr.{region}.amazonaws.com".
+	registry := strings.Split(parts[0], ".")
+	if len(registry) < 5 {
+		return ecrRepoDetails{}, fmt.Errorf("unable to parse the AWS region from %s", uri)
+	}
+	name, tag := parts[1], defaultECRTag
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		name, tag = name[:i], name[i+1:]
+	}
+	return ecrRepoDetails{
+		name:   name,
+		region: registry[3],
+		tag:    tag,
+	}, nil
+}
+
+func (uri s3ObjectURI) parse() (s3ObjectDetails, error) {
+	parts := strings.SplitN(strings.TrimPrefix(string(uri), s3URIPrefix), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return s3ObjectDetails{}, fmt.Errorf("unable to parse the S3 bucket and object key from %s: please pass the object URI with the format `--url s3://{bucket}/{key}`", uri)
+	}
+	return s3ObjectDetails{
+		bucket: parts[0],
+		key:    parts[1],
+	}, nil
+}
+
 func (o *initPipelineOpts) storeGitHubAccessToken() error {
 	secretName := o.secretName()
 	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
@@ -542,18 +672,12 @@ Update the file to add additional stages, change the branch to be tracked, or ad
 }
 
 func (o *initPipelineOpts) createBuildspec() error {
-	artifactBuckets, err := o.artifactBuckets()
-	if err != nil {
-		return err
-	}
 	content, err := o.parser.Parse(buildspecTemplatePath, struct {
 		BinaryS3BucketPath string
 		Version            string
-		ArtifactBuckets    []artifactBucket
 	}{
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
-		ArtifactBuckets:    artifactBuckets,
 	})
 	if err != nil {
 		return err
@@ -620,40 +744,22 @@ func (o *initPipelineOpts) pipelineProvider() (manifest.Provider, error) {
 			RepositoryURL: fmt.Sprintf(fmtBBRepoURL, bbURL, o.repoOwner, o.repoName),
 			Branch:        o.repoBranch,
 		}
+	case manifest.ECRProviderName:
+		config = &manifest.ECRProperties{
+			Repository: o.ecrRepo,
+			Tag:        o.imageTag,
+		}
+	case manifest.S3ProviderName:
+		config = &manifest.S3Properties{
+			Bucket:    o.repoName,
+			ObjectKey: o.objectKey,
+		}
 	default:
 		return nil, fmt.Errorf("unable to create pipeline source provider for %s", o.repoName)
 	}
 	return manifest.NewProvider(config)
 }
 
-func (o *initPipelineOpts) artifactBuckets() ([]artifactBucket, error) {
-	app, err := o.store.GetApplication(o.appName)
-	if err != nil {
-		return nil, fmt.Errorf("get application %s: %w", o.appName, err)
-	}
-	regionalResources, err := o.cfnClient.GetRegionalAppResources(app)
-	if err != nil {
-		return nil, fmt.Errorf("get regional application resources: %w", err)
-	}
-
-	var buckets []artifactBucket
-	for _, resource := range regionalResources {
-		var envNames []string
-		for _, env := range o.envConfigs {
-			if env.Region == resource.Region {
-				envNames = append(envNames, env.Name)
-			}
-		}
-		bucket := artifactBucket{
-			BucketName:   resource.S3Bucket,
-			Region:       resource.Region,
-			Environments: envNames,
-		}
-		buckets = append(buckets, bucket)
-	}
-	return buckets, nil
-}
-
 // buildPipelineInitCmd build the command for creating a new pipeline.
 func buildPipelineInitCmd() *cobra.Command {
 	vars := initPipelineVars{}
@@ -15,7 +15,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
-	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -53,7 +52,7 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
 			},
 
-			expectedError: errors.New("must be a URL to a supported provider (GitHub, CodeCommit, Bitbucket)"),
+			expectedError: errors.New("must be a URL to a supported provider (GitHub, CodeCommit, Bitbucket, ECR, S3)"),
 		},
 		"invalid environments": {
 			inAppName: "my-app",
@@ -158,6 +157,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		inRepoURL           string
 		inGitHubAccessToken string
 		inGitBranch         string
+		inAppRegion         string
 
 		mockPrompt       func(m *mocks.Mockprompter)
 		mockRunner       func(m *mocks.Mockrunner)
@@ -173,6 +173,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		expectedGitHubOwner       string
 		expectedGitHubAccessToken string
 		expectedCodeCommitRegion  string
+		expectedImageTag          string
+		expectedObjectKey         string
 		expectedError             error
 	}{
 		"no flags, prompts for all input, success case for GitHub": {
@@ -323,7 +325,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			mockSessProvider: func(m *mocks.MocksessionProvider) {},
 
-			expectedError: fmt.Errorf("must be a URL to a supported provider (GitHub, CodeCommit, Bitbucket)"),
+			expectedError: fmt.Errorf("must be a URL to a supported provider (GitHub, CodeCommit, Bitbucket, ECR, S3)"),
 		},
 		"returns error if fail to parse GitHub URL": {
 			inEnvironments:      []string{},
@@ -349,7 +351,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 			mockPrompt: func(m *mocks.Mockprompter) {
-				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubReallyBadURL}, gomock.Any()).Return(githubReallyBadURL, nil).Times(1)
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{githubReallyBadURL, pipelineECRSourceOption, pipelineS3SourceOption}, gomock.Any()).Return(githubReallyBadURL, nil).Times(1)
 			},
 			mockSessProvider: func(m *mocks.MocksessionProvider) {},
 
@@ -451,6 +453,70 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			expectedEnvironments: []string{},
 			expectedError:        fmt.Errorf("retrieve default session: some error"),
 		},
+		"prompts for the URI of an ECR repository": {
+			inEnvironments: []string{"test"},
+			buffer:         *bytes.NewBufferString("archer\tgit@github.com:goodGoose/bhaOS (fetch)\n"),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), []string{"git@github.com:goodGoose/bhaOS", pipelineECRSourceOption, pipelineS3SourceOption}, gomock.Any()).Return(pipelineECRSourceOption, nil)
+				m.EXPECT().Get(pipelineECRURIPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:release", nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+			inAppRegion:      "us-west-2",
+
+			expectedRepoName:     "api",
+			expectedImageTag:     "release",
+			expectedEnvironments: []string{"test"},
+		},
+		"returns error if the ECR repository is not in the app's region": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "123456789012.dkr.ecr.eu-west-1.amazonaws.com/my-app/api",
+			buffer:         *bytes.NewBufferString(""),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				}, nil)
+			},
+			mockRunner:       func(m *mocks.Mockrunner) {},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+			inAppRegion:      "us-west-2",
+
+			expectedError: fmt.Errorf("repository my-app/api is in eu-west-1, but app my-app is not; they must be in the same region"),
+		},
+		"success case for an S3 object": {
+			inEnvironments: []string{"test"},
+			inRepoURL:      "s3://my-sources/my-app/workspace.zip",
+			buffer:         *bytes.NewBufferString(""),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				}, nil)
+			},
+			mockRunner:       func(m *mocks.Mockrunner) {},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedRepoName:     "my-sources",
+			expectedObjectKey:    "my-app/workspace.zip",
+			expectedEnvironments: []string{"test"},
+		},
 		"returns error if repo region is not app's region": {
 			buffer: *bytes.NewBufferString(""),
 
@@ -512,6 +578,9 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				buffer:       tc.buffer,
 				sel:          mockSelector,
 				store:        mockStore,
+				isAppRegion: func(app, region string) (bool, error) {
+					return region == tc.inAppRegion, nil
+				},
 			}
 
 			tc.mockPrompt(mockPrompt)
@@ -532,6 +601,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.expectedGitHubOwner, opts.repoOwner)
 				require.Equal(t, tc.expectedGitHubAccessToken, opts.githubAccessToken)
 				require.Equal(t, tc.expectedCodeCommitRegion, opts.ccRegion)
+				require.Equal(t, tc.expectedImageTag, opts.imageTag)
+				require.Equal(t, tc.expectedObjectKey, opts.objectKey)
 				require.ElementsMatch(t, tc.expectedEnvironments, opts.environments)
 			}
 		})
@@ -550,12 +621,10 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inBranch       string
 		inAppName      string
 
-		mockSecretsManager          func(m *mocks.MocksecretsManager)
-		mockWsWriter                func(m *mocks.MockwsPipelineWriter)
-		mockParser                  func(m *templatemocks.MockParser)
-		mockFileSystem              func(mockFS afero.Fs)
-		mockRegionalResourcesGetter func(m *mocks.MockappResourcesGetter)
-		mockStoreSvc                func(m *mocks.Mockstore)
+		mockSecretsManager func(m *mocks.MocksecretsManager)
+		mockWsWriter       func(m *mocks.MockwsPipelineWriter)
+		mockParser         func(m *templatemocks.MockParser)
+		mockFileSystem     func(mockFS afero.Fs)
 
 		expectedError error
 	}{
@@ -584,21 +653,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 			expectedError: nil,
 		},
 		"writes manifest and buildspec for GH(v2) provider": {
@@ -623,21 +677,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 			expectedError: nil,
 		},
 		"writes manifest and buildspec for CC provider": {
@@ -662,21 +701,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 			expectedError: nil,
 		},
 		"writes manifest and buildspec for BB provider": {
@@ -701,21 +725,25 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
+			expectedError: nil,
+		},
+		"writes manifest without buildspec for ECR provider": {
+			inProvider: "ECR",
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+					Prod: false,
+				},
 			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
+			inRepoName: "api",
+			inAppName:  "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Times(0)
 			},
+			mockParser:    func(m *templatemocks.MockParser) {},
 			expectedError: nil,
 		},
 		"does not return an error if secret already exists": {
@@ -744,21 +772,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 
 			expectedError: nil,
 		},
@@ -781,68 +794,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
 				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", errors.New("some error"))
 			},
-			mockParser:                  func(m *templatemocks.MockParser) {},
-			mockStoreSvc:                func(m *mocks.Mockstore) {},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
-			expectedError:               errors.New("write pipeline manifest to workspace: some error"),
-		},
-		"returns an error if application cannot be retrieved": {
-			inProvider: "GitHubV1",
-			inEnvConfigs: []*config.Environment{
-				{
-					Name: "test",
-					Prod: false,
-				},
-			},
-			inGitHubToken: "hunter2",
-			inRepoName:    "goose",
-			inBranch:      "dev",
-			inAppName:     "badgoose",
-
-			mockSecretsManager: func(m *mocks.MocksecretsManager) {
-				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
-			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-			},
-			mockParser: func(m *templatemocks.MockParser) {},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(nil, errors.New("some error"))
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
-			expectedError:               errors.New("get application badgoose: some error"),
-		},
-		"returns an error if can't get regional application resources": {
-			inProvider: "GitHubV1",
-			inEnvConfigs: []*config.Environment{
-				{
-					Name: "test",
-					Prod: false,
-				},
-			},
-			inGitHubToken: "hunter2",
-			inRepoName:    "goose",
-			inBranch:      "dev",
-			inAppName:     "badgoose",
-
-			mockSecretsManager: func(m *mocks.MocksecretsManager) {
-				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
-			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-			},
-			mockParser: func(m *templatemocks.MockParser) {},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return(nil, errors.New("some error"))
-			},
-			expectedError: fmt.Errorf("get regional application resources: some error"),
+			mockParser:    func(m *templatemocks.MockParser) {},
+			expectedError: errors.New("write pipeline manifest to workspace: some error"),
 		},
 		"returns an error if buildspec cannot be parsed": {
 			inProvider: "GitHubV1",
@@ -867,21 +820,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 			expectedError: errors.New("some error"),
 		},
 		"does not return an error if buildspec and manifest already exists": {
@@ -909,21 +847,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 			expectedError: nil,
 		},
 		"returns an error if can't write buildspec": {
@@ -951,21 +874,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					Buffer: bytes.NewBufferString("hello"),
 				}, nil)
 			},
-			mockStoreSvc: func(m *mocks.Mockstore) {
-				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
-					Name: "badgoose",
-				}, nil)
-			},
-			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
-				m.EXPECT().GetRegionalAppResources(&config.Application{
-					Name: "badgoose",
-				}).Return([]*stack.AppRegionalResources{
-					{
-						Region:   "us-west-2",
-						S3Bucket: "gooseBucket",
-					},
-				}, nil)
-			},
 			expectedError: fmt.Errorf("write buildspec to workspace: some error"),
 		},
 	}
@@ -979,14 +887,10 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager := mocks.NewMocksecretsManager(ctrl)
 			mockWriter := mocks.NewMockwsPipelineWriter(ctrl)
 			mockParser := templatemocks.NewMockParser(ctrl)
-			mockRegionalResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
-			mockstore := mocks.NewMockstore(ctrl)
 
 			tc.mockSecretsManager(mockSecretsManager)
 			tc.mockWsWriter(mockWriter)
 			tc.mockParser(mockParser)
-			tc.mockRegionalResourcesGetter(mockRegionalResourcesGetter)
-			tc.mockStoreSvc(mockstore)
 			memFs := &afero.Afero{Fs: afero.NewMemMapFs()}
 
 			opts := &initPipelineOpts{
@@ -996,8 +900,6 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				},
 
 				secretsmanager: mockSecretsManager,
-				cfnClient:      mockRegionalResourcesGetter,
-				store:          mockstore,
 				workspace:      mockWriter,
 				parser:         mockParser,
 				fs:             memFs,
@@ -1244,3 +1146,90 @@ func TestInitPipelineBBRepoURL_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestInitPipelineECRRepoURI_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inRepoURI ecrRepoURI
+
+		expectedDetails ecrRepoDetails
+		expectedError   error
+	}{
+		"successfully parses uri without tag": {
+			inRepoURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+
+			expectedDetails: ecrRepoDetails{
+				name:   "my-app/api",
+				region: "us-west-2",
+				tag:    "latest",
+			},
+			expectedError: nil,
+		},
+		"successfully parses uri with tag": {
+			inRepoURI: "123456789012.dkr.ecr.eu-west-1.amazonaws.com/my-app/api:release",
+
+			expectedDetails: ecrRepoDetails{
+				name:   "my-app/api",
+				region: "eu-west-1",
+				tag:    "release",
+			},
+			expectedError: nil,
+		},
+		"errors if the uri has no repository": {
+			inRepoURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+
+			expectedError: fmt.Errorf("unable to parse the ECR repository name from 123456789012.dkr.ecr.us-west-2.amazonaws.com"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := ecrRepoURI.parse(tc.inRepoURI)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
+
+func TestInitPipelineS3ObjectURI_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inObjectURI s3ObjectURI
+
+		expectedDetails s3ObjectDetails
+		expectedError   error
+	}{
+		"successfully parses uri": {
+			inObjectURI: "s3://my-sources/my-app/workspace.zip",
+
+			expectedDetails: s3ObjectDetails{
+				bucket: "my-sources",
+				key:    "my-app/workspace.zip",
+			},
+			expectedError: nil,
+		},
+		"errors if the uri has no object key": {
+			inObjectURI: "s3://my-sources",
+
+			expectedError: fmt.Errorf("unable to parse the S3 bucket and object key from s3://my-sources: please pass the object URI with the format `--url s3://{bucket}/{key}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := s3ObjectURI.parse(tc.inObjectURI)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRetryAppNamePrompt          = "Which application's pipeline would you like to retry?"
+	pipelineRetryAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineRetryPipelineNamePrompt  = "Which pipeline of %s would you like to retry?"
+	pipelineRetryPipelineNameHelpPrompt = "The failed actions of a stage in the latest execution of the pipeline are retried."
+	fmtPipelineRetryStagePrompt         = "Which stage of %s would you like to retry?"
+	pipelineRetryStageHelpPrompt        = "Only the actions that failed in the stage are run again."
+)
+
+type pipelineRetryVars struct {
+	appName      string
+	pipelineName string
+	stageName    string
+}
+
+type pipelineRetryOpts struct {
+	pipelineRetryVars
+
+	ws          wsPipelineReader
+	store       store
+	pipelineSvc pipelineGetter
+	retrier     pipelineStageRetrier
+	sel         deployedPipelineSelector
+	prompt      prompter
+}
+
+func newPipelineRetryOpts(vars pipelineRetryVars) (*pipelineRetryOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	cp := codepipeline.New(sess)
+	prompter := prompt.New()
+	return &pipelineRetryOpts{
+		pipelineRetryVars: vars,
+		ws:                ws,
+		store:             store,
+		pipelineSvc:       cp,
+		retrier:           cp,
+		sel:               selector.NewPipelineSelect(prompter, store, cp),
+		prompt:            prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineRetryOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.pipelineName == "" || o.stageName == "" {
+		return nil
+	}
+	stages, err := o.stageNames()
+	if err != nil {
+		return err
+	}
+	for _, stage := range stages {
+		if stage == o.stageName {
+			return nil
+		}
+	}
+	return fmt.Errorf("stage %s does not exist in pipeline %s", o.stageName, o.pipelineName)
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *pipelineRetryOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.pipelineName == "" {
+		name, err := askDeployedPipelineName(o.ws, o.sel, o.appName,
+			fmt.Sprintf(fmtPipelineRetryPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineRetryPipelineNameHelpPrompt)
+		if err != nil {
+			return err
+		}
+		o.pipelineName = name
+	}
+	if o.stageName != "" {
+		return nil
+	}
+	stages, err := o.stageNames()
+	if err != nil {
+		return err
+	}
+	stage, err := o.prompt.SelectOne(
+		fmt.Sprintf(fmtPipelineRetryStagePrompt, color.HighlightUserInput(o.pipelineName)),
+		pipelineRetryStageHelpPrompt,
+		stages,
+		prompt.WithFinalMessage("Stage:"),
+	)
+	if err != nil {
+		return fmt.Errorf("select stage of pipeline %s: %w", o.pipelineName, err)
+	}
+	o.stageName = stage
+	return nil
+}
+
+// Execute retries the failed actions of the stage in the latest execution of the pipeline.
+func (o *pipelineRetryOpts) Execute() error {
+	if err := o.retrier.RetryStageExecution(o.pipelineName, o.stageName); err != nil {
+		return err
+	}
+	log.Successf("Retrying the failed actions of stage %s in pipeline %s.\n",
+		color.HighlightUserInput(o.stageName), color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+// RecommendActions logs follow-up commands to inspect the pipeline.
+func (o *pipelineRetryOpts) RecommendActions() error {
+	log.Infoln("Recommended follow-up actions:")
+	log.Infof("- Run %s to follow the progress of the stage.\n",
+		color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s", o.pipelineName)))
+	return nil
+}
+
+func (o *pipelineRetryOpts) stageNames() ([]string, error) {
+	pipeline, err := o.pipelineSvc.GetPipeline(o.pipelineName)
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", o.pipelineName, err)
+	}
+	var names []string
+	for _, stage := range pipeline.Stages {
+		names = append(names, stage.Name)
+	}
+	return names, nil
+}
+
+// buildPipelineRetryCmd builds the command for retrying a failed stage of a pipeline.
+func buildPipelineRetryCmd() *cobra.Command {
+	vars := pipelineRetryVars{}
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retries the failed actions of a pipeline stage.",
+		Long: `Retries the failed actions of a stage in the latest execution of a pipeline.
+The source revision of the execution is kept.`,
+
+		Example: `
+  Retries the failed actions of the "DeployTo-test" stage.
+  /code $ copilot pipeline retry -n pipeline-myapp-myrepo --stage DeployTo-test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineRetryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stageName, stageFlag, "", pipelineStageFlagDescription)
+	return cmd
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineRetryMocks struct {
+	pipelineSvc *mocks.MockpipelineGetter
+	prompt      *mocks.Mockprompter
+}
+
+func TestPipelineRetry_Validate(t *testing.T) {
+	mockPipeline := &codepipeline.Pipeline{
+		Name: "pipeline-phonetool-repo",
+		Stages: []*codepipeline.Stage{
+			{Name: "Source"},
+			{Name: "DeployTo-test"},
+		},
+	}
+	testCases := map[string]struct {
+		inPipeline string
+		inStage    string
+		setupMocks func(m pipelineRetryMocks)
+
+		wantedError error
+	}{
+		"skips validating the stage without a pipeline": {
+			inStage:    "DeployTo-test",
+			setupMocks: func(m pipelineRetryMocks) {},
+		},
+		"errors if fails to get the pipeline": {
+			inPipeline: "pipeline-phonetool-repo",
+			inStage:    "DeployTo-test",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.pipelineSvc.EXPECT().GetPipeline("pipeline-phonetool-repo").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get pipeline pipeline-phonetool-repo: some error"),
+		},
+		"errors if the stage does not exist": {
+			inPipeline: "pipeline-phonetool-repo",
+			inStage:    "DeployTo-prod",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.pipelineSvc.EXPECT().GetPipeline("pipeline-phonetool-repo").Return(mockPipeline, nil)
+			},
+			wantedError: errors.New("stage DeployTo-prod does not exist in pipeline pipeline-phonetool-repo"),
+		},
+		"success": {
+			inPipeline: "pipeline-phonetool-repo",
+			inStage:    "DeployTo-test",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.pipelineSvc.EXPECT().GetPipeline("pipeline-phonetool-repo").Return(mockPipeline, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelineRetryMocks{
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					pipelineName: tc.inPipeline,
+					stageName:    tc.inStage,
+				},
+				pipelineSvc: m.pipelineSvc,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPipelineRetry_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m pipelineRetryMocks)
+
+		wantedStage string
+		wantedError error
+	}{
+		"errors if fails to select a stage": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.pipelineSvc.EXPECT().GetPipeline("pipeline-phonetool-repo").Return(&codepipeline.Pipeline{
+					Stages: []*codepipeline.Stage{{Name: "Source"}},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"Source"}, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select stage of pipeline pipeline-phonetool-repo: some error"),
+		},
+		"selects a stage of the pipeline": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.pipelineSvc.EXPECT().GetPipeline("pipeline-phonetool-repo").Return(&codepipeline.Pipeline{
+					Stages: []*codepipeline.Stage{{Name: "Source"}, {Name: "DeployTo-test"}},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), pipelineRetryStageHelpPrompt, []string{"Source", "DeployTo-test"}, gomock.Any()).Return("DeployTo-test", nil)
+			},
+			wantedStage: "DeployTo-test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelineRetryMocks{
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					appName:      "phonetool",
+					pipelineName: "pipeline-phonetool-repo",
+				},
+				pipelineSvc: m.pipelineSvc,
+				prompt:      m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStage, opts.stageName)
+		})
+	}
+}
+
+func TestPipelineRetry_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		retryErr    error
+		wantedError error
+	}{
+		"errors if fails to retry the stage": {
+			retryErr:    errors.New("some error"),
+			wantedError: errors.New("some error"),
+		},
+		"success": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			retrier := mocks.NewMockpipelineStageRetrier(ctrl)
+			retrier.EXPECT().RetryStageExecution("pipeline-phonetool-repo", "DeployTo-test").Return(tc.retryErr)
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					pipelineName: "pipeline-phonetool-repo",
+					stageName:    "DeployTo-test",
+				},
+				retrier: retrier,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
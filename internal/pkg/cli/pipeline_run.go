@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	pipelineRunAppNamePrompt          = "Which application's pipeline would you like to run?"
+	pipelineRunAppNameHelpPrompt      = "An application is a collection of related services."
+	fmtPipelineRunPipelineNamePrompt  = "Which pipeline of %s would you like to run?"
+	pipelineRunPipelineNameHelpPrompt = "A new execution of the pipeline releases the latest revision of its source."
+)
+
+type pipelineRunVars struct {
+	appName      string
+	pipelineName string
+}
+
+type pipelineRunOpts struct {
+	pipelineRunVars
+
+	ws          wsPipelineReader
+	store       store
+	pipelineSvc pipelineExecutionStarter
+	sel         deployedPipelineSelector
+
+	// Streams the progress of the execution until it ends.
+	watchExecution func(pipelineName, executionID string) error
+}
+
+func newPipelineRunOpts(vars pipelineRunVars) (*pipelineRunOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	cp := codepipeline.New(sess)
+	return &pipelineRunOpts{
+		pipelineRunVars: vars,
+		ws:              ws,
+		store:           store,
+		pipelineSvc:     cp,
+		sel:             selector.NewPipelineSelect(prompt.New(), store, cp),
+		watchExecution: func(pipelineName, executionID string) error {
+			streamer := stream.NewPipelineExecutionStreamer(cp, pipelineName, executionID)
+			renderer := termprogress.ListeningPipelineExecutionRenderer(streamer, termprogress.RenderOptions{})
+			g, ctx := errgroup.WithContext(context.Background())
+			g.Go(func() error {
+				return stream.Stream(ctx, streamer)
+			})
+			g.Go(func() error {
+				// The renderer is done once the streamer is closed, so that the final state of the execution is rendered.
+				return termprogress.Render(context.Background(), termprogress.NewTabbedFileWriter(os.Stderr), renderer)
+			})
+			return g.Wait()
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *pipelineRunOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *pipelineRunOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.pipelineName != "" {
+		return nil
+	}
+	name, err := askDeployedPipelineName(o.ws, o.sel, o.appName,
+		fmt.Sprintf(fmtPipelineRunPipelineNamePrompt, color.HighlightUserInput(o.appName)), pipelineRunPipelineNameHelpPrompt)
+	if err != nil {
+		return err
+	}
+	o.pipelineName = name
+	return nil
+}
+
+// Execute starts a new execution of the pipeline and streams its progress until it ends.
+func (o *pipelineRunOpts) Execute() error {
+	executionID, err := o.pipelineSvc.StartPipelineExecution(o.pipelineName)
+	if err != nil {
+		return err
+	}
+	log.Infof("Started execution %s of pipeline %s.\n", color.HighlightResource(executionID), color.HighlightUserInput(o.pipelineName))
+	if err := o.watchExecution(o.pipelineName, executionID); err != nil {
+		var errFailed *stream.ErrPipelineExecutionFailed
+		if errors.As(err, &errFailed) {
+			return err
+		}
+		return fmt.Errorf("watch execution %s of pipeline %s: %w", executionID, o.pipelineName, err)
+	}
+	log.Successf("Execution %s of pipeline %s succeeded.\n", color.HighlightResource(executionID), color.HighlightUserInput(o.pipelineName))
+	return nil
+}
+
+// RecommendActions logs follow-up commands to inspect the pipeline.
+func (o *pipelineRunOpts) RecommendActions() error {
+	log.Infoln("Recommended follow-up actions:")
+	log.Infof("- Run %s to see the previous executions of the pipeline.\n",
+		color.HighlightCode(fmt.Sprintf("copilot pipeline history -n %s", o.pipelineName)))
+	return nil
+}
+
+// askDeployedPipelineName returns the name of the pipeline in the workspace's manifest if there is one.
+// Otherwise, it has the user select one of the deployed pipelines of the application.
+func askDeployedPipelineName(ws wsPipelineReader, sel deployedPipelineSelector, app, msg, help string) (string, error) {
+	data, err := ws.ReadPipelineManifest()
+	if err == nil {
+		pipeline, err := manifest.UnmarshalPipeline(data)
+		if err != nil {
+			return "", fmt.Errorf("unmarshal pipeline manifest: %w", err)
+		}
+		return pipeline.Name, nil
+	}
+	if errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
+		log.Infof("No pipeline manifest in workspace for application %s, looking for deployed pipelines.\n", color.HighlightUserInput(app))
+	}
+	return sel.DeployedPipeline(msg, help, app)
+}
+
+// buildPipelineRunCmd builds the command for starting a new execution of a pipeline.
+func buildPipelineRunCmd() *cobra.Command {
+	vars := pipelineRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Starts a new execution of a pipeline.",
+		Long: `Starts a new execution of a pipeline with the latest revision of its source.
+The status of each action is shown until the execution ends.`,
+
+		Example: `
+  Runs the pipeline "pipeline-myapp-myrepo".
+  /code $ copilot pipeline run -n pipeline-myapp-myrepo`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockRunPipelineManifest = `
+name: pipeline-phonetool-repo
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: badgoose/repo
+    branch: main
+
+stages:
+  - name: test
+`
+
+type pipelineRunMocks struct {
+	ws  *mocks.MockwsPipelineReader
+	sel *mocks.MockdeployedPipelineSelector
+}
+
+func TestPipelineRun_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inPipeline string
+		setupMocks func(m pipelineRunMocks)
+
+		wantedApp      string
+		wantedPipeline string
+		wantedError    error
+	}{
+		"errors if fails to select application": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.sel.EXPECT().Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"skips prompting if the pipeline name is passed in": {
+			inApp:      "phonetool",
+			inPipeline: "pipeline-phonetool-repo",
+			setupMocks: func(m pipelineRunMocks) {},
+
+			wantedApp:      "phonetool",
+			wantedPipeline: "pipeline-phonetool-repo",
+		},
+		"reads the pipeline name from the workspace manifest": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("phonetool", nil)
+				m.ws.EXPECT().ReadPipelineManifest().Return([]byte(mockRunPipelineManifest), nil)
+			},
+
+			wantedApp:      "phonetool",
+			wantedPipeline: "pipeline-phonetool-repo",
+		},
+		"selects a deployed pipeline if there is no manifest in the workspace": {
+			inApp: "phonetool",
+			setupMocks: func(m pipelineRunMocks) {
+				m.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), pipelineRunPipelineNameHelpPrompt, "phonetool").Return("pipeline-phonetool-other", nil)
+			},
+
+			wantedApp:      "phonetool",
+			wantedPipeline: "pipeline-phonetool-other",
+		},
+		"errors if fails to select a deployed pipeline": {
+			inApp: "phonetool",
+			setupMocks: func(m pipelineRunMocks) {
+				m.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelineRunMocks{
+				ws:  mocks.NewMockwsPipelineReader(ctrl),
+				sel: mocks.NewMockdeployedPipelineSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRunOpts{
+				pipelineRunVars: pipelineRunVars{
+					appName:      tc.inApp,
+					pipelineName: tc.inPipeline,
+				},
+				ws:  m.ws,
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedPipeline, opts.pipelineName)
+		})
+	}
+}
+
+func TestPipelineRun_Execute(t *testing.T) {
+	errFailed := &stream.ErrPipelineExecutionFailed{
+		Pipeline:    "pipeline-phonetool-repo",
+		ExecutionID: "1234",
+		Status:      "Failed",
+	}
+	testCases := map[string]struct {
+		mockStarter func(m *mocks.MockpipelineExecutionStarter)
+		watchErr    error
+
+		wantedError error
+	}{
+		"errors if fails to start an execution": {
+			mockStarter: func(m *mocks.MockpipelineExecutionStarter) {
+				m.EXPECT().StartPipelineExecution("pipeline-phonetool-repo").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"wraps errors while watching the execution": {
+			mockStarter: func(m *mocks.MockpipelineExecutionStarter) {
+				m.EXPECT().StartPipelineExecution("pipeline-phonetool-repo").Return("1234", nil)
+			},
+			watchErr:    errors.New("some error"),
+			wantedError: errors.New("watch execution 1234 of pipeline pipeline-phonetool-repo: some error"),
+		},
+		"returns the failed execution as is": {
+			mockStarter: func(m *mocks.MockpipelineExecutionStarter) {
+				m.EXPECT().StartPipelineExecution("pipeline-phonetool-repo").Return("1234", nil)
+			},
+			watchErr:    errFailed,
+			wantedError: errFailed,
+		},
+		"success": {
+			mockStarter: func(m *mocks.MockpipelineExecutionStarter) {
+				m.EXPECT().StartPipelineExecution("pipeline-phonetool-repo").Return("1234", nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			starter := mocks.NewMockpipelineExecutionStarter(ctrl)
+			tc.mockStarter(starter)
+			var watched string
+			opts := &pipelineRunOpts{
+				pipelineRunVars: pipelineRunVars{
+					pipelineName: "pipeline-phonetool-repo",
+				},
+				pipelineSvc: starter,
+				watchExecution: func(pipelineName, executionID string) error {
+					watched = executionID
+					return tc.watchErr
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "1234", watched)
+		})
+	}
+}
@@ -181,27 +181,91 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 	}
 
 	for _, stage := range manifestStages {
-		env, err := o.envStore.GetEnvironment(o.appName, stage.Name)
-		if err != nil {
-			return nil, fmt.Errorf("get environment %s in application %s: %w", stage.Name, o.appName, err)
-		}
-
-		pipelineStage := deploy.PipelineStage{
-			LocalWorkloads: workloads,
-			AssociatedEnvironment: &deploy.AssociatedEnvironment{
-				Name:      stage.Name,
+		var envs []*deploy.AssociatedEnvironment
+		for _, name := range stage.EnvironmentNames() {
+			env, err := o.envStore.GetEnvironment(o.appName, name)
+			if err != nil {
+				return nil, fmt.Errorf("get environment %s in application %s: %w", name, o.appName, err)
+			}
+			envs = append(envs, &deploy.AssociatedEnvironment{
+				Name:      name,
 				Region:    env.Region,
 				AccountID: env.AccountID,
-			},
-			RequiresApproval: stage.RequiresApproval,
-			TestCommands:     stage.TestCommands,
+			})
 		}
-		stages = append(stages, pipelineStage)
+		test, err := o.convertPipelineTest(stage.Test, envs)
+		if err != nil {
+			return nil, fmt.Errorf("convert test of stage %s: %w", stage.Name, err)
+		}
+		stages = append(stages, deploy.PipelineStage{
+			Name:             stage.Name,
+			Environments:     envs,
+			LocalWorkloads:   workloads,
+			RequiresApproval: stage.ApprovalRequired(),
+			Approval:         convertPipelineApproval(stage.Approval),
+			PreDeployments:   convertPipelineActions(stage.PreDeployments),
+			PostDeployments:  convertPipelineActions(stage.PostDeployments),
+			TestCommands:     stage.TestCommands,
+			Test:             test,
+		})
 	}
 
 	return stages, nil
 }
 
+func convertPipelineApproval(approval *manifest.PipelineApproval) *deploy.PipelineApproval {
+	if approval == nil {
+		return nil
+	}
+	converted := &deploy.PipelineApproval{
+		TopicARN: approval.Topic,
+	}
+	if approval.Timeout != nil {
+		converted.TimeoutInMinutes = int(approval.Timeout.Minutes())
+	}
+	return converted
+}
+
+// convertPipelineTest returns the tests of a stage, placed in the VPC of one of the stage's environments if requested.
+// The pipeline reads the network of the environment from the exports of its stack, so the environment must be
+// in the same account and region as the pipeline.
+func (o *updatePipelineOpts) convertPipelineTest(test *manifest.PipelineTest, envs []*deploy.AssociatedEnvironment) (*deploy.PipelineTest, error) {
+	if test == nil {
+		return nil, nil
+	}
+	converted := &deploy.PipelineTest{
+		Image:     test.Image,
+		Buildspec: test.Buildspec,
+		Variables: test.Variables,
+		Secrets:   test.Secrets,
+	}
+	if test.Network == nil {
+		return converted, nil
+	}
+	for _, env := range envs {
+		if env.Name != test.Network.Environment {
+			continue
+		}
+		if env.Region != o.region || env.AccountID != o.app.AccountID {
+			return nil, fmt.Errorf("environment %s must be in the same account and region as the pipeline to run tests in its network", env.Name)
+		}
+		converted.Environment = env
+		return converted, nil
+	}
+	return nil, fmt.Errorf("environment %s is not deployed by the stage", test.Network.Environment)
+}
+
+func convertPipelineActions(actions []manifest.PipelineAction) []deploy.PipelineAction {
+	var converted []deploy.PipelineAction
+	for _, action := range actions {
+		converted = append(converted, deploy.PipelineAction{
+			Name:     action.Name,
+			Commands: action.Commands,
+		})
+	}
+	return converted
+}
+
 func (o *updatePipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, error) {
 	regionalResources, err := o.pipelineDeployer.GetRegionalAppResources(o.app)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -27,6 +28,7 @@ type updatePipelineMocks struct {
 }
 
 func TestUpdatePipelineOpts_convertStages(t *testing.T) {
+	approvalTimeout := 2 * time.Hour
 	testCases := map[string]struct {
 		stages    []manifest.PipelineStage
 		inAppName string
@@ -59,10 +61,13 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 
 			expectedStages: []deploy.PipelineStage{
 				{
-					AssociatedEnvironment: &deploy.AssociatedEnvironment{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789012",
+					Name: "test",
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      "test",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
+						},
 					},
 					LocalWorkloads:   []string{"frontend", "backend"},
 					RequiresApproval: false,
@@ -94,10 +99,13 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 
 			expectedStages: []deploy.PipelineStage{
 				{
-					AssociatedEnvironment: &deploy.AssociatedEnvironment{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789012",
+					Name: "test",
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      "test",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
+						},
 					},
 					LocalWorkloads:   []string{"frontend", "backend"},
 					RequiresApproval: false,
@@ -130,10 +138,13 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 
 			expectedStages: []deploy.PipelineStage{
 				{
-					AssociatedEnvironment: &deploy.AssociatedEnvironment{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789012",
+					Name: "test",
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      "test",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
+						},
 					},
 					LocalWorkloads:   []string{"frontend", "backend"},
 					RequiresApproval: true,
@@ -142,6 +153,170 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"converts stages with parallel environments and pre and post deployments": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:           "prod",
+					Environments:   []string{"prod-us", "prod-eu"},
+					PreDeployments: []manifest.PipelineAction{{Name: "migrate", Commands: []string{"make migrate"}}},
+					PostDeployments: []manifest.PipelineAction{
+						{Name: "smoke", Commands: []string{"make smoke"}},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "prod-us").Return(&config.Environment{
+						Name:      "prod-us",
+						Region:    "us-east-1",
+						AccountID: "123456789012",
+					}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "prod-eu").Return(&config.Environment{
+						Name:      "prod-eu",
+						Region:    "eu-west-1",
+						AccountID: "210987654321",
+					}, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					Name: "prod",
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      "prod-us",
+							Region:    "us-east-1",
+							AccountID: "123456789012",
+						},
+						{
+							Name:      "prod-eu",
+							Region:    "eu-west-1",
+							AccountID: "210987654321",
+						},
+					},
+					LocalWorkloads:  []string{"frontend"},
+					PreDeployments:  []deploy.PipelineAction{{Name: "migrate", Commands: []string{"make migrate"}}},
+					PostDeployments: []deploy.PipelineAction{{Name: "smoke", Commands: []string{"make smoke"}}},
+				},
+			},
+		},
+		"returns an error if an environment of a stage cannot be retrieved": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:         "prod",
+					Environments: []string{"prod-us", "prod-eu"},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "prod-us").Return(nil, errors.New("some error")).Times(1),
+				)
+			},
+
+			expectedError: errors.New("get environment prod-us in application badgoose: some error"),
+		},
+		"converts stages with tests in the network of an environment and approval notifications": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					Test: &manifest.PipelineTest{
+						Buildspec: "copilot/tests/buildspec.yml",
+						Variables: map[string]string{"LOG_LEVEL": "debug"},
+						Network:   &manifest.PipelineTestNetwork{Environment: "test"},
+					},
+				},
+				{
+					Name: "prod",
+					Approval: &manifest.PipelineApproval{
+						Topic:   "arn:aws:sns:us-west-2:123456789012:releases",
+						Timeout: &approvalTimeout,
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "prod").Return(&config.Environment{
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "210987654321",
+					}, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					Name: "test",
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      "test",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
+						},
+					},
+					LocalWorkloads: []string{"frontend"},
+					Test: &deploy.PipelineTest{
+						Buildspec: "copilot/tests/buildspec.yml",
+						Variables: map[string]string{"LOG_LEVEL": "debug"},
+						Environment: &deploy.AssociatedEnvironment{
+							Name:      "test",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
+						},
+					},
+				},
+				{
+					Name: "prod",
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      "prod",
+							Region:    "us-east-1",
+							AccountID: "210987654321",
+						},
+					},
+					LocalWorkloads:   []string{"frontend"},
+					RequiresApproval: true,
+					Approval: &deploy.PipelineApproval{
+						TopicARN:         "arn:aws:sns:us-west-2:123456789012:releases",
+						TimeoutInMinutes: 120,
+					},
+				},
+			},
+		},
+		"returns an error if the tests run in the network of an environment in another region": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:         "prod",
+					TestCommands: []string{"make test"},
+					Test: &manifest.PipelineTest{
+						Network: &manifest.PipelineTestNetwork{Environment: "prod"},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "prod").Return(&config.Environment{
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "123456789012",
+					}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("convert test of stage prod: environment prod must be in the same account and region as the pipeline to run tests in its network"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -163,6 +338,11 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 				updatePipelineVars: updatePipelineVars{
 					appName: tc.inAppName,
 				},
+				app: &config.Application{
+					Name:      tc.inAppName,
+					AccountID: "123456789012",
+				},
+				region:   "us-west-2",
 				envStore: mockEnvStore,
 				ws:       mockWorkspace,
 			}
@@ -172,7 +352,7 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 				require.ElementsMatch(t, tc.expectedStages, actualStages)
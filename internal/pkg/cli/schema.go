@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cmdtemplate "github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	schemaTypePrompt     = "Which type of manifest would you like the JSON Schema of?"
+	schemaTypeHelpPrompt = `The JSON Schema can be used by your editor to validate and auto-complete the manifest.`
+)
+
+var schemaTypeFlagDescription = fmt.Sprintf(`Type of the workload manifest. Must be one of:
+%s.`, strings.Join(template.QuoteSliceFunc(manifest.WorkloadTypes), ", "))
+
+const schemaOutputDirFlagDescription = `Optional. Writes the JSON Schemas to a directory instead of printing them.
+Writes the schema of every manifest type unless --type is specified.`
+
+type schemaVars struct {
+	wkldType  string
+	outputDir string
+}
+
+type schemaOpts struct {
+	schemaVars
+
+	fs     afero.Fs
+	w      io.Writer
+	prompt prompter
+}
+
+func newSchemaOpts(vars schemaVars) *schemaOpts {
+	return &schemaOpts{
+		schemaVars: vars,
+		fs:         &afero.Afero{Fs: afero.NewOsFs()},
+		w:          os.Stdout,
+		prompt:     prompt.New(),
+	}
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *schemaOpts) Validate() error {
+	if o.wkldType == "" {
+		return nil
+	}
+	if !contains(o.wkldType, manifest.WorkloadTypes) {
+		return fmt.Errorf("invalid type %s: must be one of %s", o.wkldType, strings.Join(template.QuoteSliceFunc(manifest.WorkloadTypes), ", "))
+	}
+	return nil
+}
+
+// Ask prompts the user for the manifest type if the schema is printed.
+func (o *schemaOpts) Ask() error {
+	if o.wkldType != "" || o.outputDir != "" {
+		return nil
+	}
+	t, err := o.prompt.SelectOne(schemaTypePrompt, schemaTypeHelpPrompt, manifest.WorkloadTypes, prompt.WithFinalMessage("Manifest type:"))
+	if err != nil {
+		return fmt.Errorf("select manifest type: %w", err)
+	}
+	o.wkldType = t
+	return nil
+}
+
+// Execute prints the JSON Schema of the manifest type, or writes the schemas to the output directory.
+func (o *schemaOpts) Execute() error {
+	if o.outputDir == "" {
+		content, err := marshalSchema(o.wkldType)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, "%s\n", content)
+		return nil
+	}
+
+	types := manifest.WorkloadTypes
+	if o.wkldType != "" {
+		types = []string{o.wkldType}
+	}
+	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+	}
+	for _, t := range types {
+		content, err := marshalSchema(t)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(o.outputDir, schemaFileName(t))
+		if err := afero.WriteFile(o.fs, path, append(content, '\n'), 0644); err != nil {
+			return fmt.Errorf("write JSON Schema to %s: %w", path, err)
+		}
+		log.Successf("Wrote the JSON Schema of the %s manifest at %s\n", t, path)
+	}
+	return nil
+}
+
+func marshalSchema(wkldType string) ([]byte, error) {
+	s, err := manifest.WorkloadJSONSchema(wkldType)
+	if err != nil {
+		return nil, fmt.Errorf("generate JSON Schema for %s: %w", wkldType, err)
+	}
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON Schema for %s: %w", wkldType, err)
+	}
+	return content, nil
+}
+
+// schemaFileName returns the name of the file holding the JSON Schema of a manifest type.
+// For example, "Load Balanced Web Service" is written to "load-balanced-web-service.schema.json".
+func schemaFileName(wkldType string) string {
+	return fmt.Sprintf("%s.schema.json", strings.ReplaceAll(strings.ToLower(wkldType), " ", "-"))
+}
+
+// BuildSchemaCmd builds the command for printing the JSON Schema of manifests.
+func BuildSchemaCmd() *cobra.Command {
+	vars := schemaVars{}
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Prints the JSON Schema of a manifest.",
+		Long: `Prints the JSON Schema of a workload manifest.
+The schema can be used by editors to validate and auto-complete manifests.`,
+		Example: `
+  Print the JSON Schema of the manifest of a "Load Balanced Web Service".
+  /code $ copilot schema --type "Load Balanced Web Service"
+
+  Write the JSON Schema of every manifest type to a "schemas/" directory.
+  /code $ copilot schema --output-dir ./schemas
+  /code $ ls ./schemas
+  /code backend-service.schema.json  load-balanced-web-service.schema.json  ...`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newSchemaOpts(vars))
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.wkldType, typeFlag, typeFlagShort, "", schemaTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", schemaOutputDirFlagDescription)
+	cmd.SetUsageTemplate(cmdtemplate.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
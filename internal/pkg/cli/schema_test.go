@@ -0,0 +1,176 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inType string
+
+		wantedErr string
+	}{
+		"no type": {},
+		"valid type": {
+			inType: manifest.WorkerServiceType,
+		},
+		"invalid type": {
+			inType:    "Static Site",
+			wantedErr: `invalid type Static Site: must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service", "Scheduled Job"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &schemaOpts{
+				schemaVars: schemaVars{
+					wkldType: tc.inType,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSchemaOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inType      string
+		inOutputDir string
+		setupMocks  func(m *mocks.Mockprompter)
+
+		wantedType string
+		wantedErr  string
+	}{
+		"does not prompt if the type is provided": {
+			inType: manifest.BackendServiceType,
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedType: manifest.BackendServiceType,
+		},
+		"does not prompt if the schemas are written to a directory": {
+			inOutputDir: "schemas",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"prompts for the type": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(schemaTypePrompt, gomock.Any(), manifest.WorkloadTypes, gomock.Any()).Return(manifest.ScheduledJobType, nil)
+			},
+			wantedType: manifest.ScheduledJobType,
+		},
+		"wraps the prompt error": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "select manifest type: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(m)
+			opts := &schemaOpts{
+				schemaVars: schemaVars{
+					wkldType:  tc.inType,
+					outputDir: tc.inOutputDir,
+				},
+				prompt: m,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedType, opts.wkldType)
+		})
+	}
+}
+
+func TestSchemaOpts_Execute(t *testing.T) {
+	t.Run("prints the schema of the manifest type", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		opts := &schemaOpts{
+			schemaVars: schemaVars{
+				wkldType: manifest.WorkerServiceType,
+			},
+			w: buf,
+		}
+
+		err := opts.Execute()
+
+		require.NoError(t, err)
+		var s manifest.JSONSchema
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &s))
+		require.Equal(t, "Copilot Worker Service manifest", s.Title)
+		require.Contains(t, s.Properties, "subscribe")
+	})
+
+	t.Run("writes the schema of every manifest type to the output directory", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		opts := &schemaOpts{
+			schemaVars: schemaVars{
+				outputDir: "schemas",
+			},
+			fs: fs,
+		}
+
+		err := opts.Execute()
+
+		require.NoError(t, err)
+		for _, file := range []string{
+			"request-driven-web-service.schema.json",
+			"load-balanced-web-service.schema.json",
+			"backend-service.schema.json",
+			"worker-service.schema.json",
+			"scheduled-job.schema.json",
+		} {
+			ok, err := afero.Exists(fs, "schemas/"+file)
+			require.NoError(t, err)
+			require.True(t, ok, "file %s should exist", file)
+		}
+	})
+
+	t.Run("writes only the schema of the manifest type to the output directory", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		opts := &schemaOpts{
+			schemaVars: schemaVars{
+				wkldType:  manifest.ScheduledJobType,
+				outputDir: "schemas",
+			},
+			fs: fs,
+		}
+
+		err := opts.Execute()
+
+		require.NoError(t, err)
+		files, err := afero.ReadDir(fs, "schemas")
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		require.Equal(t, "scheduled-job.schema.json", files[0].Name())
+	})
+}
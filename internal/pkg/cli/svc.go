@@ -30,10 +30,14 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
+	cmd.AddCommand(buildSvcHistoryCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcRunCmd())
+	cmd.AddCommand(buildSvcValidateCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -4,8 +4,10 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -25,6 +27,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
@@ -36,12 +39,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -49,6 +54,20 @@ const (
 	fmtForceUpdateSvcStart    = "Forcing an update for service %s from environment %s"
 	fmtForceUpdateSvcFailed   = "Failed to force an update for service %s from environment %s: %v.\n"
 	fmtForceUpdateSvcComplete = "Forced an update for service %s from environment %s.\n"
+
+	fmtDeployDiffConfirmPrompt = "Deploy the changes to %s in environment %s?"
+
+	fmtImageScanStart    = "Scanning the image of %s for vulnerabilities"
+	fmtImageScanFailed   = "Failed to scan the image of %s: %v.\n"
+	fmtImageScanComplete = "Scanned the image of %s: %s.\n"
+
+	fmtImageSignStart    = "Signing the image of %s with signing profile %s"
+	fmtImageSignFailed   = "Failed to sign the image of %s: %v.\n"
+	fmtImageSignComplete = "Signed the image of %s with signing profile %s.\n"
+
+	fmtDryRunEnvUpgradeSkipped = "Environment %s is on version %s. Deploying without --%s upgrades it to version %s first, which is not previewed.\n"
+	fmtDryRunImageSkipped      = "The image of %s is not built or pushed in a dry run, the changes are previewed with the image tagged %s.\n"
+	fmtDryRunAddonsSkipped     = "The addons template of %s is not uploaded in a dry run, the changes are previewed with the deployed addons.\n"
 )
 
 type deployWkldVars struct {
@@ -58,6 +77,8 @@ type deployWkldVars struct {
 	imageTag       string
 	resourceTags   map[string]string
 	forceNewUpdate bool
+	showDiff       bool
+	dryRun         bool
 }
 
 type uploadCustomResourcesOpts struct {
@@ -71,19 +92,29 @@ type deploySvcOpts struct {
 	store               store
 	ws                  wsSvcDirReader
 	imageBuilderPusher  imageBuilderPusher
+	registryLogin       registryLoginer
+	imageScanner        imageScanner
+	imageSigner         imageSigner
+	dockerEngine        repository.ContainerLoginBuildPusher
 	unmarshal           func([]byte) (manifest.WorkloadManifest, error)
 	s3                  artifactUploader
 	cmd                 runner
 	addons              templater
 	appCFN              appResourcesGetter
 	svcCFN              serviceDeployer
+	trafficWeights      trafficWeightsGetter
+	stoppedTasks        stoppedTasksGetter
 	newSvcUpdater       func(func(*session.Session) serviceUpdater)
 	sessProvider        sessionProvider
 	envUpgradeCmd       actionCommand
+	envVersionGetter    versionGetter
 	newAppVersionGetter func(string) (versionGetter, error)
 	endpointGetter      endpointGetter
 	snsTopicGetter      deployedEnvironmentLister
 	identity            identityService
+	revisionStore       serviceRevisionStore
+	taskDefRevisions    taskDefRevisionGetter
+	marshalRevision     func(cloudformation.StackConfiguration) ([]byte, error)
 
 	spinner progress
 	sel     wsSelector
@@ -96,6 +127,7 @@ type deploySvcOpts struct {
 	appliedManifest   interface{}
 	imageDigest       string
 	buildRequired     bool
+	addonsSkipped     bool
 	appEnvResources   *stack.AppRegionalResources
 	rdSvcAlias        string
 	svcUpdater        serviceUpdater
@@ -135,9 +167,11 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 			}
 			return d, nil
 		},
-		cmd:            exec.NewCmd(),
-		sessProvider:   sessions.NewProvider(),
-		snsTopicGetter: deployStore,
+		cmd:             exec.NewCmd(),
+		dockerEngine:    dockerengine.New(exec.NewCmd()),
+		sessProvider:    sessions.NewProvider(),
+		snsTopicGetter:  deployStore,
+		marshalRevision: marshalServiceRevision,
 	}
 	opts.uploadOpts = newUploadCustomResourcesOpts(opts)
 	return opts, err
@@ -148,6 +182,9 @@ func (o *deploySvcOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.dryRun && o.forceNewUpdate {
+		return fmt.Errorf("cannot specify both --%s and --%s", dryRunFlag, forceFlag)
+	}
 	if o.name != "" {
 		if err := o.validateSvcName(); err != nil {
 			return err
@@ -174,6 +211,34 @@ func (o *deploySvcOpts) Ask() error {
 
 // Execute builds and pushes the container image for the service,
 func (o *deploySvcOpts) Execute() error {
+	if err := o.prepare(); err != nil {
+		return err
+	}
+	if err := o.configureContainerImage(); err != nil {
+		return err
+	}
+	if err := o.scanImage(); err != nil {
+		return err
+	}
+	if err := o.signImage(); err != nil {
+		return err
+	}
+	if err := o.deployWorkload(os.Stderr); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmptyCS) {
+			log.Warningf("Set --%s to force an update for the service.\n", forceFlag)
+		}
+		return err
+	}
+	if o.dryRun {
+		return nil
+	}
+	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// prepare retrieves the configuration of the app, environment and service, and upgrades the environment if needed.
+func (o *deploySvcOpts) prepare() error {
 	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
@@ -195,29 +260,71 @@ func (o *deploySvcOpts) Execute() error {
 	if err := o.configureClients(); err != nil {
 		return err
 	}
+	return o.upgradeEnv()
+}
 
+// upgradeEnv upgrades the environment to the latest version if needed.
+// A dry run leaves the environment untouched and only warns if it would be upgraded.
+func (o *deploySvcOpts) upgradeEnv() error {
+	if o.dryRun {
+		return warnEnvUpgradeSkipped(o.envVersionGetter, o.envName)
+	}
 	if err := o.envUpgradeCmd.Execute(); err != nil {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
+	return nil
+}
 
-	if err := o.configureContainerImage(); err != nil {
-		return err
+// warnEnvUpgradeSkipped warns if the environment is not on the latest version, since a dry run doesn't upgrade it.
+func warnEnvUpgradeSkipped(getter versionGetter, envName string) error {
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s: %w", envName, err)
 	}
+	if semver.Compare(version, deploy.LatestEnvTemplateVersion) < 0 {
+		log.Warningf(fmtDryRunEnvUpgradeSkipped, color.HighlightUserInput(envName), version, dryRunFlag, deploy.LatestEnvTemplateVersion)
+	}
+	return nil
+}
 
-	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
+// loginToRegistry logs in to the registry that the image of the service is pushed to if it's built,
+// unless the registry is in loggedIn.
+func (o *deploySvcOpts) loginToRegistry(loggedIn map[string]bool) error {
+	svc, err := o.manifest()
 	if err != nil {
 		return err
 	}
+	required, err := manifest.ServiceDockerfileBuildRequired(svc)
+	if err != nil || !required {
+		return err
+	}
+	return loginToRegistry(o.registryLogin, loggedIn)
+}
 
-	if err := o.deploySvc(addonsURL); err != nil {
+// buildImage builds and pushes the container image, and writes the output of docker to out.
+func (o *deploySvcOpts) buildImage(out io.Writer) error {
+	o.dockerEngine = dockerengine.NewWithOutput(exec.NewCmd(), out)
+	return o.configureContainerImage()
+}
+
+// deployWorkload uploads the addons of the service and deploys the service stack, the progress of the stack is written to out.
+func (o *deploySvcOpts) deployWorkload(out termprogress.FileWriter) error {
+	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
+	if err != nil {
 		return err
 	}
-	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
-	return nil
+	return o.deploySvc(addonsURL, out)
 }
 
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
+	if o.dryRun {
+		logRecommendedActions([]string{
+			fmt.Sprintf("Run %s to deploy the changes.",
+				color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s --env %s", o.name, o.envName))),
+		})
+		return nil
+	}
 	var recommendations []string
 	uriRecs, err := o.uriRecommendedActions()
 	if err != nil {
@@ -298,21 +405,31 @@ func (o *deploySvcOpts) configureClients() error {
 	// ECR client against tools account profile AND target environment region.
 	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
 	registry := ecr.New(defaultSessEnvRegion)
-	o.imageBuilderPusher, err = repository.New(repoName, registry)
+	repo, err := repository.New(repoName, registry)
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.imageBuilderPusher = repo
+	o.registryLogin = repo
+	o.imageScanner = registry
+	o.imageSigner = exec.NewNotationCommand()
 
-	o.s3 = s3.New(defaultSessEnvRegion)
+	s3Client := s3.New(defaultSessEnvRegion)
+	o.s3 = s3Client
+	o.revisionStore = s3Client
 
 	o.newSvcUpdater = func(f func(*session.Session) serviceUpdater) {
 		o.svcUpdater = f(envSession)
 	}
 
 	// CF client against env account profile AND target environment region.
-	o.svcCFN = cloudformation.New(envSession)
+	svcCFN := cloudformation.New(envSession)
+	o.svcCFN = svcCFN
+	o.trafficWeights = svcCFN
+	o.stoppedTasks = awsecs.New(envSession)
+	o.taskDefRevisions = ecs.New(envSession)
 
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -320,6 +437,8 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate env describer: %w", err)
 	}
+	o.endpointGetter = envDescriber
+	o.envVersionGetter = envDescriber
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
 		return fmt.Errorf("initiate addons service: %w", err)
@@ -360,13 +479,18 @@ func (o *deploySvcOpts) configureContainerImage() error {
 	if !required {
 		return nil
 	}
+	if o.dryRun {
+		logDryRunImageSkipped(o.name, o.imageTag)
+		o.buildRequired = true
+		return nil
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArg, err := o.dfBuildArgs(svc)
 	if err != nil {
 		return err
 	}
 
-	digest, err := o.imageBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	digest, err := o.imageBuilderPusher.BuildAndPush(o.dockerEngine, buildArg)
 	if err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
@@ -375,6 +499,92 @@ func (o *deploySvcOpts) configureContainerImage() error {
 	return nil
 }
 
+// logDryRunImageSkipped notes that the changes of a dry run are previewed with the tag of the image instead of its digest.
+func logDryRunImageSkipped(wkld, tag string) {
+	if tag == "" {
+		tag = "latest"
+	}
+	log.Infof(fmtDryRunImageSkipped, color.HighlightUserInput(wkld), tag)
+}
+
+// scanImage scans the image pushed to the ECR repository if the manifest has an "image.scan" section.
+func (o *deploySvcOpts) scanImage() error {
+	if !o.buildRequired || o.dryRun {
+		return nil
+	}
+	mft, ok := o.appliedManifest.(imageScanConfigurer)
+	if !ok {
+		return nil
+	}
+	return scanWorkloadImage(o.imageScanner, o.spinner, mft.ImageScan(), o.appName, o.name, o.imageDigest)
+}
+
+type imageScanConfigurer interface {
+	ImageScan() *manifest.ImageScan
+}
+
+// scanWorkloadImage scans the image of the workload with the given digest if conf is not nil.
+// It returns an error if the scan finds vulnerabilities at or above the "image.scan.fail_on" severity
+// that are not in the "image.scan.allow" list.
+func scanWorkloadImage(scanner imageScanner, spinner progress, conf *manifest.ImageScan, app, wkld, digest string) error {
+	if conf == nil {
+		return nil
+	}
+	spinner.Start(fmt.Sprintf(fmtImageScanStart, color.HighlightUserInput(wkld)))
+	findings, err := scanner.ScanImage(fmt.Sprintf("%s/%s", app, wkld), digest)
+	if err != nil {
+		spinner.Stop(log.Serrorf(fmtImageScanFailed, color.HighlightUserInput(wkld), err))
+		return fmt.Errorf("scan image of %s: %w", wkld, err)
+	}
+	spinner.Stop(log.Ssuccessf(fmtImageScanComplete, color.HighlightUserInput(wkld), findings.Summary()))
+	severity := conf.FailOnSeverity()
+	if severity == "" {
+		return nil
+	}
+	vulnerabilities := findings.AtOrAbove(severity, conf.Allow)
+	if len(vulnerabilities) == 0 {
+		return nil
+	}
+	for _, v := range vulnerabilities {
+		log.Errorf("%s (%s) %s\n", v.Name, strings.ToLower(v.Severity), v.URI)
+	}
+	return fmt.Errorf("image of %s has %s at or above severity %s that %s not allowed by %q",
+		wkld, english.Plural(len(vulnerabilities), "vulnerability", "vulnerabilities"), severity,
+		english.PluralWord(len(vulnerabilities), "is", "are"), "image.scan.allow")
+}
+
+// signImage signs the image pushed to the ECR repository if the manifest has an "image.sign" section.
+func (o *deploySvcOpts) signImage() error {
+	if !o.buildRequired || o.dryRun {
+		return nil
+	}
+	mft, ok := o.appliedManifest.(imageSigningConfigurer)
+	if !ok {
+		return nil
+	}
+	return signWorkloadImage(o.imageSigner, o.spinner, mft.ImageSigning(), o.registryLogin, o.name, o.imageDigest)
+}
+
+type imageSigningConfigurer interface {
+	ImageSigning() *manifest.ImageSigning
+}
+
+// signWorkloadImage signs the image of the workload with the given digest in the repository if conf is not nil.
+// The signature is pushed to the repository, so it's only created once the image passed its scan.
+func signWorkloadImage(signer imageSigner, spinner progress, conf *manifest.ImageSigning, repo repositoryURIGetter, wkld, digest string) error {
+	profile := conf.ProfileARN()
+	if profile == "" {
+		return nil
+	}
+	spinner.Start(fmt.Sprintf(fmtImageSignStart, color.HighlightUserInput(wkld), color.HighlightResource(profile)))
+	if err := signer.Sign(fmt.Sprintf("%s@%s", repo.URI(), digest), profile); err != nil {
+		spinner.Stop(log.Serrorf(fmtImageSignFailed, color.HighlightUserInput(wkld), err))
+		return fmt.Errorf("sign image of %s: %w", wkld, err)
+	}
+	spinner.Stop(log.Ssuccessf(fmtImageSignComplete, color.HighlightUserInput(wkld), color.HighlightResource(profile)))
+	return nil
+}
+
 func (o *deploySvcOpts) dfBuildArgs(svc interface{}) (*dockerengine.BuildArguments, error) {
 	copilotDir, err := o.ws.CopilotDirPath()
 	if err != nil {
@@ -401,6 +611,10 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 	if err != nil {
 		return nil, fmt.Errorf("get platform for service: %w", err)
 	}
+	var platforms []string
+	if mp, ok := unmarshaledManifest.(multiPlatformImager); ok {
+		platforms = mp.ImagePlatforms()
+	}
 	return &dockerengine.BuildArguments{
 		Dockerfile: *args.Dockerfile,
 		Context:    *args.Context,
@@ -408,10 +622,19 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 		CacheFrom:  args.CacheFrom,
 		Target:     aws.StringValue(args.Target),
 		Platform:   aws.StringValue(platform),
+		Platforms:  platforms,
 		Tags:       tags,
+		CacheTo:    args.CacheTo,
+
+		CacheFromPrevious: aws.BoolValue(args.CacheFromPrevious),
 	}, nil
 }
 
+// multiPlatformImager is implemented by the manifests of workloads whose image can be built for several platforms.
+type multiPlatformImager interface {
+	ImagePlatforms() []string
+}
+
 // pushAddonsTemplateToS3Bucket generates the addons template for the service and pushes it to S3.
 // If the service doesn't have any addons, it returns the empty string and no errors.
 // If the service has addons, it returns the URL of the S3 object storing the addons template.
@@ -425,6 +648,11 @@ func (o *deploySvcOpts) pushAddonsTemplateToS3Bucket() (string, error) {
 		}
 		return "", fmt.Errorf("retrieve addons template: %w", err)
 	}
+	if o.dryRun {
+		log.Infof(fmtDryRunAddonsSkipped, color.HighlightUserInput(o.name))
+		o.addonsSkipped = true
+		return "", nil
+	}
 
 	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
 		return "", err
@@ -526,12 +754,22 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get identity: %w", err)
+	}
+	rc.DeployedBy = caller.ARN
 	o.newSvcUpdater(func(s *session.Session) serviceUpdater {
 		return ecs.New(s)
 	})
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
+		if t.Deployment.ShiftsTraffic() {
+			if rc.TrafficWeights, err = o.trafficWeights.TrafficWeights(stack.NameForService(o.appName, o.envName, o.name)); err != nil {
+				return nil, fmt.Errorf("get traffic weights of service %s: %w", o.name, err)
+			}
+		}
 		if o.targetApp.RequiresDNSDelegation() {
 			var appVersionGetter versionGetter
 			if appVersionGetter, err = o.newAppVersionGetter(o.appName); err != nil {
@@ -541,6 +779,11 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 				return nil, err
 			}
 			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+		} else if o.targetEnvironment.HasImportedCerts() {
+			if err = validateLBSvcAliasWithImportedCerts(aws.StringValue(t.Name), t.Alias, o.envName); err != nil {
+				return nil, err
+			}
+			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		} else {
 			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		}
@@ -548,11 +791,6 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 		o.newSvcUpdater(func(s *session.Session) serviceUpdater {
 			return apprunner.New(s)
 		})
-		var caller identity.Caller
-		caller, err = o.identity.Get()
-		if err != nil {
-			return nil, fmt.Errorf("get identity: %w", err)
-		}
 		appInfo := deploy.AppInformation{
 			Name:                o.targetEnvironment.App,
 			DNSName:             o.targetApp.Domain,
@@ -620,25 +858,183 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	return conf, nil
 }
 
-func (o *deploySvcOpts) deploySvc(addonsURL string) error {
+func (o *deploySvcOpts) deploySvc(addonsURL string, out termprogress.FileWriter) error {
 	conf, err := o.stackConfiguration(addonsURL)
 	if err != nil {
 		return err
 	}
 
-	if err := o.svcCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	if o.showDiff || o.dryRun {
+		err = deployStackDiff(deployStackDiffInput{
+			deployer: o.svcCFN,
+			prompt:   o.prompt,
+			conf:     conf,
+			roleARN:  o.targetEnvironment.ExecutionRoleARN,
+			wkldName: o.name,
+			envName:  o.envName,
+			dryRun:   o.dryRun,
+
+			keepDeployedAddons: o.addonsSkipped,
+		})
+	} else {
+		err = o.svcCFN.DeployService(out, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	}
+	if err != nil {
+		var errRolledBack *stream.ErrECSDeploymentRolledBack
+		if errors.As(err, &errRolledBack) {
+			o.logStoppedTasks(errRolledBack)
+		}
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if errors.As(err, &errEmptyCS) {
+			if o.dryRun {
+				return nil
+			}
 			if o.forceNewUpdate {
 				return o.forceDeploy()
 			}
-			log.Warningf("Set --%s to force an update for the service.\n", forceFlag)
 		}
 		return fmt.Errorf("deploy service: %w", err)
 	}
+	if o.dryRun {
+		return nil
+	}
+	o.recordRevision(conf)
+	return nil
+}
+
+// recordRevision stores the configuration that an ECS service is deployed with, so that it can be rolled back to.
+// Failing to record it doesn't fail the deployment.
+func (o *deploySvcOpts) recordRevision(conf cloudformation.StackConfiguration) {
+	if _, ok := conf.(*stack.RequestDrivenWebService); ok {
+		return
+	}
+	err := o.retrieveAppResourcesForEnvRegion()
+	if err == nil {
+		err = recordServiceRevision(recordServiceRevisionInput{
+			taskDefRevisions: o.taskDefRevisions,
+			store:            o.revisionStore,
+			marshal:          o.marshalRevision,
+			bucket:           o.appEnvResources.S3Bucket,
+			conf:             conf,
+			app:              o.appName,
+			env:              o.envName,
+			svc:              o.name,
+		})
+	}
+	if err != nil {
+		log.Warningf("Failed to record the deployment of service %s, %s will not be able to roll back to it: %v\n",
+			o.name, color.HighlightCode("svc rollback"), err)
+	}
+}
+
+type recordServiceRevisionInput struct {
+	taskDefRevisions taskDefRevisionGetter
+	store            serviceRevisionStore
+	marshal          func(cloudformation.StackConfiguration) ([]byte, error)
+	bucket           string
+	conf             cloudformation.StackConfiguration
+	app              string
+	env              string
+	svc              string
+}
+
+// recordServiceRevision stores the template, parameters and tags that a service is deployed with
+// under the task definition revision that the service runs.
+func recordServiceRevision(in recordServiceRevisionInput) error {
+	revision, err := in.taskDefRevisions.ServiceTaskDefinitionRevision(in.app, in.env, in.svc)
+	if err != nil {
+		return fmt.Errorf("get task definition revision of service %s: %w", in.svc, err)
+	}
+	data, err := in.marshal(in.conf)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf(deploy.ServiceRevisionKeyFormat, in.env, in.svc, revision)
+	if _, err := in.store.PutObject(in.bucket, key, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("put configuration of revision %d to bucket %s: %w", revision, in.bucket, err)
+	}
 	return nil
 }
 
+// marshalServiceRevision serializes the configuration that a service is deployed with to record it as a revision.
+func marshalServiceRevision(conf cloudformation.StackConfiguration) ([]byte, error) {
+	return stack.MarshalWorkloadRevision(conf)
+}
+
+// logStoppedTasks summarizes why the tasks of a deployment that ECS rolled back stopped.
+func (o *deploySvcOpts) logStoppedTasks(rollback *stream.ErrECSDeploymentRolledBack) {
+	tasks, err := o.stoppedTasks.StoppedServiceTasks(rollback.Cluster, rollback.Service)
+	if err != nil {
+		log.Warningf("Failed to retrieve the stopped tasks of service %s: %v\n", o.name, err)
+		return
+	}
+	var stopped describe.ECSStoppedTasks
+	for _, task := range tasks {
+		if aws.StringValue(task.TaskDefinitionArn) != rollback.TaskDefinition {
+			continue
+		}
+		status, err := task.TaskStatus()
+		if err != nil {
+			continue
+		}
+		stopped = append(stopped, *status)
+	}
+	if len(stopped) == 0 {
+		return
+	}
+	log.Errorf("The deployment of service %s was rolled back. Its tasks stopped for the following reasons:\n\n", color.HighlightUserInput(o.name))
+	log.Infoln(stopped.HumanString())
+}
+
+type deployStackDiffInput struct {
+	deployer serviceDeployer
+	prompt   prompter
+	conf     cloudformation.StackConfiguration
+	roleARN  string
+	wkldName string
+	envName  string
+	dryRun   bool
+
+	keepDeployedAddons bool // The addons template isn't uploaded, so the stack keeps the one it's deployed with.
+}
+
+// deployStackDiff previews the infrastructure changes to a workload stack and deploys them once confirmed.
+// If it's a dry run, the changes are discarded after they're printed.
+func deployStackDiff(in deployStackDiffInput) error {
+	opts := []awscloudformation.StackOption{awscloudformation.WithRoleARN(in.roleARN)}
+	if in.keepDeployedAddons {
+		opts = append(opts, awscloudformation.WithPreviousParameterValue(stack.WorkloadAddonsTemplateURLParamKey))
+	}
+	diffService := in.deployer.DiffService
+	if in.dryRun {
+		// The changes are discarded, the preview must not modify the deployed stack.
+		diffService = in.deployer.PreviewService
+	}
+	diff, err := diffService(os.Stderr, in.conf, opts...)
+	if err != nil {
+		return err
+	}
+	log.Infoln(diff.HumanString())
+	if in.dryRun {
+		if err := in.deployer.DiscardStackDiff(diff); err != nil {
+			return fmt.Errorf("discard changes to stack %s: %w", diff.StackName, err)
+		}
+		return nil
+	}
+	confirmed, err := in.prompt.Confirm(fmt.Sprintf(fmtDeployDiffConfirmPrompt, color.HighlightUserInput(in.wkldName), color.HighlightUserInput(in.envName)), "")
+	if err != nil {
+		_ = in.deployer.DiscardStackDiff(diff)
+		return fmt.Errorf("confirm deployment: %w", err)
+	}
+	if !confirmed {
+		if err := in.deployer.DiscardStackDiff(diff); err != nil {
+			return fmt.Errorf("discard changes to stack %s: %w", diff.StackName, err)
+		}
+		return errors.New("deployment cancelled - no changes made")
+	}
+	return in.deployer.ExecuteStackDiff(os.Stderr, diff)
+}
+
 func (o *deploySvcOpts) forceDeploy() error {
 	// Force update the service if --force is set and change set is empty.
 	o.spinner.Start(fmt.Sprintf(fmtForceUpdateSvcStart, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)))
@@ -657,6 +1053,21 @@ func (o *deploySvcOpts) forceDeploy() error {
 	return nil
 }
 
+// validateLBSvcAliasWithImportedCerts returns an error if the service has no alias to be served with the
+// certificates imported in the environment.
+func validateLBSvcAliasWithImportedCerts(svcName string, aliases *manifest.Alias, envName string) error {
+	if aliases == nil {
+		log.Errorf(`Environment %s serves HTTPS traffic with imported certificates.
+Please specify "http.alias" in the manifest of service %s with a domain name covered by the certificates.
+`, envName, svcName)
+		return fmt.Errorf(`alias is required for service %s to be deployed in environment %s`, svcName, envName)
+	}
+	if _, err := aliases.ToStringSlice(); err != nil {
+		return fmt.Errorf(`convert 'http.alias' to string slice: %w`, err)
+	}
+	return nil
+}
+
 func validateLBSvcAliasAndAppVersion(svcName string, aliases *manifest.Alias, app *config.Application, envName string, appVersionGetter versionGetter) error {
 	if aliases == nil {
 		return nil
@@ -835,6 +1246,9 @@ func (o *deploySvcOpts) uriRecommendedActions() ([]string, error) {
 	network := "over the internet."
 	if o.targetSvc.Type == manifest.BackendServiceType {
 		network = "with service discovery."
+		if svc, ok := o.appliedManifest.(*manifest.BackendService); ok && svc.HTTPEnabled() {
+			network = "from within your environment through its internal load balancer."
+		}
 	}
 	recs := []string{
 		fmt.Sprintf("You can access your service at %s %s", color.HighlightResource(uri), network),
@@ -926,7 +1340,9 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Previews the infrastructure changes to a service without deploying them.
+  /code $ copilot svc deploy --name frontend --env test --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -941,6 +1357,8 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, dryRunFlagDescription)
 
 	return cmd
 }
@@ -6,6 +6,9 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 
@@ -22,8 +26,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -39,6 +45,11 @@ type deploySvcMocks struct {
 	mockServiceDeployer    *mocks.MockserviceDeployer
 	mockSpinner            *mocks.Mockprogress
 	mockServiceUpdater     *mocks.MockserviceUpdater
+	mockPrompt             *mocks.Mockprompter
+	mockStoppedTasks       *mocks.MockstoppedTasksGetter
+	mockIdentity           *mocks.MockidentityService
+	mockRevisionStore      *mocks.MockserviceRevisionStore
+	mockTaskDefRevisions   *mocks.MocktaskDefRevisionGetter
 }
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
@@ -46,6 +57,8 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 		inAppName string
 		inEnvName string
 		inSvcName string
+		inDryRun  bool
+		inForce   bool
 
 		mockWs    func(m *mocks.MockwsSvcDirReader)
 		mockStore func(m *mocks.Mockstore)
@@ -89,6 +102,15 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
+		"with both dry run and force": {
+			inAppName: "phonetool",
+			inDryRun:  true,
+			inForce:   true,
+			mockWs:    func(m *mocks.MockwsSvcDirReader) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("cannot specify both --dry-run and --force"),
+		},
 		"successful validation": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -115,9 +137,11 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
+					appName:        tc.inAppName,
+					name:           tc.inSvcName,
+					envName:        tc.inEnvName,
+					dryRun:         tc.inDryRun,
+					forceNewUpdate: tc.inForce,
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -212,6 +236,115 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 	}
 }
 
+func TestSvcDeployOpts_upgradeEnv(t *testing.T) {
+	testCases := map[string]struct {
+		inDryRun bool
+		mock     func(cmd *mocks.MockactionCommand, versions *mocks.MockversionGetter)
+
+		wantedErr error
+	}{
+		"upgrades the environment": {
+			mock: func(cmd *mocks.MockactionCommand, versions *mocks.MockversionGetter) {
+				cmd.EXPECT().Execute().Return(nil)
+			},
+		},
+		"errors if failed to upgrade the environment": {
+			mock: func(cmd *mocks.MockactionCommand, versions *mocks.MockversionGetter) {
+				cmd.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New(`execute "env upgrade --app phonetool --name test": some error`),
+		},
+		"does not upgrade the environment in a dry run": {
+			inDryRun: true,
+			mock: func(cmd *mocks.MockactionCommand, versions *mocks.MockversionGetter) {
+				versions.EXPECT().Version().Return("v0.0.1", nil)
+				cmd.EXPECT().Execute().Times(0)
+			},
+		},
+		"errors if failed to get the version of the environment in a dry run": {
+			inDryRun: true,
+			mock: func(cmd *mocks.MockactionCommand, versions *mocks.MockversionGetter) {
+				versions.EXPECT().Version().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get template version of environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cmd := mocks.NewMockactionCommand(ctrl)
+			versions := mocks.NewMockversionGetter(ctrl)
+			tc.mock(cmd, versions)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					envName: "test",
+					dryRun:  tc.inDryRun,
+				},
+				targetEnvironment: &config.Environment{Name: "test"},
+				envUpgradeCmd:     cmd,
+				envVersionGetter:  versions,
+			}
+
+			// WHEN
+			err := opts.upgradeEnv()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_dryRun(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ws := mocks.NewMockwsSvcDirReader(ctrl)
+	ws.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  scan:
+    fail_on: high`), nil)
+	builder := mocks.NewMockimageBuilderPusher(ctrl)
+	builder.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+	scanner := mocks.NewMockimageScanner(ctrl)
+	scanner.EXPECT().ScanImage(gomock.Any(), gomock.Any()).Times(0)
+	addons := mocks.NewMocktemplater(ctrl)
+	addons.EXPECT().Template().Return("some data", nil)
+	uploader := mocks.NewMockartifactUploader(ctrl)
+	uploader.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	opts := deploySvcOpts{
+		deployWkldVars: deployWkldVars{
+			name:   "api",
+			dryRun: true,
+		},
+		ws:                 ws,
+		unmarshal:          manifest.UnmarshalWorkload,
+		imageBuilderPusher: builder,
+		imageScanner:       scanner,
+		addons:             addons,
+		s3:                 uploader,
+	}
+
+	// WHEN
+	require.NoError(t, opts.configureContainerImage())
+	require.NoError(t, opts.scanImage())
+	addonsURL, err := opts.pushAddonsTemplateToS3Bucket()
+
+	// THEN
+	require.NoError(t, err)
+	require.Empty(t, addonsURL)
+	require.True(t, opts.buildRequired, "expected the changes to be previewed with the image of the ECR repository")
+	require.True(t, opts.addonsSkipped, "expected the deployed addons template to be kept")
+}
+
 func TestSvcDeployOpts_configureContainerImage(t *testing.T) {
 	mockError := errors.New("mockError")
 	mockManifest := []byte(`name: serviceA
@@ -232,6 +365,14 @@ image:
 	mockManifestWithGoodPlatform := []byte(`name: serviceA
 type: 'Load Balanced Web Service'
 platform: linux/amd64
+image:
+  build:
+    dockerfile: path/to/Dockerfile
+    context: path
+`)
+	mockManifestWithPlatformList := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+platform: [linux/arm64, linux/amd64]
 image:
   build:
     dockerfile: path/to/Dockerfile
@@ -286,7 +427,7 @@ image:
 					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifestWithBadPlatform, nil),
 				)
 			},
-			wantErr: fmt.Errorf("unmarshal service serviceA manifest: unmarshal to load balanced web service: validate platform: platform %s is invalid; the valid platform is: %s", "linus/abc123", "linux/amd64"),
+			wantErr: fmt.Errorf("unmarshal service serviceA manifest: unmarshal to load balanced web service: validate platform: platform %s is invalid; valid platforms are: %s", "linus/abc123", "linux/amd64 and linux/arm64"),
 		},
 		"success with valid platform": {
 			inputSvc: "serviceA",
@@ -303,6 +444,22 @@ image:
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
 		},
+		"success with a list of platforms": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifestWithPlatformList, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+						Platform:   "linux/arm64",
+						Platforms:  []string{"linux/arm64", "linux/amd64"},
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 		"success without building and pushing": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -401,6 +558,288 @@ image:
 	}
 }
 
+func TestSvcDeployOpts_loginToRegistry(t *testing.T) {
+	const mockRegistry = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+	testCases := map[string]struct {
+		location   *string
+		loggedIn   map[string]bool
+		setupMocks func(m *mocks.MockregistryLoginer)
+
+		wantedLoggedIn map[string]bool
+		wantedError    error
+	}{
+		"does not log in if the image is not built": {
+			location:       aws.String("nginx"),
+			loggedIn:       map[string]bool{},
+			setupMocks:     func(m *mocks.MockregistryLoginer) {},
+			wantedLoggedIn: map[string]bool{},
+		},
+		"does not log in again to a registry": {
+			loggedIn: map[string]bool{mockRegistry: true},
+			setupMocks: func(m *mocks.MockregistryLoginer) {
+				m.EXPECT().URI().Return(mockRegistry + "/phonetool/api")
+				m.EXPECT().SkipLogin()
+			},
+			wantedLoggedIn: map[string]bool{mockRegistry: true},
+		},
+		"errors if failed to log in": {
+			loggedIn: map[string]bool{},
+			setupMocks: func(m *mocks.MockregistryLoginer) {
+				m.EXPECT().URI().Return(mockRegistry + "/phonetool/api")
+				m.EXPECT().Login(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"logs in to the registry": {
+			loggedIn: map[string]bool{},
+			setupMocks: func(m *mocks.MockregistryLoginer) {
+				m.EXPECT().URI().Return(mockRegistry + "/phonetool/api")
+				m.EXPECT().Login(gomock.Any()).Return(nil)
+			},
+			wantedLoggedIn: map[string]bool{mockRegistry: true},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLoginer := mocks.NewMockregistryLoginer(ctrl)
+			tc.setupMocks(mockLoginer)
+
+			mft := &manifest.BackendService{}
+			mft.ImageConfig.Image.Location = tc.location
+			if tc.location == nil {
+				mft.ImageConfig.Image.Build.BuildString = aws.String("./Dockerfile")
+			}
+			opts := deploySvcOpts{
+				registryLogin:   mockLoginer,
+				appliedManifest: mft,
+			}
+
+			// WHEN
+			err := opts.loginToRegistry(tc.loggedIn)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedLoggedIn, tc.loggedIn)
+			}
+		})
+	}
+}
+
+func TestSvcDeployOpts_scanImage(t *testing.T) {
+	const mockDigest = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	mockFindings := &ecr.ImageScanFindings{
+		Digest: mockDigest,
+		SeverityCounts: map[string]int{
+			"CRITICAL": 1,
+			"MEDIUM":   1,
+		},
+		Findings: []ecr.ImageScanFinding{
+			{Name: "CVE-2021-3711", Severity: "CRITICAL"},
+			{Name: "CVE-2020-1971", Severity: "MEDIUM"},
+		},
+	}
+	severity := func(s string) *manifest.ImageScanSeverity {
+		v := manifest.ImageScanSeverity(s)
+		return &v
+	}
+	testCases := map[string]struct {
+		buildRequired bool
+		scan          *manifest.ImageScan
+		setupMocks    func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress)
+
+		wantedError error
+	}{
+		"does not scan images that are not built": {
+			scan:       &manifest.ImageScan{},
+			setupMocks: func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress) {},
+		},
+		"does not scan the image without an image.scan section": {
+			buildRequired: true,
+			setupMocks:    func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress) {},
+		},
+		"errors if failed to scan the image": {
+			buildRequired: true,
+			scan:          &manifest.ImageScan{},
+			setupMocks: func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().ScanImage("phonetool/api", mockDigest).Return(nil, errors.New("some error"))
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("scan image of api: some error"),
+		},
+		"only reports the findings without a fail_on severity": {
+			buildRequired: true,
+			scan:          &manifest.ImageScan{},
+			setupMocks: func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().ScanImage("phonetool/api", mockDigest).Return(mockFindings, nil)
+				spinner.EXPECT().Stop(log.Ssuccessf(fmtImageScanComplete, color.HighlightUserInput("api"), "1 critical, 1 medium"))
+			},
+		},
+		"errors if the image has vulnerabilities at or above the fail_on severity": {
+			buildRequired: true,
+			scan: &manifest.ImageScan{
+				FailOn: severity(manifest.ImageScanSeverityMedium),
+			},
+			setupMocks: func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().ScanImage("phonetool/api", mockDigest).Return(mockFindings, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New(`image of api has 2 vulnerabilities at or above severity medium that are not allowed by "image.scan.allow"`),
+		},
+		"succeeds if the vulnerabilities are allowed": {
+			buildRequired: true,
+			scan: &manifest.ImageScan{
+				FailOn: severity(manifest.ImageScanSeverityHigh),
+				Allow:  []string{"CVE-2021-3711"},
+			},
+			setupMocks: func(m *mocks.MockimageScanner, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().ScanImage("phonetool/api", mockDigest).Return(mockFindings, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockScanner := mocks.NewMockimageScanner(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockScanner, mockSpinner)
+
+			mft := &manifest.BackendService{}
+			mft.ImageConfig.Scan = tc.scan
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					name:    "api",
+				},
+				imageScanner:    mockScanner,
+				spinner:         mockSpinner,
+				appliedManifest: mft,
+				imageDigest:     mockDigest,
+				buildRequired:   tc.buildRequired,
+			}
+
+			// WHEN
+			err := opts.scanImage()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcDeployOpts_signImage(t *testing.T) {
+	const (
+		mockDigest  = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+		mockRepoURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+		mockProfile = "arn:aws:signer:us-west-2:123456789012:/signing-profiles/phonetool"
+	)
+	testCases := map[string]struct {
+		buildRequired bool
+		dryRun        bool
+		sign          *manifest.ImageSigning
+		setupMocks    func(m *mocks.MockimageSigner, repo *mocks.MockregistryLoginer, spinner *mocks.Mockprogress)
+
+		wantedError error
+	}{
+		"does not sign images that are not built": {
+			sign: &manifest.ImageSigning{
+				Profile: aws.String(mockProfile),
+			},
+			setupMocks: func(m *mocks.MockimageSigner, repo *mocks.MockregistryLoginer, spinner *mocks.Mockprogress) {},
+		},
+		"does not sign the image in a dry run": {
+			buildRequired: true,
+			dryRun:        true,
+			sign: &manifest.ImageSigning{
+				Profile: aws.String(mockProfile),
+			},
+			setupMocks: func(m *mocks.MockimageSigner, repo *mocks.MockregistryLoginer, spinner *mocks.Mockprogress) {},
+		},
+		"does not sign the image without an image.sign section": {
+			buildRequired: true,
+			setupMocks:    func(m *mocks.MockimageSigner, repo *mocks.MockregistryLoginer, spinner *mocks.Mockprogress) {},
+		},
+		"errors if failed to sign the image": {
+			buildRequired: true,
+			sign: &manifest.ImageSigning{
+				Profile: aws.String(mockProfile),
+			},
+			setupMocks: func(m *mocks.MockimageSigner, repo *mocks.MockregistryLoginer, spinner *mocks.Mockprogress) {
+				repo.EXPECT().URI().Return(mockRepoURI)
+				spinner.EXPECT().Start(gomock.Any())
+				m.EXPECT().Sign(mockRepoURI+"@"+mockDigest, mockProfile).Return(errors.New("some error"))
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("sign image of api: some error"),
+		},
+		"signs the pushed image by digest": {
+			buildRequired: true,
+			sign: &manifest.ImageSigning{
+				Profile: aws.String(mockProfile),
+			},
+			setupMocks: func(m *mocks.MockimageSigner, repo *mocks.MockregistryLoginer, spinner *mocks.Mockprogress) {
+				repo.EXPECT().URI().Return(mockRepoURI)
+				spinner.EXPECT().Start(fmt.Sprintf(fmtImageSignStart, color.HighlightUserInput("api"), color.HighlightResource(mockProfile)))
+				m.EXPECT().Sign(mockRepoURI+"@"+mockDigest, mockProfile).Return(nil)
+				spinner.EXPECT().Stop(log.Ssuccessf(fmtImageSignComplete, color.HighlightUserInput("api"), color.HighlightResource(mockProfile)))
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSigner := mocks.NewMockimageSigner(ctrl)
+			mockRepo := mocks.NewMockregistryLoginer(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockSigner, mockRepo, mockSpinner)
+
+			mft := &manifest.BackendService{}
+			mft.ImageConfig.Sign = tc.sign
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					name:    "api",
+					dryRun:  tc.dryRun,
+				},
+				imageSigner:     mockSigner,
+				registryLogin:   mockRepo,
+				spinner:         mockSpinner,
+				appliedManifest: mft,
+				imageDigest:     mockDigest,
+				buildRequired:   tc.buildRequired,
+			}
+
+			// WHEN
+			err := opts.signImage()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 	mockError := errors.New("some error")
 	tests := map[string]struct {
@@ -561,13 +1000,21 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 		mockEnvName   = "mockEnv"
 		mockSvcName   = "mockSvc"
 		mockAddonsURL = "mockAddonsURL"
+		mockRevision  = `{"stackName":"mockApp-mockEnv-mockSvc"}`
 	)
+	mockStackDiff := &deploycfn.StackDiff{
+		StackName:   "mockApp-mockEnv-mockSvc",
+		ChangeSetID: "mockChangeSetID",
+	}
 	tests := map[string]struct {
 		inAliases      *manifest.Alias
 		inApp          *config.Application
 		inEnvironment  *config.Environment
 		inBuildRequire bool
 		inForceDeploy  bool
+		inShowDiff     bool
+		inDryRun       bool
+		inSkipAddons   bool
 
 		mock func(m *deploySvcMocks)
 
@@ -671,6 +1118,46 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
+		"fail to enable https without an alias for the imported certificates": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: fmt.Errorf("alias is required for service %s to be deployed in environment %s", mockSvcName, mockEnvName),
+		},
+		"deploy with https for the imported certificates": {
+			inAliases: &manifest.Alias{String: aws.String("example.com")},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.mockAppResourcesGetter.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				m.mockTaskDefRevisions.EXPECT().ServiceTaskDefinitionRevision(mockAppName, mockEnvName, mockSvcName).Return(4, nil)
+				m.mockRevisionStore.EXPECT().PutObject("mockBucket", "revisions/mockEnv/mockSvc/4.json", gomock.Any()).Return("", nil)
+			},
+		},
 		"error if fail to deploy service": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -687,6 +1174,34 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("deploy service: some error"),
 		},
+		"error with the stopped tasks if the deployment is rolled back": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(&stream.ErrECSDeploymentRolledBack{
+					Cluster:        "mockCluster",
+					Service:        "mockService",
+					TaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/mockApp-mockEnv-mockSvc:2",
+					Reason:         "ECS deployment circuit breaker: tasks failed to start.",
+				})
+				m.mockStoppedTasks.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:1111:task/mockCluster/1234567890"),
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/mockApp-mockEnv-mockSvc:2"),
+						StoppedReason:     aws.String("Essential container in task exited"),
+					},
+				}, nil)
+			},
+			wantErr: fmt.Errorf("deploy service: deployment of service mockService failed and is rolling back to the previous revision: ECS deployment circuit breaker: tasks failed to start."),
+		},
 		"error if change set is empty but force flag is not set": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -769,6 +1284,150 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.mockAppResourcesGetter.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				m.mockTaskDefRevisions.EXPECT().ServiceTaskDefinitionRevision(mockAppName, mockEnvName, mockSvcName).Return(4, nil)
+				m.mockRevisionStore.EXPECT().PutObject("mockBucket", "revisions/mockEnv/mockSvc/4.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						b, err := ioutil.ReadAll(data)
+						require.NoError(t, err)
+						require.Equal(t, mockRevision, string(b))
+						return "", nil
+					})
+			},
+		},
+		"success if fail to record the revision": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.mockAppResourcesGetter.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				m.mockTaskDefRevisions.EXPECT().ServiceTaskDefinitionRevision(mockAppName, mockEnvName, mockSvcName).Return(4, nil)
+				m.mockRevisionStore.EXPECT().PutObject("mockBucket", "revisions/mockEnv/mockSvc/4.json", gomock.Any()).Return("", mockError)
+			},
+		},
+		"dry run discards the changes after printing them": {
+			inDryRun: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().PreviewService(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStackDiff, nil)
+				m.mockServiceDeployer.EXPECT().DiscardStackDiff(mockStackDiff).Return(nil)
+				m.mockServiceDeployer.EXPECT().ExecuteStackDiff(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"dry run keeps the deployed addons template if it is not uploaded": {
+			inDryRun:     true,
+			inSkipAddons: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				// The role ARN and the previous value of the addons template URL.
+				m.mockServiceDeployer.EXPECT().PreviewService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStackDiff, nil)
+				m.mockServiceDeployer.EXPECT().DiscardStackDiff(mockStackDiff).Return(nil)
+			},
+		},
+		"dry run succeeds if there are no changes": {
+			inDryRun: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().PreviewService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, cloudformation.NewMockErrChangeSetEmpty())
+			},
+		},
+		"error if fail to discard the changes of a dry run": {
+			inDryRun: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().PreviewService(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStackDiff, nil)
+				m.mockServiceDeployer.EXPECT().DiscardStackDiff(mockStackDiff).Return(mockError)
+			},
+			wantErr: fmt.Errorf("deploy service: discard changes to stack mockApp-mockEnv-mockSvc: some error"),
+		},
+		"error if the changes are not confirmed": {
+			inShowDiff: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DiffService(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStackDiff, nil)
+				m.mockPrompt.EXPECT().Confirm(fmt.Sprintf(fmtDeployDiffConfirmPrompt, mockSvcName, mockEnvName), "").Return(false, nil)
+				m.mockServiceDeployer.EXPECT().DiscardStackDiff(mockStackDiff).Return(nil)
+			},
+			wantErr: fmt.Errorf("deploy service: deployment cancelled - no changes made"),
+		},
+		"success with diff": {
+			inShowDiff: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DiffService(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStackDiff, nil)
+				m.mockPrompt.EXPECT().Confirm(fmt.Sprintf(fmtDeployDiffConfirmPrompt, mockSvcName, mockEnvName), "").Return(true, nil)
+				m.mockServiceDeployer.EXPECT().ExecuteStackDiff(gomock.Any(), mockStackDiff).Return(nil)
+				m.mockAppResourcesGetter.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+				m.mockTaskDefRevisions.EXPECT().ServiceTaskDefinitionRevision(mockAppName, mockEnvName, mockSvcName).Return(4, nil)
+				m.mockRevisionStore.EXPECT().PutObject("mockBucket", "revisions/mockEnv/mockSvc/4.json", gomock.Any()).Return("", nil)
 			},
 		},
 		"success with force update": {
@@ -805,7 +1464,15 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				mockServiceDeployer:    mocks.NewMockserviceDeployer(ctrl),
 				mockServiceUpdater:     mocks.NewMockserviceUpdater(ctrl),
 				mockSpinner:            mocks.NewMockprogress(ctrl),
+				mockPrompt:             mocks.NewMockprompter(ctrl),
+				mockStoppedTasks:       mocks.NewMockstoppedTasksGetter(ctrl),
+				mockIdentity:           mocks.NewMockidentityService(ctrl),
+				mockRevisionStore:      mocks.NewMockserviceRevisionStore(ctrl),
+				mockTaskDefRevisions:   mocks.NewMocktaskDefRevisionGetter(ctrl),
 			}
+			m.mockIdentity.EXPECT().Get().Return(identity.Caller{
+				ARN: "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+			}, nil).AnyTimes()
 			tc.mock(m)
 
 			opts := deploySvcOpts{
@@ -814,9 +1481,12 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 					appName:        mockAppName,
 					envName:        mockEnvName,
 					forceNewUpdate: tc.inForceDeploy,
+					showDiff:       tc.inShowDiff,
+					dryRun:         tc.inDryRun,
 				},
 				ws:            m.mockWs,
 				buildRequired: tc.inBuildRequire,
+				addonsSkipped: tc.inSkipAddons,
 				appCFN:        m.mockAppResourcesGetter,
 				newAppVersionGetter: func(s string) (versionGetter, error) {
 					return m.mockAppVersionGetter, nil
@@ -830,19 +1500,35 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 							Name: aws.String(mockSvcName),
 						},
 						LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+							TaskConfig: manifest.TaskConfig{
+								CPU:    aws.Int(256),
+								Memory: aws.Int(512),
+								Count: manifest.Count{
+									Value: aws.Int(1),
+								},
+							},
 							RoutingRule: manifest.RoutingRule{
 								Alias: tc.inAliases,
 							},
 						},
 					}, nil
 				},
-				svcCFN:        m.mockServiceDeployer,
-				svcUpdater:    m.mockServiceUpdater,
-				newSvcUpdater: func(f func(*session.Session) serviceUpdater) {},
-				spinner:       m.mockSpinner,
+				svcCFN:           m.mockServiceDeployer,
+				stoppedTasks:     m.mockStoppedTasks,
+				svcUpdater:       m.mockServiceUpdater,
+				newSvcUpdater:    func(f func(*session.Session) serviceUpdater) {},
+				spinner:          m.mockSpinner,
+				prompt:           m.mockPrompt,
+				identity:         m.mockIdentity,
+				revisionStore:    m.mockRevisionStore,
+				taskDefRevisions: m.mockTaskDefRevisions,
+				marshalRevision: func(conf deploycfn.StackConfiguration) ([]byte, error) {
+					require.IsType(t, &stack.LoadBalancedWebService{}, conf)
+					return []byte(mockRevision), nil
+				},
 			}
 
-			gotErr := opts.deploySvc(mockAddonsURL)
+			gotErr := opts.deploySvc(mockAddonsURL, os.Stderr)
 
 			if tc.wantErr != nil {
 				require.EqualError(t, gotErr, tc.wantErr.Error())
@@ -1151,6 +1837,7 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 		mockAppVersionGetter   func(m *mocks.MockversionGetter)
 		mockEndpointGetter     func(m *mocks.MockendpointGetter)
 		mockDeployStore        func(m *mocks.MockdeployedEnvironmentLister)
+		mockIdentity           func(m *mocks.MockidentityService)
 
 		wantErr error
 	}{
@@ -1162,8 +1849,32 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
 			mockEndpointGetter:     func(m *mocks.MockendpointGetter) {},
 			mockDeployStore:        func(m *mocks.MockdeployedEnvironmentLister) {},
+			mockIdentity:           func(m *mocks.MockidentityService) {},
 			wantErr:                fmt.Errorf("read service %s manifest file: %w", mockSvcName, mockError),
 		},
+		"fail to get identity": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+			mockIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{}, mockError)
+			},
+			wantErr: fmt.Errorf("get identity: %w", mockError),
+		},
 		"fail to get deployed topics": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -1184,6 +1895,9 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
 				m.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return(nil, mockError)
 			},
+			mockIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{}, nil)
+			},
 			wantErr: fmt.Errorf("get SNS topics for app mockApp and environment mockEnv: %w", mockError),
 		},
 		"success": {
@@ -1208,6 +1922,11 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 					*topic,
 				}, nil)
 			},
+			mockIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{
+					ARN: "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+				}, nil)
+			},
 		},
 	}
 
@@ -1221,11 +1940,13 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 			mockAppVersionGetter := mocks.NewMockversionGetter(ctrl)
 			mockEndpointGetter := mocks.NewMockendpointGetter(ctrl)
 			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockIdentity := mocks.NewMockidentityService(ctrl)
 			tc.mockWorkspace(mockWorkspace)
 			tc.mockAppResourcesGetter(mockAppResourcesGetter)
 			tc.mockAppVersionGetter(mockAppVersionGetter)
 			tc.mockEndpointGetter(mockEndpointGetter)
 			tc.mockDeployStore(mockDeployStore)
+			tc.mockIdentity(mockIdentity)
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
@@ -1242,6 +1963,7 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 				newSvcUpdater:     func(f func(*session.Session) serviceUpdater) {},
 				endpointGetter:    mockEndpointGetter,
 				snsTopicGetter:    mockDeployStore,
+				identity:          mockIdentity,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
 				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
@@ -1264,3 +1986,87 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcDeployOpts_stackConfiguration_trafficWeights(t *testing.T) {
+	mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		WorkloadProps: &manifest.WorkloadProps{
+			Name:  "frontend",
+			Image: "nginx",
+		},
+		Path: "/",
+		Port: 80,
+	})
+	mft.Deployment.Strategy = aws.String(manifest.BlueGreenDeploymentStrategy)
+	testCases := map[string]struct {
+		mockTrafficWeights func(m *mocks.MocktrafficWeightsGetter)
+
+		wantedParams map[string]string
+		wantedErr    error
+	}{
+		"errors if the traffic weights cannot be retrieved": {
+			mockTrafficWeights: func(m *mocks.MocktrafficWeightsGetter) {
+				m.EXPECT().TrafficWeights("phonetool-test-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get traffic weights of service frontend: some error"),
+		},
+		"keeps the traffic weights of the deployed service": {
+			mockTrafficWeights: func(m *mocks.MocktrafficWeightsGetter) {
+				m.EXPECT().TrafficWeights("phonetool-test-frontend").Return(&stack.TrafficWeights{
+					TargetGroup:          0,
+					AlternateTargetGroup: 100,
+				}, nil)
+			},
+			wantedParams: map[string]string{
+				stack.LBWebServiceTargetGroupWeightParamKey:          "0",
+				stack.LBWebServiceAlternateTargetGroupWeightParamKey: "100",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEndpointGetter := mocks.NewMockendpointGetter(ctrl)
+			mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil)
+			mockIdentity := mocks.NewMockidentityService(ctrl)
+			mockIdentity.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:sts::123456789012:assumed-role/Admin/alice"}, nil)
+			mockTrafficWeights := mocks.NewMocktrafficWeightsGetter(ctrl)
+			tc.mockTrafficWeights(mockTrafficWeights)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name:    "frontend",
+					appName: "phonetool",
+					envName: "test",
+				},
+				appliedManifest:   mft,
+				endpointGetter:    mockEndpointGetter,
+				identity:          mockIdentity,
+				trafficWeights:    mockTrafficWeights,
+				newSvcUpdater:     func(f func(*session.Session) serviceUpdater) {},
+				targetApp:         &config.Application{Name: "phonetool"},
+				targetEnvironment: &config.Environment{App: "phonetool", Name: "test", Region: "us-west-2"},
+			}
+
+			// WHEN
+			conf, err := opts.stackConfiguration("")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			params, err := conf.Parameters()
+			require.NoError(t, err)
+			for _, param := range params {
+				if wanted, ok := tc.wantedParams[aws.StringValue(param.ParameterKey)]; ok {
+					require.Equal(t, wanted, aws.StringValue(param.ParameterValue))
+					delete(tc.wantedParams, aws.StringValue(param.ParameterKey))
+				}
+			}
+			require.Empty(t, tc.wantedParams)
+		})
+	}
+}
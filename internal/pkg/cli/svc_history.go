@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcHistoryNamePrompt     = "Which service's deployment history would you like to show?"
+	svcHistoryNameHelpPrompt = "Displays the image, digest and principal of the service's previous deployments."
+)
+
+// ecsServiceTypes are the types of services that are deployed to ECS and keep a history of task definition revisions.
+var ecsServiceTypes = []string{
+	manifest.LoadBalancedWebServiceType,
+	manifest.BackendServiceType,
+	manifest.WorkerServiceType,
+}
+
+type svcHistoryVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+	limit            int
+}
+
+type svcHistoryOpts struct {
+	svcHistoryVars
+
+	w                    io.Writer
+	store                store
+	historyDescriber     statusDescriber
+	sel                  deploySelector
+	initHistoryDescriber func(*svcHistoryOpts) error
+}
+
+func newSvcHistoryOpts(vars svcHistoryVars) (*svcHistoryOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcHistoryOpts{
+		svcHistoryVars: vars,
+		store:          configStore,
+		w:              log.OutputWriter,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initHistoryDescriber: func(o *svcHistoryOpts) error {
+			if err := validateECSService(configStore, o.appName, o.svcName, "showing the deployment history"); err != nil {
+				return err
+			}
+			d, err := describe.NewServiceHistoryDescriber(&describe.NewServiceHistoryConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.svcName,
+				Limit:       o.limit,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating history describer for service %s in application %s: %w", o.svcName, o.appName, err)
+			}
+			o.historyDescriber = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcHistoryOpts) Validate() error {
+	if o.limit < 0 {
+		return fmt.Errorf("--%s must be a positive number", limitFlag)
+	}
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return err
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcHistoryOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute displays the previous deployments of the service.
+func (o *svcHistoryOpts) Execute() error {
+	if err := o.initHistoryDescriber(o); err != nil {
+		return err
+	}
+	history, err := o.historyDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe deployment history of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := history.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, history.HumanString())
+	}
+	return nil
+}
+
+func (o *svcHistoryOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcHistoryOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcHistoryNamePrompt, svcHistoryNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithSvc(o.svcName), selector.WithServiceTypesFilter(ecsServiceTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// validateECSService returns an error if the service is not deployed to ECS.
+func validateECSService(store store, app, svc, action string) error {
+	wkld, err := store.GetWorkload(app, svc)
+	if err != nil {
+		return fmt.Errorf("retrieve %s from application %s: %w", svc, app, err)
+	}
+	for _, svcType := range ecsServiceTypes {
+		if wkld.Type == svcType {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not supported for services with type %s, only for: %s", action, wkld.Type, strings.Join(ecsServiceTypes, ", "))
+}
+
+// buildSvcHistoryCmd builds the command for showing the previous deployments of a service.
+func buildSvcHistoryCmd() *cobra.Command {
+	vars := svcHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows the previous deployments of a service.",
+		Long: `Shows the previous deployments of a service.
+Each deployment lists its revision, who deployed it and when, and the image with its digest.`,
+
+		Example: `
+  Shows the last 10 deployments of the service "my-svc" in the "test" environment.
+  /code $ copilot svc history -n my-svc -e test
+  Shows the last 3 deployments in JSON format.
+  /code $ copilot svc history -n my-svc -e test --limit 3 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, describe.DefaultServiceHistoryLimit, svcHistoryLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
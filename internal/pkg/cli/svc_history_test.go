@@ -0,0 +1,208 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcHistory_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp        string
+		inputSvc        string
+		inputLimit      int
+		mockStoreReader func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"invalid limit": {
+			inputLimit: -1,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--limit must be a positive number"),
+		},
+		"invalid service name": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			inputApp:   "my-app",
+			inputSvc:   "my-svc",
+			inputLimit: 3,
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{
+					Name: "my-svc",
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := mocks.NewMockstore(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+
+			opts := &svcHistoryOpts{
+				svcHistoryVars: svcHistoryVars{
+					svcName: tc.inputSvc,
+					appName: tc.inputApp,
+					limit:   tc.inputLimit,
+				},
+				store: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcHistory_Execute(t *testing.T) {
+	history := &describe.ServiceHistory{
+		Revisions: []describe.ServiceRevision{
+			{
+				Revision: 2,
+				Image:    "nginx:latest",
+				Current:  true,
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON     bool
+		mockHistoryDescriber func(m *mocks.MockstatusDescriber)
+
+		wantedError  error
+		wantedOutput string
+	}{
+		"errors if failed to describe the history of the service": {
+			mockHistoryDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe deployment history of service mockSvc: some error"),
+		},
+		"writes the history in JSON format": {
+			shouldOutputJSON: true,
+			mockHistoryDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(history, nil)
+			},
+			wantedOutput: "{\"revisions\":[{\"revision\":2,\"image\":\"nginx:latest\",\"deployedAt\":\"0001-01-01T00:00:00Z\",\"current\":true}]}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockHistoryDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockHistoryDescriber(mockHistoryDescriber)
+
+			opts := &svcHistoryOpts{
+				svcHistoryVars: svcHistoryVars{
+					svcName:          "mockSvc",
+					envName:          "mockEnv",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					appName:          "mockApp",
+				},
+				historyDescriber:     mockHistoryDescriber,
+				initHistoryDescriber: func(*svcHistoryOpts) error { return nil },
+				w:                    b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, b.String())
+			}
+		})
+	}
+}
+
+func Test_validateECSService(t *testing.T) {
+	testCases := map[string]struct {
+		mockStore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if failed to get the workload": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve my-svc from application my-app: some error"),
+		},
+		"errors if the service is deployed to App Runner": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.RequestDrivenWebServiceType,
+				}, nil)
+			},
+			wantedError: errors.New("rolling back is not supported for services with type Request-Driven Web Service, only for: Load Balanced Web Service, Backend Service, Worker Service"),
+		},
+		"success for services deployed to ECS": {
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			// WHEN
+			err := validateECSService(mockStore, "my-app", "my-svc", "rolling back")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
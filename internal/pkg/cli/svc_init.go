@@ -262,7 +262,7 @@ func (o *initSvcOpts) Execute() error {
 	if o.platform != nil {
 		log.Warningf(`Your architecture type is currently unsupported. Setting platform %s instead.\n`, dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch))
 		if o.wkldType != manifest.RequestDrivenWebServiceType {
-			log.Warning("See 'platform' field in your manifest.\n")
+			log.Warningf("See 'platform' field in your manifest to build for %s or for a list of platforms instead.\n", dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Arm64Arch))
 		}
 	}
 
@@ -76,6 +76,12 @@ type packageSvcOpts struct {
 	stackSerializer   func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
 	newEndpointGetter func(app, env string) (endpointGetter, error)
 	snsTopicGetter    deployedEnvironmentLister
+
+	newTrafficWeightsGetter func(env *config.Environment) (trafficWeightsGetter, error)
+
+	// Set by "pipeline build" after it uploaded the addons and pushed the image of the service.
+	addonsURL   string
+	imageDigest string
 }
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
@@ -129,6 +135,14 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 				}
+			} else if env.HasImportedCerts() {
+				if err := validateLBSvcAliasWithImportedCerts(aws.StringValue(t.Name), t.Alias, env.Name); err != nil {
+					return nil, err
+				}
+				serializer, err = stack.NewHTTPSLoadBalancedWebService(t, env.Name, app.Name, rc)
+				if err != nil {
+					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
+				}
 			} else {
 				serializer, err = stack.NewLoadBalancedWebService(t, env.Name, app.Name, rc)
 				if err != nil {
@@ -228,6 +242,13 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		}
 		return d, nil
 	}
+	opts.newTrafficWeightsGetter = func(env *config.Environment) (trafficWeightsGetter, error) {
+		envSess, err := p.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("assume environment manager role: %w", err)
+		}
+		return cloudformation.New(envSess), nil
+	}
 	return opts, nil
 }
 
@@ -379,6 +400,7 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 		return nil, err
 	}
 	rc := stack.RuntimeConfig{
+		AddonsTemplateURL:        o.addonsURL,
 		AdditionalTags:           app.Tags,
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                app.AccountID,
@@ -401,6 +423,17 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 		rc.Image = &stack.ECRImage{
 			RepoURL:  repoURL,
 			ImageTag: o.tag,
+			Digest:   o.imageDigest,
+		}
+	}
+	if lbMft, ok := envMft.(*manifest.LoadBalancedWebService); ok && lbMft.Deployment.ShiftsTraffic() {
+		// Keep the weights that ECS last set on the deployed service, so that the stack update doesn't shift the traffic.
+		weightsGetter, err := o.newTrafficWeightsGetter(env)
+		if err != nil {
+			return nil, err
+		}
+		if rc.TrafficWeights, err = weightsGetter.TrafficWeights(stack.NameForService(o.appName, o.envName, o.name)); err != nil {
+			return nil, fmt.Errorf("get traffic weights of service %s: %w", o.name, err)
 		}
 	}
 	serializer, err := o.stackSerializer(envMft, env, app, rc)
@@ -0,0 +1,357 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackNamePrompt     = "Which service would you like to roll back?"
+	svcRollbackNameHelpPrompt = "The selected service will be redeployed with the configuration of a previous deployment."
+	svcRollbackRevisionPrompt = "Which deployment would you like to roll back to?"
+	svcRollbackRevisionHelp   = "The service is redeployed with the image, template and parameters of the selected deployment."
+
+	fmtSvcRollbackConfirmPrompt = "Are you sure you want to roll back service %s in environment %s to revision %d?"
+	fmtSvcRollbackSucceed       = "Rolled back service %s in environment %s to revision %d.\n"
+)
+
+type svcRollbackVars struct {
+	svcName          string
+	envName          string
+	appName          string
+	revision         int
+	skipConfirmation bool
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store            store
+	prompt           prompter
+	sel              deploySelector
+	revisions        serviceRevisionDescriber
+	rollbacker       serviceRollbacker
+	trafficWeights   trafficWeightsGetter
+	identity         identityService
+	appCFN           appResourcesGetter
+	revisionStore    serviceRevisionStore
+	taskDefRevisions taskDefRevisionGetter
+	targetApp        *config.Application
+	targetEnv        *config.Environment
+	initClients      func(*svcRollbackOpts) error
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		prompt:          prompter,
+		sel:             selector.NewDeploySelect(prompter, configStore, deployStore),
+		initClients: func(o *svcRollbackOpts) error {
+			if err := validateECSService(configStore, o.appName, o.svcName, "rolling back"); err != nil {
+				return err
+			}
+			app, err := configStore.GetApplication(o.appName)
+			if err != nil {
+				return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+			}
+			o.targetApp = app
+			env, err := configStore.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			o.targetEnv = env
+			d, err := describe.NewServiceHistoryDescriber(&describe.NewServiceHistoryConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating history describer for service %s in application %s: %w", o.svcName, o.appName, err)
+			}
+			o.revisions = d
+			provider := sessions.NewProvider()
+			envSess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assuming environment manager role: %w", err)
+			}
+			cfn := cloudformation.New(envSess)
+			o.rollbacker = cfn
+			o.trafficWeights = cfn
+			o.taskDefRevisions = ecs.New(envSess)
+			defaultSess, err := provider.Default()
+			if err != nil {
+				return err
+			}
+			o.identity = identity.New(defaultSess)
+			o.appCFN = cloudformation.New(defaultSess)
+			// The configuration of each revision is stored in the application's bucket in the environment's region.
+			defaultSessEnvRegion, err := provider.DefaultWithRegion(env.Region)
+			if err != nil {
+				return fmt.Errorf("create default session with region %s: %w", env.Region, err)
+			}
+			o.revisionStore = s3.New(defaultSessEnvRegion)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcRollbackOpts) Validate() error {
+	if o.revision < 0 {
+		return fmt.Errorf("--%s must be a positive revision", toFlag)
+	}
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return err
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcRollbackOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute redeploys the service with the image, template and parameters of a previous deployment.
+func (o *svcRollbackOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	target, err := o.targetRevision()
+	if err != nil {
+		return err
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnv.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", o.appName, o.targetEnv.Region, err)
+	}
+	conf, err := o.revisionConfig(resources.S3Bucket, target, caller.ARN)
+	if err != nil {
+		return err
+	}
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcRollbackConfirmPrompt,
+			color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), target.Revision), "", prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("svc rollback confirmation prompt: %w", err)
+		}
+		if !confirmed {
+			return errors.New("svc rollback cancelled - no changes made")
+		}
+	}
+	if err := o.rollbacker.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnv.ExecutionRoleARN)); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmptyCS) {
+			log.Infof("Service %s in environment %s is already deployed with the configuration of revision %d.\n", o.svcName, o.envName, target.Revision)
+			return nil
+		}
+		return fmt.Errorf("roll back service %s to revision %d: %w", o.svcName, target.Revision, err)
+	}
+	log.Successf(fmtSvcRollbackSucceed, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), target.Revision)
+	// The rollback registers a new task definition revision, record it so that it can be rolled back to as well.
+	if err := recordServiceRevision(recordServiceRevisionInput{
+		taskDefRevisions: o.taskDefRevisions,
+		store:            o.revisionStore,
+		marshal:          marshalServiceRevision,
+		bucket:           resources.S3Bucket,
+		conf:             conf,
+		app:              o.appName,
+		env:              o.envName,
+		svc:              o.svcName,
+	}); err != nil {
+		log.Warningf("Failed to record the rollback of service %s: %v\n", o.svcName, err)
+	}
+	return nil
+}
+
+// revisionConfig returns the template, parameters and tags that the service was deployed with at the target revision,
+// with the image of the revision and deployedBy recorded as the principal of the rollback.
+func (o *svcRollbackOpts) revisionConfig(bucket string, target *describe.ServiceRevision, deployedBy string) (*stack.WorkloadRevision, error) {
+	data, err := o.revisionStore.GetObject(bucket, fmt.Sprintf(deploy.ServiceRevisionKeyFormat, o.envName, o.svcName, target.Revision))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, fmt.Errorf("configuration of revision %d of service %s is not recorded: only revisions deployed with %s or %s can be rolled back to",
+				target.Revision, o.svcName, color.HighlightCode("svc deploy"), color.HighlightCode("svc rollback"))
+		}
+		return nil, fmt.Errorf("get configuration of revision %d of service %s: %w", target.Revision, o.svcName, err)
+	}
+	conf, err := stack.UnmarshalWorkloadRevision(data, target.PinnedImage(), deployedBy)
+	if err != nil {
+		return nil, fmt.Errorf("read configuration of revision %d of service %s: %w", target.Revision, o.svcName, err)
+	}
+	weights, err := o.trafficWeights.TrafficWeights(conf.StackName())
+	if err != nil {
+		return nil, fmt.Errorf("get traffic weights of service %s: %w", o.svcName, err)
+	}
+	conf.SetTrafficWeights(weights)
+	return conf, nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to see the rollback in the deployment history of your service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc history -n %s -e %s", o.svcName, o.envName))),
+	})
+	return nil
+}
+
+// targetRevision returns the revision passed with --to, or prompts for one of the previous deployments.
+func (o *svcRollbackOpts) targetRevision() (*describe.ServiceRevision, error) {
+	if o.revision != 0 {
+		revision, err := o.revisions.Revision(o.revision)
+		if err != nil {
+			return nil, err
+		}
+		if revision.Current {
+			return nil, fmt.Errorf("revision %d is the current deployment of service %s", o.revision, o.svcName)
+		}
+		return revision, nil
+	}
+	revisions, err := o.revisions.Revisions()
+	if err != nil {
+		return nil, err
+	}
+	var previous []describe.ServiceRevision
+	for _, revision := range revisions {
+		if !revision.Current {
+			previous = append(previous, revision)
+		}
+	}
+	if len(previous) == 0 {
+		return nil, fmt.Errorf("no previous deployment of service %s to roll back to", o.svcName)
+	}
+	var options []prompt.Option
+	for _, revision := range previous {
+		options = append(options, prompt.Option{
+			Value: strconv.Itoa(revision.Revision),
+			Hint:  revisionHint(revision),
+		})
+	}
+	selected, err := o.prompt.SelectOption(svcRollbackRevisionPrompt, svcRollbackRevisionHelp, options, prompt.WithFinalMessage("Revision:"))
+	if err != nil {
+		return nil, fmt.Errorf("select revision: %w", err)
+	}
+	for i := range previous {
+		if strconv.Itoa(previous[i].Revision) == selected {
+			return &previous[i], nil
+		}
+	}
+	return nil, fmt.Errorf("revision %s is not a previous deployment of service %s", selected, o.svcName)
+}
+
+// revisionHint describes a deployment as "<image>, deployed <time> by <principal>".
+func revisionHint(revision describe.ServiceRevision) string {
+	hint := fmt.Sprintf("%s, deployed %s", revision.Image, humanize.Time(revision.DeployedAt))
+	if revision.DeployedBy != "" {
+		hint = fmt.Sprintf("%s by %s", hint, revision.DeployedBy)
+	}
+	return hint
+}
+
+func (o *svcRollbackOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRollbackOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithSvc(o.svcName), selector.WithServiceTypesFilter(ecsServiceTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcRollbackCmd builds the command for rolling back a service to a previous deployment.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back a service to a previous deployment.",
+		Long: `Rolls back a service to a previous deployment.
+The service is redeployed with the template and parameters of the selected deployment,
+and with its image pinned by digest if the digest was recorded.`,
+
+		Example: `
+  Select a previous deployment of the service "my-svc" in the "test" environment to roll back to.
+  /code $ copilot svc rollback -n my-svc -e test
+  Roll back to revision 12 without confirmation.
+  /code $ copilot svc rollback -n my-svc -e test --to 12 --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.revision, toFlag, 0, svcRollbackToFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
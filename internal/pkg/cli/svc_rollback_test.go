@@ -0,0 +1,349 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcRollbackMocks struct {
+	revisions        *mocks.MockserviceRevisionDescriber
+	rollbacker       *mocks.MockserviceRollbacker
+	trafficWeights   *mocks.MocktrafficWeightsGetter
+	identity         *mocks.MockidentityService
+	prompt           *mocks.Mockprompter
+	appCFN           *mocks.MockappResourcesGetter
+	revisionStore    *mocks.MockserviceRevisionStore
+	taskDefRevisions *mocks.MocktaskDefRevisionGetter
+}
+
+func TestSvcRollback_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp        string
+		inputRevision   int
+		mockStoreReader func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"invalid revision": {
+			inputRevision: -2,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--to must be a positive revision"),
+		},
+		"invalid app name": {
+			inputApp: "my-app",
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			inputApp:      "my-app",
+			inputRevision: 3,
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := mocks.NewMockstore(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:  tc.inputApp,
+					revision: tc.inputRevision,
+				},
+				store: mockStoreReader,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcRollback_Execute(t *testing.T) {
+	const (
+		mockRepoURI   = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+		mockDigest    = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+		mockCallerARN = "arn:aws:sts::123456789012:assumed-role/Admin/alice"
+	)
+	mockError := errors.New("some error")
+	current := describe.ServiceRevision{
+		Revision: 3,
+		Image:    mockRepoURI + ":v2",
+		Current:  true,
+	}
+	previous := describe.ServiceRevision{
+		Revision:   2,
+		Image:      mockRepoURI + ":v1",
+		Digest:     mockDigest,
+		DeployedBy: "arn:aws:sts::123456789012:assumed-role/Admin/bob",
+	}
+	mockApp := &config.Application{Name: "phonetool"}
+	mockRecord := []byte(`{"stackName":"phonetool-test-api","template":"template","parameters":{"ContainerImage":"` + mockRepoURI + `:v1","DeployedBy":"arn:aws:sts::123456789012:assumed-role/Admin/bob","TaskCount":"1"}}`)
+	const mockRecordKey = "revisions/test/api/2.json"
+	// The rollback redeploys the recorded template and parameters, with the image pinned by its digest and the caller as the principal.
+	wantedConf := func(t *testing.T, conf cloudformation.StackConfiguration) {
+		tpl, err := conf.Template()
+		require.NoError(t, err)
+		require.Equal(t, "template", tpl)
+		params, err := conf.Parameters()
+		require.NoError(t, err)
+		require.Equal(t, []*sdkcloudformation.Parameter{
+			{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String(mockRepoURI + "@" + mockDigest)},
+			{ParameterKey: aws.String("DeployedBy"), ParameterValue: aws.String(mockCallerARN)},
+			{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("1")},
+		}, params)
+	}
+	testCases := map[string]struct {
+		inputRevision    int
+		skipConfirmation bool
+		initClientsErr   error
+		setupMocks       func(m svcRollbackMocks)
+
+		wantedError error
+	}{
+		"errors if failed to initialize clients": {
+			initClientsErr: mockError,
+			setupMocks:     func(m svcRollbackMocks) {},
+			wantedError:    mockError,
+		},
+		"errors if the revision is the current deployment": {
+			inputRevision: 3,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(3).Return(&current, nil)
+			},
+			wantedError: errors.New("revision 3 is the current deployment of service api"),
+		},
+		"errors if failed to get the revision": {
+			inputRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"errors if there is no previous deployment": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revisions().Return([]describe.ServiceRevision{current}, nil)
+			},
+			wantedError: errors.New("no previous deployment of service api to roll back to"),
+		},
+		"errors if failed to get the application resources": {
+			inputRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(nil, mockError)
+			},
+			wantedError: errors.New("get application phonetool resources from region us-west-2: some error"),
+		},
+		"errors if the configuration of the revision is not recorded": {
+			inputRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(nil, &s3.ErrObjectNotFound{})
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("configuration of revision 2 of service api is not recorded: only revisions deployed with `svc deploy` or `svc rollback` can be rolled back to"),
+		},
+		"errors if failed to get the configuration of the revision": {
+			inputRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(nil, mockError)
+			},
+			wantedError: errors.New("get configuration of revision 2 of service api: some error"),
+		},
+		"errors if failed to get the traffic weights of the service": {
+			inputRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(mockRecord, nil)
+				m.trafficWeights.EXPECT().TrafficWeights("phonetool-test-api").Return(nil, mockError)
+			},
+			wantedError: errors.New("get traffic weights of service api: some error"),
+		},
+		"errors if the rollback is not confirmed": {
+			inputRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(mockRecord, nil)
+				m.trafficWeights.EXPECT().TrafficWeights("phonetool-test-api").Return(nil, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, nil)
+				m.rollbacker.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("svc rollback cancelled - no changes made"),
+		},
+		"wraps deployment errors": {
+			inputRevision:    2,
+			skipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(mockRecord, nil)
+				m.trafficWeights.EXPECT().TrafficWeights("phonetool-test-api").Return(nil, nil)
+				m.rollbacker.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedError: errors.New("roll back service api to revision 2: some error"),
+		},
+		"succeeds if the service is already deployed with the revision": {
+			inputRevision:    2,
+			skipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(mockRecord, nil)
+				m.trafficWeights.EXPECT().TrafficWeights("phonetool-test-api").Return(nil, nil)
+				m.rollbacker.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(awscloudformation.NewMockErrChangeSetEmpty())
+				m.revisionStore.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"succeeds if failed to record the new revision": {
+			inputRevision:    2,
+			skipConfirmation: true,
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revision(2).Return(&previous, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(mockRecord, nil)
+				m.trafficWeights.EXPECT().TrafficWeights("phonetool-test-api").Return(nil, nil)
+				m.rollbacker.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.taskDefRevisions.EXPECT().ServiceTaskDefinitionRevision("phonetool", "test", "api").Return(0, mockError)
+			},
+		},
+		"redeploys the selected previous deployment and records the new revision": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.revisions.EXPECT().Revisions().Return([]describe.ServiceRevision{current, previous}, nil)
+				m.prompt.EXPECT().SelectOption(svcRollbackRevisionPrompt, svcRollbackRevisionHelp, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, opts []prompt.Option, _ ...prompt.PromptConfig) (string, error) {
+						require.Len(t, opts, 1)
+						require.Equal(t, "2", opts[0].Value)
+						return "2", nil
+					})
+				m.identity.EXPECT().Get().Return(identity.Caller{ARN: mockCallerARN}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.revisionStore.EXPECT().GetObject("mockBucket", mockRecordKey).Return(mockRecord, nil)
+				m.trafficWeights.EXPECT().TrafficWeights("phonetool-test-api").Return(nil, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+				m.rollbacker.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ termprogress.FileWriter, conf cloudformation.StackConfiguration, _ ...awscloudformation.StackOption) error {
+						wantedConf(t, conf)
+						return nil
+					})
+				m.taskDefRevisions.EXPECT().ServiceTaskDefinitionRevision("phonetool", "test", "api").Return(4, nil)
+				m.revisionStore.EXPECT().PutObject("mockBucket", "revisions/test/api/4.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						b, err := ioutil.ReadAll(data)
+						require.NoError(t, err)
+						conf, err := stack.UnmarshalWorkloadRevision(b, mockRepoURI+"@"+mockDigest, mockCallerARN)
+						require.NoError(t, err)
+						wantedConf(t, conf)
+						return "", nil
+					})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRollbackMocks{
+				revisions:        mocks.NewMockserviceRevisionDescriber(ctrl),
+				rollbacker:       mocks.NewMockserviceRollbacker(ctrl),
+				trafficWeights:   mocks.NewMocktrafficWeightsGetter(ctrl),
+				identity:         mocks.NewMockidentityService(ctrl),
+				prompt:           mocks.NewMockprompter(ctrl),
+				appCFN:           mocks.NewMockappResourcesGetter(ctrl),
+				revisionStore:    mocks.NewMockserviceRevisionStore(ctrl),
+				taskDefRevisions: mocks.NewMocktaskDefRevisionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:          "phonetool",
+					envName:          "test",
+					svcName:          "api",
+					revision:         tc.inputRevision,
+					skipConfirmation: tc.skipConfirmation,
+				},
+				prompt:           m.prompt,
+				revisions:        m.revisions,
+				rollbacker:       m.rollbacker,
+				trafficWeights:   m.trafficWeights,
+				identity:         m.identity,
+				appCFN:           m.appCFN,
+				revisionStore:    m.revisionStore,
+				taskDefRevisions: m.taskDefRevisions,
+				targetApp:        mockApp,
+				targetEnv: &config.Environment{
+					Region:           "us-west-2",
+					ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+				},
+				initClients: func(*svcRollbackOpts) error { return tc.initClientsErr },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
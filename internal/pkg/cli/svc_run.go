@@ -0,0 +1,463 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcRunNamePrompt     = "Which service would you like to run locally?"
+	svcRunNameHelpPrompt = "Copilot builds the service's image and starts its containers with Docker."
+	svcRunEnvPrompt      = "Which environment's configuration would you like to use?"
+	svcRunEnvHelpPrompt  = `The environment overrides in the manifest are applied to the service,
+and secrets are retrieved from the environment's account and region.`
+
+	secretsManagerServiceName = "secretsmanager"
+)
+
+var errSvcRunNotLocal = fmt.Errorf("running a service is only supported on the local machine, please specify --%s", localFlag)
+
+type svcRunVars struct {
+	appName     string
+	name        string
+	envName     string
+	local       bool
+	secretsFile string
+}
+
+type svcRunOpts struct {
+	svcRunVars
+
+	store     store
+	ws        wsSvcDirReader
+	fs        afero.Fs
+	docker    containerRunner
+	unmarshal func([]byte) (manifest.WorkloadManifest, error)
+	sel       wsSelector
+
+	// Secret getters against the environment's account and region. Initialized lazily only if secrets need to be resolved.
+	ssm                  secretValueGetter
+	secretsManager       secretValueGetter
+	configureSecretsFunc func(env *config.Environment) error
+
+	// Writer to stream the containers' logs to. Override in unit tests.
+	out io.Writer
+	// Returns a channel that receives the signals interrupting the command, and a function to stop receiving them.
+	notifyInterrupt func() (<-chan os.Signal, func())
+}
+
+func newSvcRunOpts(vars svcRunVars) (*svcRunOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	opts := &svcRunOpts{
+		svcRunVars: vars,
+
+		store:     store,
+		ws:        ws,
+		fs:        &afero.Afero{Fs: afero.NewOsFs()},
+		docker:    dockerengine.New(exec.NewCmd()),
+		unmarshal: manifest.UnmarshalWorkload,
+		sel:       selector.NewWorkspaceSelect(prompt.New(), store, ws),
+		out:       os.Stdout,
+		notifyInterrupt: func() (<-chan os.Signal, func()) {
+			c := make(chan os.Signal, 1)
+			signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+			return c, func() { signal.Stop(c) }
+		},
+	}
+	opts.configureSecretsFunc = func(env *config.Environment) error {
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("assume environment manager role: %w", err)
+		}
+		opts.ssm = ssm.New(sess)
+		opts.secretsManager = secretsmanager.NewFromSession(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcRunOpts) Validate() error {
+	if !o.local {
+		return errSvcRunNotLocal
+	}
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.secretsFile != "" {
+		if _, err := o.fs.Stat(o.secretsFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *svcRunOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcRunNamePrompt, svcRunNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(svcRunEnvPrompt, svcRunEnvHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	return nil
+}
+
+// Execute builds the service's image and runs its main container along with its sidecars until the main container stops.
+// The containers are removed once the main container stops or the command is interrupted.
+// It returns an error if the main container exits with a non-zero code.
+func (o *svcRunOpts) Execute() error {
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return err
+	}
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	containers, err := localContainersFromManifest(o.appName, o.envName, o.name, mft)
+	if err != nil {
+		return err
+	}
+	main := containers[0]
+	if main.ImageURI == "" {
+		if main.ImageURI, err = o.buildImage(mft); err != nil {
+			return err
+		}
+	}
+	for _, container := range containers {
+		secrets, err := o.resolveSecrets(container.Secrets)
+		if err != nil {
+			return err
+		}
+		container.Secrets = secrets
+	}
+
+	interrupted, stopNotify := o.notifyInterrupt()
+	defer stopNotify()
+	var names []string
+	removeContainers := func() {
+		if err := o.docker.Remove(names...); err != nil {
+			log.Warningf("Failed to clean up the containers of service %s: %v\n", o.name, err)
+		}
+		names = nil
+	}
+	defer func() {
+		if names != nil {
+			removeContainers()
+		}
+	}()
+	for _, container := range containers {
+		log.Infof("Starting container %s.\n", color.HighlightResource(container.ContainerName))
+		if err := o.docker.Run(container); err != nil {
+			return err
+		}
+		names = append(names, container.ContainerName)
+	}
+	log.Successf("Running service %s locally with the configuration of environment %s.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	logs := make(chan error, 1)
+	go func() {
+		logs <- o.docker.Logs(main.ContainerName, o.out)
+	}()
+	select {
+	case err := <-logs:
+		if err != nil {
+			return err
+		}
+	case <-interrupted:
+		log.Infof("Stopping service %s.\n", color.HighlightUserInput(o.name))
+		removeContainers()
+		// The logs stop streaming once the main container is removed.
+		<-logs
+		return nil
+	}
+	// The logs stop streaming once the main container stops, which is kept until its exit code is read.
+	code, err := o.docker.Wait(main.ContainerName)
+	if err != nil {
+		return err
+	}
+	removeContainers()
+	if code != 0 {
+		return fmt.Errorf("main container %s of service %s exited with code %d", main.ContainerName, o.name, code)
+	}
+	return nil
+}
+
+func (o *svcRunOpts) manifest() (manifest.WorkloadManifest, error) {
+	raw, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read service %s manifest file: %w", o.name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	return envMft, nil
+}
+
+func (o *svcRunOpts) buildImage(mft manifest.WorkloadManifest) (string, error) {
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return "", fmt.Errorf("get copilot directory: %w", err)
+	}
+	args, err := buildArgs(o.name, "", copilotDir, mft)
+	if err != nil {
+		return "", err
+	}
+	args.URI = localImageName(o.appName, o.name)
+	if err := o.docker.Build(args); err != nil {
+		return "", fmt.Errorf("build image for service %s: %w", o.name, err)
+	}
+	return args.URI, nil
+}
+
+// resolveSecrets returns the plaintext values of the secrets, keyed by their environment variable names.
+// The values are read from the secrets file if one is provided. Otherwise, they are retrieved
+// from SSM Parameter Store or AWS Secrets Manager depending on the secret's ARN.
+func (o *svcRunOpts) resolveSecrets(secrets map[string]string) (map[string]string, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	if o.secretsFile != "" {
+		return o.stubSecrets(secrets)
+	}
+	if err := o.configureSecrets(); err != nil {
+		return nil, err
+	}
+	resolved := make(map[string]string, len(secrets))
+	for name, valueFrom := range secrets {
+		getter := o.ssm
+		if parsed, err := arn.Parse(valueFrom); err == nil && parsed.Service == secretsManagerServiceName {
+			getter = o.secretsManager
+		}
+		value, err := getter.GetSecretValue(valueFrom)
+		if err != nil {
+			return nil, fmt.Errorf("resolve secret %s: %w", name, err)
+		}
+		resolved[name] = value
+	}
+	return resolved, nil
+}
+
+func (o *svcRunOpts) stubSecrets(secrets map[string]string) (map[string]string, error) {
+	raw, err := afero.ReadFile(o.fs, o.secretsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read secrets file %s: %w", o.secretsFile, err)
+	}
+	var stubs map[string]string
+	if err := yaml.Unmarshal(raw, &stubs); err != nil {
+		return nil, fmt.Errorf("unmarshal secrets file %s: %w", o.secretsFile, err)
+	}
+	resolved := make(map[string]string, len(secrets))
+	var missing []string
+	for name := range secrets {
+		value, ok := stubs[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		resolved[name] = value
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("secrets file %s is missing values for: %s", o.secretsFile, strings.Join(missing, ", "))
+	}
+	return resolved, nil
+}
+
+func (o *svcRunOpts) configureSecrets() error {
+	if o.ssm != nil && o.secretsManager != nil {
+		return nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	return o.configureSecretsFunc(env)
+}
+
+// localContainersFromManifest returns the options to run the containers of the service.
+// The main container is always the first element; its image URI is empty if the image needs to be built.
+// Sidecars join the network stack of the main container so that, like in an ECS task, they can reach each other over localhost.
+func localContainersFromManifest(app, env, svc string, mft manifest.WorkloadManifest) ([]*dockerengine.RunOptions, error) {
+	var (
+		img      manifest.Image
+		port     *uint16
+		task     manifest.TaskConfig
+		override manifest.ImageOverride
+		sidecars map[string]*manifest.SidecarConfig
+	)
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		img, port, task, override, sidecars = t.ImageConfig.Image, t.ImageConfig.Port, t.TaskConfig, t.ImageOverride, t.Sidecars
+	case *manifest.BackendService:
+		img, port, task, override, sidecars = t.ImageConfig.Image, t.ImageConfig.Port, t.TaskConfig, t.ImageOverride, t.Sidecars
+	case *manifest.WorkerService:
+		img, task, override, sidecars = t.ImageConfig.Image, t.TaskConfig, t.ImageOverride, t.Sidecars
+	default:
+		return nil, fmt.Errorf("running a %T locally is not supported", t)
+	}
+
+	mainName := localContainerName(app, svc)
+	main := &dockerengine.RunOptions{
+		ImageURI:      img.GetLocation(),
+		ContainerName: mainName,
+		Ports:         make(map[string]string),
+		EnvVars:       localEnvVars(app, env, svc, task.Variables),
+		Secrets:       task.Secrets,
+	}
+	if port := aws.Uint16Value(port); port != 0 {
+		main.Ports[fmt.Sprintf("%d", port)] = fmt.Sprintf("%d", port)
+	}
+	if override.Command != nil {
+		cmd, err := override.Command.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert 'command' to string slice: %w", err)
+		}
+		main.Command = cmd
+	}
+
+	// Sort the sidecars by name so that they're started in a deterministic order.
+	var sidecarNames []string
+	for name := range sidecars {
+		sidecarNames = append(sidecarNames, name)
+	}
+	sort.Strings(sidecarNames)
+	containers := []*dockerengine.RunOptions{main}
+	for _, name := range sidecarNames {
+		sidecar := sidecars[name]
+		if sidecar == nil {
+			continue
+		}
+		if sidecar.Image == nil {
+			return nil, fmt.Errorf("sidecar %s must have an image to run locally", name)
+		}
+		if sidecar.Port != nil {
+			// Ports of containers sharing a network stack can only be published by the container that owns the stack.
+			sidecarPort := strings.Split(aws.StringValue(sidecar.Port), "/")[0]
+			main.Ports[sidecarPort] = sidecarPort
+		}
+		container := &dockerengine.RunOptions{
+			ImageURI:         aws.StringValue(sidecar.Image),
+			ContainerName:    fmt.Sprintf("%s-%s", mainName, name),
+			EnvVars:          localEnvVars(app, env, svc, sidecar.Variables),
+			Secrets:          sidecar.Secrets,
+			ContainerNetwork: mainName,
+		}
+		if sidecar.Command != nil {
+			cmd, err := sidecar.Command.ToStringSlice()
+			if err != nil {
+				return nil, fmt.Errorf("convert 'command' of sidecar %s to string slice: %w", name, err)
+			}
+			container.Command = cmd
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
+// localEnvVars returns the manifest variables along with the variables that Copilot injects into deployed containers.
+func localEnvVars(app, env, svc string, vars map[string]string) map[string]string {
+	out := map[string]string{
+		"COPILOT_APPLICATION_NAME": app,
+		"COPILOT_ENVIRONMENT_NAME": env,
+		"COPILOT_SERVICE_NAME":     svc,
+	}
+	for k, v := range vars {
+		out[k] = v
+	}
+	return out
+}
+
+func localContainerName(app, svc string) string {
+	return fmt.Sprintf("copilot-%s-%s", app, svc)
+}
+
+func localImageName(app, svc string) string {
+	return fmt.Sprintf("%s/%s", app, svc)
+}
+
+// buildSvcRunCmd builds the command for running a service.
+func buildSvcRunCmd() *cobra.Command {
+	vars := svcRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run a service locally.",
+		Long: `Run a service locally.
+Copilot builds the service's image and runs its main container and sidecars with Docker,
+using the manifest variables, secrets and overrides of the selected environment.`,
+		Example: `
+  Run the service "frontend" locally with the configuration of the "test" environment.
+  /code $ copilot svc run --local -n frontend -e test
+  Run the service with secret values stubbed from a local file.
+  /code $ copilot svc run --local -n frontend -e test --secrets-file secrets.yml`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.local, localFlag, false, localRunFlagDescription)
+	cmd.Flags().StringVar(&vars.secretsFile, secretsFileFlag, "", secretsFileFlagDescription)
+	return cmd
+}
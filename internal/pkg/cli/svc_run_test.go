@@ -0,0 +1,425 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRunOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inLocal       bool
+		inAppName     string
+		inSvcName     string
+		inEnvName     string
+		inSecretsFile string
+
+		setupMocks func(m *mocks.Mockstore)
+		setupFs    func(fs afero.Fs)
+
+		wantedError error
+	}{
+		"error if --local is not set": {
+			inAppName:  "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errSvcRunNotLocal,
+		},
+		"error if no app in workspace": {
+			inLocal:    true,
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"error if service does not exist": {
+			inLocal:   true,
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"error if environment does not exist": {
+			inLocal:   true,
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"error if secrets file does not exist": {
+			inLocal:       true,
+			inAppName:     "phonetool",
+			inSecretsFile: "secrets.yml",
+			setupMocks:    func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("open secrets.yml: file does not exist"),
+		},
+		"success": {
+			inLocal:       true,
+			inAppName:     "phonetool",
+			inSvcName:     "frontend",
+			inEnvName:     "test",
+			inSecretsFile: "secrets.yml",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "secrets.yml", []byte("GITHUB_TOKEN: abc"), 0644)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			opts := &svcRunOpts{
+				svcRunVars: svcRunVars{
+					local:       tc.inLocal,
+					appName:     tc.inAppName,
+					name:        tc.inSvcName,
+					envName:     tc.inEnvName,
+					secretsFile: tc.inSecretsFile,
+				},
+				store: mockStore,
+				fs:    fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type svcRunMocks struct {
+	store  *mocks.Mockstore
+	ws     *mocks.MockwsSvcDirReader
+	docker *mocks.MockcontainerRunner
+	ssm    *mocks.MocksecretValueGetter
+	sm     *mocks.MocksecretValueGetter
+}
+
+func TestSvcRunOpts_Execute(t *testing.T) {
+	const lbManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 8080
+variables:
+  LOG_LEVEL: info
+secrets:
+  GITHUB_TOKEN: GH_TOKEN
+  DB_PASSWORD: arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-abc123
+sidecars:
+  nginx:
+    port: 80/tcp
+    image: nginx
+environments:
+  test:
+    variables:
+      LOG_LEVEL: debug
+`
+	const workerManifest = `name: worker
+type: Worker Service
+image:
+  location: public.ecr.aws/my/worker:latest
+command: ["node", "index.js"]
+`
+	mockErr := errors.New("some error")
+	copilotDir := filepath.FromSlash("/ws/copilot")
+	wantedMain := func(secrets map[string]string) *dockerengine.RunOptions {
+		return &dockerengine.RunOptions{
+			ImageURI:      "phonetool/frontend",
+			ContainerName: "copilot-phonetool-frontend",
+			Ports: map[string]string{
+				"8080": "8080",
+				"80":   "80",
+			},
+			EnvVars: map[string]string{
+				"COPILOT_APPLICATION_NAME": "phonetool",
+				"COPILOT_ENVIRONMENT_NAME": "test",
+				"COPILOT_SERVICE_NAME":     "frontend",
+				"LOG_LEVEL":                "debug",
+			},
+			Secrets: secrets,
+		}
+	}
+	wantedSidecar := &dockerengine.RunOptions{
+		ImageURI:      "nginx",
+		ContainerName: "copilot-phonetool-frontend-nginx",
+		EnvVars: map[string]string{
+			"COPILOT_APPLICATION_NAME": "phonetool",
+			"COPILOT_ENVIRONMENT_NAME": "test",
+			"COPILOT_SERVICE_NAME":     "frontend",
+		},
+		ContainerNetwork: "copilot-phonetool-frontend",
+	}
+
+	testCases := map[string]struct {
+		inSvcName     string
+		inSecretsFile string
+		interrupted   bool
+		setupFs       func(fs afero.Fs)
+		setupMocks    func(m svcRunMocks)
+
+		wantedError error
+	}{
+		"error if docker engine is not running": {
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(mockErr)
+			},
+			wantedError: mockErr,
+		},
+		"error if the manifest cannot be read": {
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return(nil, mockErr)
+			},
+			wantedError: errors.New("read service frontend manifest file: some error"),
+		},
+		"error if the image fails to build": {
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(lbManifest), nil)
+				m.ws.EXPECT().CopilotDirPath().Return(copilotDir, nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(mockErr)
+			},
+			wantedError: errors.New("build image for service frontend: some error"),
+		},
+		"error if the secrets file is missing a secret": {
+			inSvcName:     "frontend",
+			inSecretsFile: "secrets.yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "secrets.yml", []byte("GITHUB_TOKEN: abc"), 0644)
+			},
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(lbManifest), nil)
+				m.ws.EXPECT().CopilotDirPath().Return(copilotDir, nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+			},
+			wantedError: errors.New("secrets file secrets.yml is missing values for: DB_PASSWORD"),
+		},
+		"error if a secret cannot be retrieved": {
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(lbManifest), nil)
+				m.ws.EXPECT().CopilotDirPath().Return(copilotDir, nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				m.ssm.EXPECT().GetSecretValue("GH_TOKEN").Return("", mockErr).AnyTimes()
+				m.sm.EXPECT().GetSecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-abc123").Return("hunter2", nil).AnyTimes()
+			},
+			wantedError: errors.New("resolve secret GITHUB_TOKEN: some error"),
+		},
+		"runs the main container and sidecars with resolved secrets and removes them once stopped": {
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(lbManifest), nil)
+				m.ws.EXPECT().CopilotDirPath().Return(copilotDir, nil)
+				m.docker.EXPECT().Build(&dockerengine.BuildArguments{
+					URI:        "phonetool/frontend",
+					Dockerfile: filepath.FromSlash("/ws/frontend/Dockerfile"),
+					Context:    filepath.FromSlash("/ws/frontend"),
+				}).Return(nil)
+				m.ssm.EXPECT().GetSecretValue("GH_TOKEN").Return("abc", nil)
+				m.sm.EXPECT().GetSecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-abc123").Return("hunter2", nil)
+				gomock.InOrder(
+					m.docker.EXPECT().Run(wantedMain(map[string]string{
+						"GITHUB_TOKEN": "abc",
+						"DB_PASSWORD":  "hunter2",
+					})).Return(nil),
+					m.docker.EXPECT().Run(wantedSidecar).Return(nil),
+					m.docker.EXPECT().Logs("copilot-phonetool-frontend", gomock.Any()).Return(nil),
+					m.docker.EXPECT().Wait("copilot-phonetool-frontend").Return(0, nil),
+					m.docker.EXPECT().Remove("copilot-phonetool-frontend", "copilot-phonetool-frontend-nginx").Return(nil),
+				)
+			},
+		},
+		"stubs secrets from the secrets file": {
+			inSvcName:     "frontend",
+			inSecretsFile: "secrets.yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "secrets.yml", []byte("GITHUB_TOKEN: abc\nDB_PASSWORD: hunter2"), 0644)
+			},
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(lbManifest), nil)
+				m.ws.EXPECT().CopilotDirPath().Return(copilotDir, nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				gomock.InOrder(
+					m.docker.EXPECT().Run(wantedMain(map[string]string{
+						"GITHUB_TOKEN": "abc",
+						"DB_PASSWORD":  "hunter2",
+					})).Return(nil),
+					m.docker.EXPECT().Run(wantedSidecar).Return(mockErr),
+					m.docker.EXPECT().Remove("copilot-phonetool-frontend").Return(nil),
+				)
+			},
+			wantedError: mockErr,
+		},
+		"removes the containers once interrupted": {
+			inSvcName:   "worker",
+			interrupted: true,
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("worker").Return([]byte(workerManifest), nil)
+				m.docker.EXPECT().Run(gomock.Any()).Return(nil)
+				removed := make(chan struct{})
+				m.docker.EXPECT().Logs("copilot-phonetool-worker", gomock.Any()).DoAndReturn(func(_ string, _ io.Writer) error {
+					<-removed
+					return nil
+				})
+				m.docker.EXPECT().Remove("copilot-phonetool-worker").DoAndReturn(func(_ ...string) error {
+					close(removed)
+					return nil
+				})
+			},
+		},
+		"runs an existing image with the manifest command": {
+			inSvcName: "worker",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("worker").Return([]byte(workerManifest), nil)
+				m.docker.EXPECT().Run(&dockerengine.RunOptions{
+					ImageURI:      "public.ecr.aws/my/worker:latest",
+					ContainerName: "copilot-phonetool-worker",
+					Ports:         map[string]string{},
+					EnvVars: map[string]string{
+						"COPILOT_APPLICATION_NAME": "phonetool",
+						"COPILOT_ENVIRONMENT_NAME": "test",
+						"COPILOT_SERVICE_NAME":     "worker",
+					},
+					Command: []string{"node", "index.js"},
+				}).Return(nil)
+				m.docker.EXPECT().Logs("copilot-phonetool-worker", gomock.Any()).Return(nil)
+				m.docker.EXPECT().Wait("copilot-phonetool-worker").Return(0, nil)
+				m.docker.EXPECT().Remove("copilot-phonetool-worker").Return(nil)
+			},
+		},
+		"error if the main container exits with a non-zero code": {
+			inSvcName: "worker",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("worker").Return([]byte(workerManifest), nil)
+				gomock.InOrder(
+					m.docker.EXPECT().Run(gomock.Any()).Return(nil),
+					m.docker.EXPECT().Logs("copilot-phonetool-worker", gomock.Any()).Return(nil),
+					m.docker.EXPECT().Wait("copilot-phonetool-worker").Return(1, nil),
+					m.docker.EXPECT().Remove("copilot-phonetool-worker").Return(nil),
+				)
+			},
+			wantedError: errors.New("main container copilot-phonetool-worker of service worker exited with code 1"),
+		},
+		"error if failed to wait for the main container": {
+			inSvcName: "worker",
+			setupMocks: func(m svcRunMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("worker").Return([]byte(workerManifest), nil)
+				m.docker.EXPECT().Run(gomock.Any()).Return(nil)
+				m.docker.EXPECT().Logs("copilot-phonetool-worker", gomock.Any()).Return(nil)
+				m.docker.EXPECT().Wait("copilot-phonetool-worker").Return(0, mockErr)
+				m.docker.EXPECT().Remove("copilot-phonetool-worker").Return(nil)
+			},
+			wantedError: mockErr,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRunMocks{
+				store:  mocks.NewMockstore(ctrl),
+				ws:     mocks.NewMockwsSvcDirReader(ctrl),
+				docker: mocks.NewMockcontainerRunner(ctrl),
+				ssm:    mocks.NewMocksecretValueGetter(ctrl),
+				sm:     mocks.NewMocksecretValueGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			opts := &svcRunOpts{
+				svcRunVars: svcRunVars{
+					local:       true,
+					appName:     "phonetool",
+					name:        tc.inSvcName,
+					envName:     "test",
+					secretsFile: tc.inSecretsFile,
+				},
+				store:          m.store,
+				ws:             m.ws,
+				fs:             fs,
+				docker:         m.docker,
+				unmarshal:      manifest.UnmarshalWorkload,
+				ssm:            m.ssm,
+				secretsManager: m.sm,
+				configureSecretsFunc: func(env *config.Environment) error {
+					return fmt.Errorf("should not be called")
+				},
+				out: new(bytes.Buffer),
+				notifyInterrupt: func() (<-chan os.Signal, func()) {
+					c := make(chan os.Signal, 1)
+					if tc.interrupted {
+						c <- os.Interrupt
+					}
+					return c, func() {}
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
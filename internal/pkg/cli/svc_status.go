@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -75,6 +76,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					Env:         o.envName,
 					Svc:         o.svcName,
 					ConfigStore: configStore,
+					ImageScan:   isImageScanConfigured(o.svcName, o.envName),
 				})
 				if err != nil {
 					return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.appName, err)
@@ -86,6 +88,30 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 	}, nil
 }
 
+// isImageScanConfigured returns true if the manifest of the service in the workspace
+// scans its image for vulnerabilities in the environment.
+// Without a workspace, the scan findings of the service's image are not shown.
+func isImageScanConfigured(svc, env string) bool {
+	ws, err := workspace.New()
+	if err != nil {
+		return false
+	}
+	raw, err := ws.ReadServiceManifest(svc)
+	if err != nil {
+		return false
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return false
+	}
+	envMft, err := mft.ApplyEnv(env)
+	if err != nil {
+		return false
+	}
+	scanned, ok := envMft.(imageScanConfigurer)
+	return ok && scanned.ImageScan() != nil
+}
+
 // Validate returns an error if the values provided by the user are invalid.
 func (o *svcStatusOpts) Validate() error {
 	if o.appName == "" {
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcValidateNamePrompt = "Which service's manifest would you like to validate?"
+)
+
+type validateSvcVars struct {
+	name string
+}
+
+type validateSvcOpts struct {
+	validateSvcVars
+
+	ws  wsSvcReader
+	sel wsSelector
+}
+
+func newValidateSvcOpts(vars validateSvcVars) (*validateSvcOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	return &validateSvcOpts{
+		validateSvcVars: vars,
+		ws:              ws,
+		sel:             selector.NewWorkspaceSelect(prompt.New(), store, ws),
+	}, nil
+}
+
+// Validate returns an error if the service does not exist in the workspace.
+func (o *validateSvcOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+// Ask prompts the user for the service to validate if it wasn't provided.
+func (o *validateSvcOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Service(svcValidateNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute lints the manifest of the service and its environment overrides.
+func (o *validateSvcOpts) Execute() error {
+	mft, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest for service %s: %w", o.name, err)
+	}
+	return validateManifest(mft, "service", o.name)
+}
+
+// validateManifest logs every problem found in the manifest of a workload,
+// and returns an error if the manifest is invalid.
+func validateManifest(mft []byte, wkldKind, name string) error {
+	errs, err := manifest.ValidateWorkload(mft)
+	if err != nil {
+		return fmt.Errorf("validate manifest for %s %s: %w", wkldKind, name, err)
+	}
+	if len(errs) == 0 {
+		log.Successf("Manifest for %s %s is valid.\n", wkldKind, name)
+		return nil
+	}
+	for _, e := range errs {
+		log.Errorln(e.Error())
+	}
+	return fmt.Errorf("manifest for %s %s has %s", wkldKind, name, english.Plural(len(errs), "error", ""))
+}
+
+// buildSvcValidateCmd builds the command for linting the manifest of a service.
+func buildSvcValidateCmd() *cobra.Command {
+	vars := validateSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the manifest of a service.",
+		Long: `Validates the manifest of a service without connecting to AWS.
+The manifest is checked against its JSON Schema, then the overrides of each environment are applied and checked.`,
+		Example: `
+  Validate the manifest of the "frontend" service.
+  /code $ copilot svc validate -n frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	return cmd
+}
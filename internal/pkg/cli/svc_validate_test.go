@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type validateSvcMocks struct {
+	ws  *mocks.MockwsSvcReader
+	sel *mocks.MockwsSelector
+}
+
+func TestValidateSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m validateSvcMocks)
+
+		wantedErr string
+	}{
+		"no name": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Times(0)
+			},
+		},
+		"service exists in the workspace": {
+			inName: "api",
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+			},
+		},
+		"service does not exist in the workspace": {
+			inName: "api",
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: "service 'api' does not exist in the workspace",
+		},
+		"fails to list services": {
+			inName: "api",
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list services in the workspace: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateSvcMocks{
+				ws: mocks.NewMockwsSvcReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateSvcOpts{
+				validateSvcVars: validateSvcVars{
+					name: tc.inName,
+				},
+				ws: m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m validateSvcMocks)
+
+		wantedName string
+		wantedErr  string
+	}{
+		"does not prompt if the name is provided": {
+			inName: "api",
+			setupMocks: func(m validateSvcMocks) {
+				m.sel.EXPECT().Service(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedName: "api",
+		},
+		"selects a service in the workspace": {
+			setupMocks: func(m validateSvcMocks) {
+				m.sel.EXPECT().Service(svcValidateNamePrompt, "").Return("api", nil)
+			},
+			wantedName: "api",
+		},
+		"wraps the selection error": {
+			setupMocks: func(m validateSvcMocks) {
+				m.sel.EXPECT().Service(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "select service: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateSvcMocks{
+				sel: mocks.NewMockwsSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateSvcOpts{
+				validateSvcVars: validateSvcVars{
+					name: tc.inName,
+				},
+				sel: m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestValidateSvcOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m validateSvcMocks)
+
+		wantedErr string
+	}{
+		"fails to read the manifest": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest for service api: some error",
+		},
+		"manifest is not YAML": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte("name: ["), nil)
+			},
+			wantedErr: "validate manifest for service api: unmarshal manifest: yaml: line 1: did not find expected node content",
+		},
+		"manifest is invalid": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: eighty
+memroy: 512`), nil)
+			},
+			wantedErr: "manifest for service api has 2 errors",
+		},
+		"manifest is valid": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80`), nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateSvcMocks{
+				ws: mocks.NewMockwsSvcReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateSvcOpts{
+				validateSvcVars: validateSvcVars{
+					name: "api",
+				},
+				ws: m.ws,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -39,6 +39,12 @@ var (
 	errScheduleInvalid      = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
 )
 
+// Pipeline source validation errors.
+var (
+	errECRRepositoryURIBadFormat = errors.New("value must be an ECR repository URI (example: 123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc)")
+	errS3ObjectURIBadFormat      = errors.New("value must be an S3 object URI (example: s3://my-bucket/workspace.zip)")
+)
+
 // Addons validation errors.
 var (
 	fmtErrInvalidStorageType = "invalid storage type %s: must be one of %s"
@@ -264,6 +270,31 @@ func validateDomainName(val interface{}) error {
 	return nil
 }
 
+func validateECRRepositoryURI(val interface{}) error {
+	uri, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.Contains(uri, ecrIdentifier) {
+		return errECRRepositoryURIBadFormat
+	}
+	if _, err := ecrRepoURI(uri).parse(); err != nil {
+		return errECRRepositoryURIBadFormat
+	}
+	return nil
+}
+
+func validateS3ObjectURI(val interface{}) error {
+	uri, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if _, err := s3ObjectURI(uri).parse(); err != nil || !strings.HasPrefix(uri, s3URIPrefix) {
+		return errS3ObjectURIBadFormat
+	}
+	return nil
+}
+
 func validatePath(fs afero.Fs, val interface{}) error {
 	path, ok := val.(string)
 	if !ok {
@@ -260,6 +260,64 @@ func TestValidateRDSName(t *testing.T) {
 	}
 }
 
+func TestValidateECRRepositoryURI(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"not an ECR repository": {
+			input: "public.ecr.aws/my-app/api",
+			want:  errECRRepositoryURIBadFormat,
+		},
+		"missing repository name": {
+			input: "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+			want:  errECRRepositoryURIBadFormat,
+		},
+		"returns nil if valid repository URI": {
+			input: "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:release",
+			want:  nil,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, validateECRRepositoryURI(tc.input))
+		})
+	}
+}
+
+func TestValidateS3ObjectURI(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"not an S3 URI": {
+			input: "my-bucket/workspace.zip",
+			want:  errS3ObjectURIBadFormat,
+		},
+		"missing object key": {
+			input: "s3://my-bucket",
+			want:  errS3ObjectURIBadFormat,
+		},
+		"returns nil if valid object URI": {
+			input: "s3://my-bucket/workspace.zip",
+			want:  nil,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, validateS3ObjectURI(tc.input))
+		})
+	}
+}
+
 func TestValidatePath(t *testing.T) {
 	testCases := map[string]struct {
 		input interface{}
@@ -5,11 +5,8 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Application is a named collection of environments and services.
@@ -27,7 +24,7 @@ func (a *Application) RequiresDNSDelegation() bool {
 	return a.Domain != ""
 }
 
-// CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
+// CreateApplication instantiates a new application, validates its uniqueness and stores it in the backend.
 func (s *Store) CreateApplication(application *Application) error {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
 	application.Version = schemaVersion
@@ -37,19 +34,9 @@ func (s *Store) CreateApplication(application *Application) error {
 		return fmt.Errorf("serializing application %s: %w", application.Name, err)
 	}
 
-	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(applicationPath),
-		Description: aws.String("Copilot Application"),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-	})
-
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
+	if err := s.backend.Create(applicationPath, data, "Copilot Application"); err != nil {
+		if errors.Is(err, errKeyAlreadyExists) {
+			return nil
 		}
 		return fmt.Errorf("create application %s: %w", application.Name, err)
 	}
@@ -65,13 +52,7 @@ func (s *Store) UpdateApplication(application *Application) error {
 		return fmt.Errorf("serializing application %s: %w", application.Name, err)
 	}
 
-	if _, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(applicationPath),
-		Description: aws.String("Copilot Application"),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Overwrite:   aws.Bool(true),
-	}); err != nil {
+	if err := s.backend.Put(applicationPath, data, "Copilot Application"); err != nil {
 		return fmt.Errorf("update application %s: %w", application.Name, err)
 	}
 	return nil
@@ -80,27 +61,21 @@ func (s *Store) UpdateApplication(application *Application) error {
 // GetApplication fetches an application by name. If it can't be found, return a ErrNoSuchApplication
 func (s *Store) GetApplication(applicationName string) (*Application, error) {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, applicationName)
-	applicationParam, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(applicationPath),
-	})
-
+	data, err := s.backend.Get(applicationPath)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				account, region := s.getCallerAccountAndRegion()
-				return nil, &ErrNoSuchApplication{
-					ApplicationName: applicationName,
-					AccountID:       account,
-					Region:          region,
-				}
+		if errors.Is(err, errKeyNotFound) {
+			account, region := s.getCallerAccountAndRegion()
+			return nil, &ErrNoSuchApplication{
+				ApplicationName: applicationName,
+				AccountID:       account,
+				Region:          region,
 			}
 		}
 		return nil, fmt.Errorf("get application %s: %w", applicationName, err)
 	}
 
 	var application Application
-	if err := json.Unmarshal([]byte(*applicationParam.Parameter.Value), &application); err != nil {
+	if err := json.Unmarshal([]byte(data), &application); err != nil {
 		return nil, fmt.Errorf("read configuration for application %s: %w", applicationName, err)
 	}
 	return &application, nil
@@ -109,13 +84,13 @@ func (s *Store) GetApplication(applicationName string) (*Application, error) {
 // ListApplications returns the list of existing applications in the customer's account and region.
 func (s *Store) ListApplications() ([]*Application, error) {
 	var applications []*Application
-	serializedApplications, err := s.listParams(rootApplicationPath)
+	serializedApplications, err := s.backend.List(rootApplicationPath)
 	if err != nil {
 		return nil, fmt.Errorf("list applications: %w", err)
 	}
 	for _, serializedApplication := range serializedApplications {
 		var application Application
-		if err := json.Unmarshal([]byte(*serializedApplication), &application); err != nil {
+		if err := json.Unmarshal([]byte(serializedApplication), &application); err != nil {
 			return nil, fmt.Errorf("read application configuration: %w", err)
 		}
 
@@ -124,26 +99,15 @@ func (s *Store) ListApplications() ([]*Application, error) {
 	return applications, nil
 }
 
-// DeleteApplication deletes the SSM parameter related to the application.
+// DeleteApplication deletes the configuration of the application.
 func (s *Store) DeleteApplication(name string) error {
 	paramName := fmt.Sprintf(fmtApplicationPath, name)
 
-	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
-	if err != nil {
-		awserr, ok := err.(awserr.Error)
-		if !ok {
-			return err
-		}
-
-		if awserr.Code() == ssm.ErrCodeParameterNotFound {
+	if err := s.backend.Delete(paramName); err != nil {
+		if errors.Is(err, errKeyNotFound) {
 			return nil
 		}
-
-		return fmt.Errorf("delete SSM param %s: %w", paramName, awserr)
+		return fmt.Errorf("delete application %s: %w", name, err)
 	}
-
 	return nil
 }
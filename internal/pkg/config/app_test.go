@@ -113,9 +113,11 @@ func TestStore_ListApplications(t *testing.T) {
 			// GIVEN
 			lastPageInPaginatedResp = false
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                       t,
-					mockGetParametersByPath: tc.mockGetParametersByPath,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                       t,
+						mockGetParametersByPath: tc.mockGetParametersByPath,
+					},
 				},
 			}
 
@@ -219,9 +221,11 @@ func TestStore_GetApplication(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockGetParameter: tc.mockGetParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockGetParameter: tc.mockGetParameter,
+					},
 				},
 				idClient: mockIdentityService{
 					mockIdentityServiceGet: tc.mockIdentityServiceGet,
@@ -280,9 +284,11 @@ func TestStore_CreateApplication(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockPutParameter: tc.mockPutParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockPutParameter: tc.mockPutParameter,
+					},
 				},
 			}
 
@@ -329,9 +335,11 @@ func TestStore_UpdateApplication(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockPutParameter: tc.mockPutParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockPutParameter: tc.mockPutParameter,
+					},
 				},
 			}
 
@@ -373,22 +381,24 @@ func TestDeleteApplication(t *testing.T) {
 			},
 			want: nil,
 		},
-		"should return unhandled non-awserr": {
+		"should wrap unhandled errors": {
 			mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
 				require.Equal(t, fmt.Sprintf(fmtApplicationPath, mockApplicationName), *in.Name)
 
 				return nil, mockError
 			},
-			want: mockError,
+			want: fmt.Errorf("delete application %s: %w", mockApplicationName, mockError),
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                   t,
-					mockDeleteParameter: test.mockDeleteParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                   t,
+						mockDeleteParameter: test.mockDeleteParameter,
+					},
 				},
 			}
 
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+// Names of the backends that a Store can persist configuration to.
+const (
+	SSMBackend   = "ssm"
+	LocalBackend = "local"
+)
+
+// BackendName returns the name of the backend that name resolves to.
+// The empty name resolves to the default SSM backend.
+func BackendName(name string) string {
+	if name == "" {
+		return SSMBackend
+	}
+	return name
+}
+
+var (
+	errKeyAlreadyExists = errors.New("key already exists")
+	errKeyNotFound      = errors.New("key not found")
+)
+
+// backend persists serialized configuration under hierarchical keys such as "/copilot/applications/{app}".
+type backend interface {
+	// Create stores the value under the key. If the key already exists, returns errKeyAlreadyExists.
+	Create(key, value, description string) error
+	// Put stores the value under the key and overwrites any existing value.
+	Put(key, value, description string) error
+	// Get returns the value stored under the key. If the key does not exist, returns errKeyNotFound.
+	Get(key string) (string, error)
+	// List returns the values of the keys directly under the path.
+	List(path string) ([]string, error)
+	// Delete removes the key. If the key does not exist, returns errKeyNotFound.
+	Delete(key string) error
+}
+
+// ssmBackend stores configuration as String parameters in SSM Parameter Store.
+type ssmBackend struct {
+	client ssmiface.SSMAPI
+}
+
+// Create stores the value as a new parameter.
+func (b *ssmBackend) Create(key, value, description string) error {
+	_, err := b.client.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(key),
+		Description: aws.String(description),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(value),
+	})
+	if isSSMErrCode(err, ssm.ErrCodeParameterAlreadyExists) {
+		return errKeyAlreadyExists
+	}
+	return err
+}
+
+// Put stores the value as a parameter and overwrites the existing parameter.
+func (b *ssmBackend) Put(key, value, description string) error {
+	_, err := b.client.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(key),
+		Description: aws.String(description),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(value),
+		Overwrite:   aws.Bool(true),
+	})
+	return err
+}
+
+// Get returns the value of the parameter.
+func (b *ssmBackend) Get(key string) (string, error) {
+	out, err := b.client.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(key),
+	})
+	if err != nil {
+		if isSSMErrCode(err, ssm.ErrCodeParameterNotFound) {
+			return "", errKeyNotFound
+		}
+		return "", err
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// List returns the values of the parameters directly under the path.
+func (b *ssmBackend) List(path string) ([]string, error) {
+	var values []string
+	var nextToken *string
+	for {
+		params, err := b.client.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(path),
+			Recursive: aws.Bool(false),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, param := range params.Parameters {
+			values = append(values, aws.StringValue(param.Value))
+		}
+		nextToken = params.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return values, nil
+}
+
+// Delete removes the parameter.
+func (b *ssmBackend) Delete(key string) error {
+	_, err := b.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(key),
+	})
+	if isSSMErrCode(err, ssm.ErrCodeParameterNotFound) {
+		return errKeyNotFound
+	}
+	return err
+}
+
+func isSSMErrCode(err error, code string) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == code
+	}
+	return false
+}
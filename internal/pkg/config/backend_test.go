@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_Backends(t *testing.T) {
+	testCases := map[string]func() *Store{
+		"local": func() *Store {
+			return newLocalStore(afero.NewMemMapFs(), localStateDir)
+		},
+		"memory": NewMemoryStore,
+	}
+
+	for name, newStore := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := newStore()
+			app := &Application{Name: "phonetool", AccountID: "1234"}
+			test := &Environment{App: "phonetool", Name: "test", Region: "us-west-2"}
+			prod := &Environment{App: "phonetool", Name: "prod", Region: "us-east-1", Prod: true}
+			svc := &Workload{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"}
+			job := &Workload{App: "phonetool", Name: "report", Type: "Scheduled Job"}
+
+			// WHEN creating the configuration.
+			_, err := s.GetApplication("phonetool")
+			require.True(t, errors.Is(err, &ErrNoSuchApplication{ApplicationName: "phonetool", AccountID: "unknown", Region: "unknown"}))
+			require.NoError(t, s.CreateApplication(app))
+			require.NoError(t, s.CreateApplication(&Application{Name: "phonetool", AccountID: "5678"}), "creating an existing application is a no-op")
+			require.NoError(t, s.CreateEnvironment(prod))
+			require.NoError(t, s.CreateEnvironment(test))
+			require.NoError(t, s.CreateService(svc))
+			require.NoError(t, s.CreateJob(job))
+
+			// THEN
+			gotApp, err := s.GetApplication("phonetool")
+			require.NoError(t, err)
+			require.Equal(t, app, gotApp)
+			apps, err := s.ListApplications()
+			require.NoError(t, err)
+			require.Equal(t, []*Application{app}, apps)
+
+			gotEnv, err := s.GetEnvironment("phonetool", "test")
+			require.NoError(t, err)
+			require.Equal(t, test, gotEnv)
+			envs, err := s.ListEnvironments("phonetool")
+			require.NoError(t, err)
+			require.Equal(t, []*Environment{test, prod}, envs)
+
+			gotSvc, err := s.GetService("phonetool", "frontend")
+			require.NoError(t, err)
+			require.Equal(t, svc, gotSvc)
+			gotJob, err := s.GetJob("phonetool", "report")
+			require.NoError(t, err)
+			require.Equal(t, job, gotJob)
+			wklds, err := s.ListWorkloads("phonetool")
+			require.NoError(t, err)
+			require.ElementsMatch(t, []*Workload{svc, job}, wklds)
+
+			// WHEN updating the configuration.
+			app.Domain = "example.com"
+			require.NoError(t, s.UpdateApplication(app))
+
+			// THEN
+			gotApp, err = s.GetApplication("phonetool")
+			require.NoError(t, err)
+			require.Equal(t, "example.com", gotApp.Domain)
+
+			// WHEN deleting the configuration.
+			require.NoError(t, s.DeleteService("phonetool", "frontend"))
+			require.NoError(t, s.DeleteJob("phonetool", "report"))
+			require.NoError(t, s.DeleteEnvironment("phonetool", "test"))
+			require.NoError(t, s.DeleteEnvironment("phonetool", "test"), "deleting a missing environment is a no-op")
+
+			// THEN
+			_, err = s.GetService("phonetool", "frontend")
+			require.True(t, errors.Is(err, &ErrNoSuchWorkload{App: "phonetool", Name: "frontend"}))
+			_, err = s.GetEnvironment("phonetool", "test")
+			require.True(t, errors.Is(err, &ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "test"}))
+			envs, err = s.ListEnvironments("phonetool")
+			require.NoError(t, err)
+			require.Equal(t, []*Environment{prod}, envs)
+		})
+	}
+}
+
+func TestLocalBackend_Path(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	s := newLocalStore(fs, localStateDir)
+
+	// WHEN
+	err := s.CreateApplication(&Application{Name: "phonetool"})
+
+	// THEN
+	require.NoError(t, err)
+	content, err := afero.ReadFile(fs, ".copilot/state/copilot/applications/phonetool.json")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"phonetool","account":"","domain":"","domainHostedZoneID":"","version":"1.0"}`, string(content))
+}
+
+func TestWorkspaceRoot(t *testing.T) {
+	testCases := map[string]struct {
+		inDir string
+
+		wanted string
+	}{
+		"returns the directory that contains the copilot directory": {
+			inDir:  "/workspace",
+			wanted: "/workspace",
+		},
+		"returns the parent of the copilot directory": {
+			inDir:  "/workspace/copilot",
+			wanted: "/workspace",
+		},
+		"searches the parents of a nested directory": {
+			inDir:  "/workspace/frontend/src",
+			wanted: "/workspace",
+		},
+		"returns the directory itself outside of a workspace": {
+			inDir:  "/tmp/scratch",
+			wanted: "/tmp/scratch",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll("/workspace/copilot/frontend", 0755))
+			require.NoError(t, fs.MkdirAll("/workspace/frontend/src", 0755))
+			require.NoError(t, fs.MkdirAll("/tmp/scratch", 0755))
+
+			// WHEN
+			root, err := workspaceRoot(fs, filepath.FromSlash(tc.inDir))
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, filepath.FromSlash(tc.wanted), root)
+		})
+	}
+}
+
+func TestNewStoreWithBackend(t *testing.T) {
+	_, err := NewStoreWithBackend("etcd")
+	require.EqualError(t, err, `unrecognized config store backend "etcd", must be one of ssm or local`)
+
+	s, err := NewStoreWithBackend(LocalBackend)
+	require.NoError(t, err)
+	require.IsType(t, &localBackend{}, s.backend)
+}
+
+func TestBackendName(t *testing.T) {
+	require.Equal(t, SSMBackend, BackendName(""), "the empty name should resolve to the default backend")
+	require.Equal(t, SSMBackend, BackendName(SSMBackend))
+	require.Equal(t, LocalBackend, BackendName(LocalBackend))
+}
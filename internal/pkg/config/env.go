@@ -5,12 +5,9 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Environment represents a deployment environment in an application.
@@ -26,10 +23,18 @@ type Environment struct {
 	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
 }
 
+// HasImportedCerts returns true if the environment serves HTTPS traffic with certificates imported by users.
+func (e *Environment) HasImportedCerts() bool {
+	return e.CustomConfig != nil && len(e.CustomConfig.ImportCertARNs) > 0
+}
+
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC      *ImportVPC `json:"importVPC,omitempty"`
+	VPCConfig      *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportCertARNs []string   `json:"importCertARNs,omitempty"`
+	ImportALB      *ImportALB `json:"importALB,omitempty"`
+	Telemetry      *Telemetry `json:"telemetry,omitempty"`
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
@@ -55,6 +60,20 @@ type AdjustVPC struct {
 	CIDR               string   `json:"cidr"` // CIDR range for the VPC.
 	PublicSubnetCIDRs  []string `json:"publicSubnetCIDRs"`
 	PrivateSubnetCIDRs []string `json:"privateSubnetCIDRs"`
+	ForceNATGateways   bool     `json:"forceNATGateways,omitempty"` // Create NAT gateways even if no workload is placed in the private subnets.
+}
+
+// ImportALB holds the fields of an existing Application Load Balancer used by the environment instead of creating one.
+type ImportALB struct {
+	ARN              string   `json:"arn"`
+	DNSName          string   `json:"dnsName"`
+	HostedZoneID     string   `json:"hostedZoneID"` // ID of the Route 53 hosted zone of the load balancer, used for alias records.
+	SecurityGroupIDs []string `json:"securityGroupIDs"`
+}
+
+// Telemetry holds the observability settings of an environment.
+type Telemetry struct {
+	EnableContainerInsights bool `json:"containerInsights"`
 }
 
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
@@ -70,47 +89,47 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
 	}
 
-	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(environmentPath),
-		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-	})
+	err = s.backend.Create(environmentPath, data, fmt.Sprintf("The %s deployment stage", environment.Name))
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
+		if errors.Is(err, errKeyAlreadyExists) {
+			return nil
 		}
 		return fmt.Errorf("create environment %s in application %s: %w", environment.Name, environment.App, err)
 	}
 	return nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	if err := s.backend.Put(environmentPath, data, fmt.Sprintf("The %s deployment stage", environment.Name)); err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
 	environmentPath := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	environmentParam, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(environmentPath),
-	})
-
+	data, err := s.backend.Get(environmentPath)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil, &ErrNoSuchEnvironment{
-					ApplicationName: appName,
-					EnvironmentName: environmentName,
-				}
+		if errors.Is(err, errKeyNotFound) {
+			return nil, &ErrNoSuchEnvironment{
+				ApplicationName: appName,
+				EnvironmentName: environmentName,
 			}
 		}
 		return nil, fmt.Errorf("get environment %s in application %s: %w", environmentName, appName, err)
 	}
 
 	var env Environment
-	err = json.Unmarshal([]byte(*environmentParam.Parameter.Value), &env)
+	err = json.Unmarshal([]byte(data), &env)
 	if err != nil {
 		return nil, fmt.Errorf("read configuration for environment %s in application %s: %w", environmentName, appName, err)
 	}
@@ -122,13 +141,13 @@ func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	var environments []*Environment
 
 	environmentsPath := fmt.Sprintf(rootEnvParamPath, appName)
-	serializedEnvs, err := s.listParams(environmentsPath)
+	serializedEnvs, err := s.backend.List(environmentsPath)
 	if err != nil {
 		return nil, fmt.Errorf("list environments for application %s: %w", appName, err)
 	}
 	for _, serializedEnv := range serializedEnvs {
 		var env Environment
-		if err := json.Unmarshal([]byte(*serializedEnv), &env); err != nil {
+		if err := json.Unmarshal([]byte(serializedEnv), &env); err != nil {
 			return nil, fmt.Errorf("read environment configuration for application %s: %w", appName, err)
 		}
 
@@ -140,20 +159,13 @@ func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	return environments, nil
 }
 
-// DeleteEnvironment removes an environment from SSM.
+// DeleteEnvironment removes an environment from the store.
 // If the environment does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteEnvironment(appName, environmentName string) error {
 	paramName := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil
-			}
+	if err := s.backend.Delete(paramName); err != nil {
+		if errors.Is(err, errKeyNotFound) {
+			return nil
 		}
 		return fmt.Errorf("delete environment %s from application %s: %w", environmentName, appName, err)
 	}
@@ -122,9 +122,11 @@ func TestStore_ListEnvironments(t *testing.T) {
 			// GIVEN
 			lastPageInPaginatedResp = false
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                       t,
-					mockGetParametersByPath: tc.mockGetParametersByPath,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                       t,
+						mockGetParametersByPath: tc.mockGetParametersByPath,
+					},
 				},
 			}
 
@@ -202,9 +204,11 @@ func TestStore_GetEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockGetParameter: tc.mockGetParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockGetParameter: tc.mockGetParameter,
+					},
 				},
 			}
 
@@ -306,10 +310,12 @@ func TestStore_CreateEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockPutParameter: tc.mockPutParameter,
-					mockGetParameter: tc.mockGetParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockPutParameter: tc.mockPutParameter,
+						mockGetParameter: tc.mockGetParameter,
+					},
 				},
 			}
 
@@ -330,6 +336,66 @@ func TestStore_CreateEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvironment *Environment
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"success": {
+			inEnvironment: &Environment{
+				Name:   "test",
+				App:    "chicken",
+				Region: "us-west-2",
+				CustomConfig: &CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+					Telemetry: &Telemetry{
+						EnableContainerInsights: true,
+					},
+				},
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtEnvParamPath, "chicken", "test"), *param.Name)
+				require.Equal(t, `{"app":"chicken","name":"test","region":"us-west-2","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","customConfig":{"importCertARNs":["mockCertARN"],"telemetry":{"containerInsights":true}}}`, *param.Value)
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inEnvironment: &Environment{Name: "test", App: "chicken"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update environment test in application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store := &Store{
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockPutParameter: tc.mockPutParameter,
+					},
+				},
+			}
+
+			// WHEN
+			err := store.UpdateEnvironment(tc.inEnvironment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestStore_DeleteEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		inApplicationName string
@@ -368,9 +434,11 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                   t,
-					mockDeleteParameter: tc.mockDeleteParam,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                   t,
+						mockDeleteParameter: tc.mockDeleteParam,
+					},
 				},
 			}
 
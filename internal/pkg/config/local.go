@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	localStateDir     = ".copilot/state"
+	localFileExt      = ".json"
+	localFilePermMode = 0644
+	localDirPermMode  = 0755
+
+	// The workspace package imports this package, so its search for the "copilot" directory is mirrored here.
+	workspaceCopilotDirName   = "copilot"
+	maximumParentDirsToSearch = 5
+)
+
+// localBackend stores configuration as JSON files in a directory.
+// A key such as "/copilot/applications/phonetool" is stored in the file "{root}/copilot/applications/phonetool.json".
+type localBackend struct {
+	fs   afero.Fs
+	root string
+}
+
+// NewLocalStore returns a Store that persists configuration as JSON files under the ".copilot/state" directory
+// of the workspace, so that every directory of the workspace shares the same configuration.
+// The current working directory is used if it's not in a workspace yet.
+// It doesn't require AWS credentials, which is useful for offline development.
+func NewLocalStore() (*Store, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("get working directory: %w", err)
+	}
+	fs := afero.NewOsFs()
+	root, err := workspaceRoot(fs, wd)
+	if err != nil {
+		return nil, err
+	}
+	return newLocalStore(fs, filepath.Join(root, localStateDir)), nil
+}
+
+func newLocalStore(fs afero.Fs, root string) *Store {
+	return &Store{
+		backend: &localBackend{
+			fs:   fs,
+			root: root,
+		},
+	}
+}
+
+// Create writes the value to a new file.
+func (b *localBackend) Create(key, value, description string) error {
+	exists, err := afero.Exists(b.fs, b.path(key))
+	if err != nil {
+		return fmt.Errorf("check if %s exists: %w", b.path(key), err)
+	}
+	if exists {
+		return errKeyAlreadyExists
+	}
+	return b.Put(key, value, description)
+}
+
+// Put writes the value to a file and overwrites the existing file.
+func (b *localBackend) Put(key, value, _ string) error {
+	path := b.path(key)
+	if err := b.fs.MkdirAll(filepath.Dir(path), localDirPermMode); err != nil {
+		return fmt.Errorf("create directory %s: %w", filepath.Dir(path), err)
+	}
+	if err := afero.WriteFile(b.fs, path, []byte(value), localFilePermMode); err != nil {
+		return fmt.Errorf("write file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get returns the content of the file.
+func (b *localBackend) Get(key string) (string, error) {
+	content, err := afero.ReadFile(b.fs, b.path(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", errKeyNotFound
+		}
+		return "", fmt.Errorf("read file %s: %w", b.path(key), err)
+	}
+	return string(content), nil
+}
+
+// List returns the content of the files directly under the path.
+func (b *localBackend) List(path string) ([]string, error) {
+	dir := filepath.Join(b.root, filepath.FromSlash(path))
+	infos, err := afero.ReadDir(b.fs, dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read directory %s: %w", dir, err)
+	}
+	var values []string
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), localFileExt) {
+			continue
+		}
+		content, err := afero.ReadFile(b.fs, filepath.Join(dir, info.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read file %s: %w", filepath.Join(dir, info.Name()), err)
+		}
+		values = append(values, string(content))
+	}
+	return values, nil
+}
+
+// Delete removes the file.
+func (b *localBackend) Delete(key string) error {
+	exists, err := afero.Exists(b.fs, b.path(key))
+	if err != nil {
+		return fmt.Errorf("check if %s exists: %w", b.path(key), err)
+	}
+	if !exists {
+		return errKeyNotFound
+	}
+	if err := b.fs.Remove(b.path(key)); err != nil {
+		return fmt.Errorf("remove file %s: %w", b.path(key), err)
+	}
+	return nil
+}
+
+// workspaceRoot returns the directory that contains the "copilot" directory of the workspace of dir.
+// It returns dir if neither dir nor its parents contain a "copilot" directory.
+func workspaceRoot(fs afero.Fs, dir string) (string, error) {
+	if filepath.Base(dir) == workspaceCopilotDirName {
+		return filepath.Dir(dir), nil
+	}
+	searchingDir := dir
+	for try := 0; try < maximumParentDirsToSearch; try++ {
+		exists, err := afero.DirExists(fs, filepath.Join(searchingDir, workspaceCopilotDirName))
+		if err != nil {
+			return "", fmt.Errorf("check if %s exists: %w", filepath.Join(searchingDir, workspaceCopilotDirName), err)
+		}
+		if exists {
+			return searchingDir, nil
+		}
+		searchingDir = filepath.Dir(searchingDir)
+	}
+	return dir, nil
+}
+
+func (b *localBackend) path(key string) string {
+	return filepath.Join(b.root, filepath.FromSlash(key)) + localFileExt
+}
@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// memoryBackend stores configuration in memory. The configuration is lost once the process exits.
+type memoryBackend struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+// NewMemoryStore returns a Store that keeps configuration in memory, so that tests can
+// exercise the store without a mocked SSM client.
+func NewMemoryStore() *Store {
+	return &Store{
+		backend: &memoryBackend{
+			values: make(map[string]string),
+		},
+	}
+}
+
+// Create stores the value if the key does not exist yet.
+func (b *memoryBackend) Create(key, value, _ string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.values[key]; ok {
+		return errKeyAlreadyExists
+	}
+	b.values[key] = value
+	return nil
+}
+
+// Put stores the value.
+func (b *memoryBackend) Put(key, value, _ string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.values[key] = value
+	return nil
+}
+
+// Get returns the value stored under the key.
+func (b *memoryBackend) Get(key string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	value, ok := b.values[key]
+	if !ok {
+		return "", errKeyNotFound
+	}
+	return value, nil
+}
+
+// List returns the values of the keys directly under the path, sorted by key.
+func (b *memoryBackend) List(path string) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	prefix := strings.TrimSuffix(path, "/") + "/"
+	var keys []string
+	for key := range b.values {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			continue // Skip keys nested deeper than the path.
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var values []string
+	for _, key := range keys {
+		values = append(values, b.values[key])
+	}
+	return values, nil
+}
+
+// Delete removes the key.
+func (b *memoryBackend) Delete(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.values[key]; !ok {
+		return errKeyNotFound
+	}
+	delete(b.values, key)
+	return nil
+}
@@ -11,11 +11,11 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -43,15 +43,37 @@ type identityGetter interface {
 	Get() (identity.Caller, error)
 }
 
-// Store is in charge of fetching and creating applications, environment, services and other workloads, and pipeline configuration in SSM.
+// BackendEnvVar is the environment variable that selects the backend of the store returned by NewStore.
+// The store defaults to SSM Parameter Store if the variable is not set.
+const BackendEnvVar = "COPILOT_CONFIG_BACKEND"
+
+// Store is in charge of fetching and creating applications, environment, services and other workloads, and pipeline configuration.
+// The configuration is persisted in SSM by default, and can alternatively be persisted locally or in memory.
 type Store struct {
 	idClient      identityGetter
-	ssmClient     ssmiface.SSMAPI
+	backend       backend
 	sessionRegion string
 }
 
 // NewStore returns a new store, allowing you to query or create Applications, Environments, Services, and other workloads.
+// The backend of the store is selected with the BackendEnvVar environment variable.
 func NewStore() (*Store, error) {
+	return NewStoreWithBackend(os.Getenv(BackendEnvVar))
+}
+
+// NewStoreWithBackend returns a new store that persists configuration in the named backend.
+func NewStoreWithBackend(name string) (*Store, error) {
+	switch BackendName(name) {
+	case SSMBackend:
+		return newSSMStore()
+	case LocalBackend:
+		return NewLocalStore()
+	default:
+		return nil, fmt.Errorf("unrecognized config store backend %q, must be one of %s or %s", name, SSMBackend, LocalBackend)
+	}
+}
+
+func newSSMStore() (*Store, error) {
 	p := sessions.NewProvider()
 	sess, err := p.Default()
 
@@ -60,42 +82,21 @@ func NewStore() (*Store, error) {
 	}
 
 	return &Store{
-		idClient:      identity.New(sess),
-		ssmClient:     ssm.New(sess),
+		idClient: identity.New(sess),
+		backend: &ssmBackend{
+			client: ssm.New(sess),
+		},
 		sessionRegion: *sess.Config.Region,
 	}, nil
 }
 
-func (s *Store) listParams(path string) ([]*string, error) {
-	var serializedParams []*string
-
-	var nextToken *string
-	for {
-		params, err := s.ssmClient.GetParametersByPath(&ssm.GetParametersByPathInput{
-			Path:      aws.String(path),
-			Recursive: aws.Bool(false),
-			NextToken: nextToken,
-		})
-
-		if err != nil {
-			return nil, err
-		}
-
-		for _, param := range params.Parameters {
-			serializedParams = append(serializedParams, param.Value)
-		}
-
-		nextToken = params.NextToken
-		if nextToken == nil {
-			break
-		}
-	}
-	return serializedParams, nil
-}
-
 // Retrieves the caller's Account ID with a best effort. If it fails to fetch the Account ID,
 // this returns "unknown".
 func (s *Store) getCallerAccountAndRegion() (string, string) {
+	if s.idClient == nil {
+		// The store is not backed by an AWS account.
+		return "unknown", "unknown"
+	}
 	identity, err := s.idClient.Get()
 	region := s.sessionRegion
 	if err != nil {
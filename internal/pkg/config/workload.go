@@ -5,12 +5,9 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -54,18 +51,10 @@ func (s *Store) createWorkload(wkld *Workload) error {
 		return fmt.Errorf("serialize data: %w", err)
 	}
 
-	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(wkldPath),
-		Description: aws.String(fmt.Sprintf("Copilot %s %s", wkld.Type, wkld.Name)),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-	})
+	err = s.backend.Create(wkldPath, data, fmt.Sprintf("Copilot %s %s", wkld.Type, wkld.Name))
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
+		if errors.Is(err, errKeyAlreadyExists) {
+			return nil
 		}
 		return err
 	}
@@ -132,22 +121,17 @@ func (s *Store) GetWorkload(appName, name string) (*Workload, error) {
 
 func (s *Store) getWorkloadParam(appName, name string) ([]byte, error) {
 	wlPath := fmt.Sprintf(fmtWkldParamPath, appName, name)
-	wlParam, err := s.ssmClient.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(wlPath),
-	})
+	data, err := s.backend.Get(wlPath)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil, &ErrNoSuchWorkload{
-					App:  appName,
-					Name: name,
-				}
+		if errors.Is(err, errKeyNotFound) {
+			return nil, &ErrNoSuchWorkload{
+				App:  appName,
+				Name: name,
 			}
 		}
 		return nil, err
 	}
-	return []byte(*wlParam.Parameter.Value), nil
+	return []byte(data), nil
 }
 
 // ListServices returns all services belonging to a particular application.
@@ -198,13 +182,13 @@ func (s *Store) listWorkloads(appName string) ([]*Workload, error) {
 	var workloads []*Workload
 
 	workloadsPath := fmt.Sprintf(rootWkldParamPath, appName)
-	serializedWklds, err := s.listParams(workloadsPath)
+	serializedWklds, err := s.backend.List(workloadsPath)
 	if err != nil {
 		return nil, err
 	}
 	for _, serializedWkld := range serializedWklds {
 		var wkld Workload
-		if err := json.Unmarshal([]byte(*serializedWkld), &wkld); err != nil {
+		if err := json.Unmarshal([]byte(serializedWkld), &wkld); err != nil {
 			return nil, err
 		}
 
@@ -213,7 +197,7 @@ func (s *Store) listWorkloads(appName string) ([]*Workload, error) {
 	return workloads, nil
 }
 
-// DeleteService removes a service from SSM.
+// DeleteService removes a service from the store.
 // If the service does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteService(appName, svcName string) error {
 	if err := s.deleteWorkload(appName, svcName); err != nil {
@@ -222,7 +206,7 @@ func (s *Store) DeleteService(appName, svcName string) error {
 	return nil
 }
 
-// DeleteJob removes a job from SSM.
+// DeleteJob removes a job from the store.
 // If the job does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteJob(appName, jobName string) error {
 	if err := s.deleteWorkload(appName, jobName); err != nil {
@@ -233,16 +217,9 @@ func (s *Store) DeleteJob(appName, jobName string) error {
 
 func (s *Store) deleteWorkload(appName, wkldName string) error {
 	paramName := fmt.Sprintf(fmtWkldParamPath, appName, wkldName)
-	_, err := s.ssmClient.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil
-			}
+	if err := s.backend.Delete(paramName); err != nil {
+		if errors.Is(err, errKeyNotFound) {
+			return nil
 		}
 		return err
 	}
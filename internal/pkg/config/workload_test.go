@@ -113,9 +113,11 @@ func TestStore_ListServices(t *testing.T) {
 			// GIVEN
 			lastPageInPaginatedResp = false
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                       t,
-					mockGetParametersByPath: tc.mockGetParametersByPath,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                       t,
+						mockGetParametersByPath: tc.mockGetParametersByPath,
+					},
 				},
 			}
 
@@ -193,9 +195,11 @@ func TestStore_ListWorkloads(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			//GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                       t,
-					mockGetParametersByPath: tc.mockGetParametersByPath,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                       t,
+						mockGetParametersByPath: tc.mockGetParametersByPath,
+					},
 				},
 			}
 
@@ -282,9 +286,11 @@ func TestStore_ListJobs(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			//GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                       t,
-					mockGetParametersByPath: tc.mockGetParametersByPath,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                       t,
+						mockGetParametersByPath: tc.mockGetParametersByPath,
+					},
 				},
 			}
 
@@ -359,9 +365,11 @@ func TestStore_GetService(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockGetParameter: tc.mockGetParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockGetParameter: tc.mockGetParameter,
+					},
 				},
 			}
 
@@ -434,9 +442,11 @@ func TestStore_GetJob(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockGetParameter: tc.mockGetParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockGetParameter: tc.mockGetParameter,
+					},
 				},
 			}
 
@@ -526,10 +536,12 @@ func TestStore_CreateService(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
 			store := &Store{
-				ssmClient: &mockSSM{
-					t:                t,
-					mockPutParameter: tc.mockPutParameter,
-					mockGetParameter: tc.mockGetParameter,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t:                t,
+						mockPutParameter: tc.mockPutParameter,
+						mockGetParameter: tc.mockGetParameter,
+					},
 				},
 			}
 
@@ -582,10 +594,12 @@ func TestDeleteService(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			s := &Store{
-				ssmClient: &mockSSM{
-					t: t,
+				backend: &ssmBackend{
+					client: &mockSSM{
+						t: t,
 
-					mockDeleteParameter: test.mockDeleteParam,
+						mockDeleteParameter: test.mockDeleteParam,
+					},
 				},
 			}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -52,6 +53,10 @@ type ecsClient interface {
 	stream.ECSServiceDescriber
 }
 
+type elbv2Client interface {
+	stream.ListenerRuleDescriber
+}
+
 type cfnClient interface {
 	// Methods augmented by the aws wrapper struct.
 	Create(*cloudformation.Stack) (string, error)
@@ -65,12 +70,16 @@ type cfnClient interface {
 	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	DescribeChangeSet(changeSetID, stackName string) (*cloudformation.ChangeSetDescription, error)
+	CreateChangeSet(*cloudformation.Stack) (string, error)
+	ExecuteChangeSet(changeSetID, stackName string) error
+	DeleteChangeSet(changeSetID, stackName string) error
 	TemplateBody(stackName string) (string, error)
 	TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
 	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
 	ErrorEvents(stackName string) ([]cloudformation.StackEvent, error)
 	Outputs(stack *cloudformation.Stack) (map[string]string, error)
+	StackResources(stackName string) ([]*cloudformation.StackResource, error)
 
 	// Methods vended by the aws sdk struct.
 	DescribeStackEvents(*sdkcloudformation.DescribeStackEventsInput) (*sdkcloudformation.DescribeStackEventsOutput, error)
@@ -104,6 +113,7 @@ type CloudFormation struct {
 	codeStarClient codeStarClient
 	cpClient       codePipelineClient
 	ecsClient      ecsClient
+	elbv2Client    elbv2Client
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	s3Client       s3Client
@@ -117,6 +127,7 @@ func New(sess *session.Session) CloudFormation {
 		codeStarClient: codestar.New(sess),
 		cpClient:       codepipeline.New(sess),
 		ecsClient:      ecs.New(sess),
+		elbv2Client:    elbv2.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -291,9 +302,10 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 			renderer = r
 		case aws.StringValue(change.ResourceChange.ResourceType) == ecsServiceResourceType:
 			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, progress.ECSServiceRendererOpts{
-				Group:      in.g,
-				Ctx:        in.ctx,
-				RenderOpts: in.opts,
+				Group:         in.g,
+				Ctx:           in.ctx,
+				RenderOpts:    in.opts,
+				ListenerRules: cf.elbv2Client,
 			})
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
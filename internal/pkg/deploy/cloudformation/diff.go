@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Actions that can be applied to a resource by a change set.
+const (
+	ResourceActionAdd     = "Add"
+	ResourceActionModify  = "Modify"
+	ResourceActionReplace = "Replace"
+	ResourceActionRemove  = "Remove"
+)
+
+const (
+	diffMinCellWidth     = 10
+	diffTabWidth         = 4
+	diffCellPaddingWidth = 2
+	diffPaddingChar      = ' '
+)
+
+// StackDiff holds the changes proposed for a stack by a change set that is created but not executed yet.
+type StackDiff struct {
+	StackName   string
+	ChangeSetID string
+	Resources   []ResourceDiff
+	Parameters  []ParameterDiff
+
+	isNewStack bool // True if the change set creates the stack.
+}
+
+// ResourceDiff is a resource-level change proposed by a change set.
+type ResourceDiff struct {
+	Action    string
+	LogicalID string
+	Type      string
+	// Conditional is true if the resource is replaced only if the value of a property changes at deployment time.
+	Conditional bool
+}
+
+// ParameterDiff is a change to the value of a stack parameter.
+type ParameterDiff struct {
+	Key      string
+	Previous *string // Nil if the parameter is added to the stack.
+	Proposed *string // Nil if the parameter is removed from the stack.
+}
+
+// HumanString returns a readable representation of the stack changes.
+func (d *StackDiff) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, diffMinCellWidth, diffTabWidth, diffCellPaddingWidth, diffPaddingChar, 0)
+	fmt.Fprint(writer, color.Bold.Sprintf("Resource changes for stack %s\n", d.StackName))
+	if len(d.Resources) == 0 {
+		fmt.Fprintln(writer, "  No resource changes.")
+	}
+	for _, r := range d.Resources {
+		action := r.Action
+		if r.Conditional {
+			action = fmt.Sprintf("%s (conditional)", action)
+		}
+		fmt.Fprintf(writer, "  %s %s\t%s\t%s\n", resourceActionSymbol(r.Action), action, r.LogicalID, r.Type)
+	}
+	if len(d.Parameters) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nParameter changes\n"))
+	}
+	for _, p := range d.Parameters {
+		switch {
+		case p.Previous == nil:
+			fmt.Fprintf(writer, "  + %s\t%q\n", p.Key, aws.StringValue(p.Proposed))
+		case p.Proposed == nil:
+			fmt.Fprintf(writer, "  - %s\t%q\n", p.Key, aws.StringValue(p.Previous))
+		default:
+			fmt.Fprintf(writer, "  ~ %s\t%q -> %q\n", p.Key, aws.StringValue(p.Previous), aws.StringValue(p.Proposed))
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func resourceActionSymbol(action string) string {
+	switch action {
+	case ResourceActionAdd:
+		return "+"
+	case ResourceActionRemove:
+		return "-"
+	case ResourceActionReplace:
+		return "!"
+	default:
+		return "~"
+	}
+}
+
+// newResourceDiffs converts the changes of a change set to resource-level diffs.
+func newResourceDiffs(changes []*sdkcloudformation.Change) []ResourceDiff {
+	var diffs []ResourceDiff
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		diff := ResourceDiff{
+			Action:    aws.StringValue(rc.Action),
+			LogicalID: aws.StringValue(rc.LogicalResourceId),
+			Type:      aws.StringValue(rc.ResourceType),
+		}
+		if diff.Action == sdkcloudformation.ChangeActionModify {
+			switch aws.StringValue(rc.Replacement) {
+			case sdkcloudformation.ReplacementTrue:
+				diff.Action = ResourceActionReplace
+			case sdkcloudformation.ReplacementConditional:
+				diff.Action = ResourceActionReplace
+				diff.Conditional = true
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// newParameterDiffs returns the parameters whose values differ between the deployed and the proposed stack, sorted by key.
+func newParameterDiffs(deployed, proposed []*sdkcloudformation.Parameter) []ParameterDiff {
+	prev := make(map[string]*string)
+	for _, p := range deployed {
+		prev[aws.StringValue(p.ParameterKey)] = p.ParameterValue
+	}
+	next := make(map[string]*string)
+	for _, p := range proposed {
+		key := aws.StringValue(p.ParameterKey)
+		if aws.BoolValue(p.UsePreviousValue) {
+			next[key] = prev[key]
+			continue
+		}
+		next[key] = p.ParameterValue
+	}
+	var diffs []ParameterDiff
+	for k, v := range next {
+		old, ok := prev[k]
+		if !ok {
+			diffs = append(diffs, ParameterDiff{Key: k, Proposed: aws.String(aws.StringValue(v))})
+			continue
+		}
+		if aws.StringValue(old) != aws.StringValue(v) {
+			diffs = append(diffs, ParameterDiff{Key: k, Previous: aws.String(aws.StringValue(old)), Proposed: aws.String(aws.StringValue(v))})
+		}
+	}
+	for k, v := range prev {
+		if _, ok := next[k]; !ok {
+			diffs = append(diffs, ParameterDiff{Key: k, Previous: aws.String(aws.StringValue(v))})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].Key < diffs[j].Key })
+	return diffs
+}
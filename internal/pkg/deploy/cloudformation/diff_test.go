@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackDiff_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		in     *StackDiff
+		wanted string
+	}{
+		"no resource changes": {
+			in: &StackDiff{
+				StackName: "phonetool-test-api",
+			},
+			wanted: `Resource changes for stack phonetool-test-api
+  No resource changes.
+`,
+		},
+		"resource and parameter changes": {
+			in: &StackDiff{
+				StackName: "phonetool-test-api",
+				Resources: []ResourceDiff{
+					{Action: ResourceActionAdd, LogicalID: "HTTPListenerRule", Type: "AWS::ElasticLoadBalancingV2::ListenerRule"},
+					{Action: ResourceActionModify, LogicalID: "Service", Type: "AWS::ECS::Service"},
+					{Action: ResourceActionReplace, LogicalID: "TaskDefinition", Type: "AWS::ECS::TaskDefinition"},
+					{Action: ResourceActionReplace, LogicalID: "TargetGroup", Type: "AWS::ElasticLoadBalancingV2::TargetGroup", Conditional: true},
+					{Action: ResourceActionRemove, LogicalID: "LogGroup", Type: "AWS::Logs::LogGroup"},
+				},
+				Parameters: []ParameterDiff{
+					{Key: "ContainerImage", Previous: aws.String("image:v1"), Proposed: aws.String("image:v2")},
+					{Key: "TaskCount", Proposed: aws.String("2")},
+					{Key: "OldParam", Previous: aws.String("")},
+				},
+			},
+			wanted: `Resource changes for stack phonetool-test-api
+  + Add                    HTTPListenerRule  AWS::ElasticLoadBalancingV2::ListenerRule
+  ~ Modify                 Service           AWS::ECS::Service
+  ! Replace                TaskDefinition    AWS::ECS::TaskDefinition
+  ! Replace (conditional)  TargetGroup       AWS::ElasticLoadBalancingV2::TargetGroup
+  - Remove                 LogGroup          AWS::Logs::LogGroup
+
+Parameter changes
+  ~ ContainerImage  "image:v1" -> "image:v2"
+  + TaskCount       "2"
+  - OldParam        ""
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
@@ -234,10 +234,13 @@ func TestGHv1PipelineCreation(t *testing.T) {
 			Build: deploy.PipelineBuildFromManifest(nil),
 			Stages: []deploy.PipelineStage{
 				{
-					AssociatedEnvironment: &deploy.AssociatedEnvironment{
-						Name:      environmentToDeploy.Name,
-						Region:    *appSess.Config.Region,
-						AccountID: app.AccountID,
+					Name: environmentToDeploy.Name,
+					Environments: []*deploy.AssociatedEnvironment{
+						{
+							Name:      environmentToDeploy.Name,
+							Region:    *appSess.Config.Region,
+							AccountID: app.AccountID,
+						},
 					},
 					LocalWorkloads: []string{"frontend", "backend"},
 				},
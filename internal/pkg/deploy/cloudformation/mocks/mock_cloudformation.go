@@ -13,6 +13,7 @@ import (
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -135,6 +136,59 @@ func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
 }
 
+// Mockelbv2Client is a mock of elbv2Client interface.
+type Mockelbv2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockelbv2ClientMockRecorder
+}
+
+// Mockelbv2ClientMockRecorder is the mock recorder for Mockelbv2Client.
+type Mockelbv2ClientMockRecorder struct {
+	mock *Mockelbv2Client
+}
+
+// NewMockelbv2Client creates a new mock instance.
+func NewMockelbv2Client(ctrl *gomock.Controller) *Mockelbv2Client {
+	mock := &Mockelbv2Client{ctrl: ctrl}
+	mock.recorder = &Mockelbv2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockelbv2Client) EXPECT() *Mockelbv2ClientMockRecorder {
+	return m.recorder
+}
+
+// TargetGroupWeights mocks base method.
+func (m *Mockelbv2Client) TargetGroupWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetGroupWeights", ruleARN)
+	ret0, _ := ret[0].([]elbv2.TargetGroupWeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetGroupWeights indicates an expected call of TargetGroupWeights.
+func (mr *Mockelbv2ClientMockRecorder) TargetGroupWeights(ruleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetGroupWeights", reflect.TypeOf((*Mockelbv2Client)(nil).TargetGroupWeights), ruleARN)
+}
+
+// WeightedListenerRule mocks base method.
+func (m *Mockelbv2Client) WeightedListenerRule(targetGroupARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WeightedListenerRule", targetGroupARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WeightedListenerRule indicates an expected call of WeightedListenerRule.
+func (mr *Mockelbv2ClientMockRecorder) WeightedListenerRule(targetGroupARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WeightedListenerRule", reflect.TypeOf((*Mockelbv2Client)(nil).WeightedListenerRule), targetGroupARN)
+}
+
 // MockcfnClient is a mock of cfnClient interface.
 type MockcfnClient struct {
 	ctrl     *gomock.Controller
@@ -187,6 +241,21 @@ func (mr *MockcfnClientMockRecorder) CreateAndWait(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWait", reflect.TypeOf((*MockcfnClient)(nil).CreateAndWait), arg0)
 }
 
+// CreateChangeSet mocks base method.
+func (m *MockcfnClient) CreateChangeSet(arg0 *cloudformation0.Stack) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet.
+func (mr *MockcfnClientMockRecorder) CreateChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockcfnClient)(nil).CreateChangeSet), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockcfnClient) Delete(stackName string) error {
 	m.ctrl.T.Helper()
@@ -229,6 +298,20 @@ func (mr *MockcfnClientMockRecorder) DeleteAndWaitWithRoleARN(stackName, roleARN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndWaitWithRoleARN", reflect.TypeOf((*MockcfnClient)(nil).DeleteAndWaitWithRoleARN), stackName, roleARN)
 }
 
+// DeleteChangeSet mocks base method.
+func (m *MockcfnClient) DeleteChangeSet(changeSetID, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet.
+func (mr *MockcfnClientMockRecorder) DeleteChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).DeleteChangeSet), changeSetID, stackName)
+}
+
 // Describe mocks base method.
 func (m *MockcfnClient) Describe(stackName string) (*cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +387,20 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// ExecuteChangeSet mocks base method.
+func (m *MockcfnClient) ExecuteChangeSet(changeSetID, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet.
+func (mr *MockcfnClientMockRecorder) ExecuteChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).ExecuteChangeSet), changeSetID, stackName)
+}
+
 // ListStacksWithTags mocks base method.
 func (m *MockcfnClient) ListStacksWithTags(tags map[string]string) ([]cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +431,21 @@ func (mr *MockcfnClientMockRecorder) Outputs(stack interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockcfnClient)(nil).Outputs), stack)
 }
 
+// StackResources mocks base method.
+func (m *MockcfnClient) StackResources(stackName string) ([]*cloudformation0.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", stackName)
+	ret0, _ := ret[0].([]*cloudformation0.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockcfnClientMockRecorder) StackResources(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockcfnClient)(nil).StackResources), stackName)
+}
+
 // TemplateBody mocks base method.
 func (m *MockcfnClient) TemplateBody(stackName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +79,14 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	var rulePriorityLambda string
+	if s.manifest.HTTPEnabled() {
+		lambda, err := s.parser.Read(lbWebSvcRulePriorityGeneratorPath)
+		if err != nil {
+			return "", fmt.Errorf("read rule priority lambda: %w", err)
+		}
+		rulePriorityLambda = lambda.String()
+	}
 	convSidecarOpts := convertSidecarOpts{
 		sidecarConfig: s.manifest.Sidecars,
 		imageConfig:   &s.manifest.ImageConfig.Image,
@@ -97,6 +105,9 @@ func (s *BackendService) Template() (string, error) {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
 
+	if s.manifest.Count.AdvancedCount.QueueScaling != nil {
+		return "", fmt.Errorf("convert the advanced count configuration for service %s: %w", s.name, errQueueDelayOnlyForWorkers)
+	}
 	advancedCount, err := convertAdvancedCount(&s.manifest.Count.AdvancedCount)
 	if err != nil {
 		return "", fmt.Errorf("convert the advanced count configuration for service %s: %w", s.name, err)
@@ -123,6 +134,26 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	deployment, err := convertRollingDeployment(&s.manifest.Deployment)
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	var httpHealthCheck template.HTTPHealthCheckOpts
+	var deregistrationDelay *int64
+	var allowedSourceIPs []string
+	if s.manifest.HTTPEnabled() {
+		if err := s.validateHTTP(); err != nil {
+			return "", fmt.Errorf(`convert "http" field for service %s: %w`, s.name, err)
+		}
+		httpHealthCheck = convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck)
+		deregistrationDelay = aws.Int64(60)
+		if s.manifest.RoutingRule.DeregistrationDelay != nil {
+			deregistrationDelay = aws.Int64(int64(s.manifest.RoutingRule.DeregistrationDelay.Seconds()))
+		}
+		if s.manifest.RoutingRule.AllowedSourceIps != nil {
+			allowedSourceIPs = *s.manifest.RoutingRule.AllowedSourceIps
+		}
+	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
 		Variables:                s.manifest.BackendServiceConfig.Variables,
 		Secrets:                  s.manifest.BackendServiceConfig.Secrets,
@@ -132,8 +163,14 @@ func (s *BackendService) Template() (string, error) {
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		Platform:                 convertPlatform(s.manifest.Platform),
 		WorkloadType:             manifest.BackendServiceType,
+		ALBEnabled:               s.manifest.HTTPEnabled(),
 		HealthCheck:              s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
+		HTTPHealthCheck:          httpHealthCheck,
+		DeregistrationDelay:      deregistrationDelay,
+		AllowedSourceIps:         allowedSourceIPs,
+		RulePriorityLambda:       rulePriorityLambda,
 		LogConfig:                convertLogging(s.manifest.Logging),
 		DockerLabels:             s.manifest.ImageConfig.DockerLabels,
 		DesiredCountLambda:       desiredCountLambda.String(),
@@ -146,6 +183,7 @@ func (s *BackendService) Template() (string, error) {
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
+		Deployment:               deployment,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
@@ -167,14 +205,48 @@ func (s *BackendService) Parameters() ([]*cloudformation.Parameter, error) {
 	if s.manifest.BackendServiceConfig.ImageConfig.Port != nil {
 		containerPort = strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.BackendServiceConfig.ImageConfig.Port)), 10)
 	}
+	svcParams = append(svcParams, &cloudformation.Parameter{
+		ParameterKey:   aws.String(BackendServiceContainerPortParamKey),
+		ParameterValue: aws.String(containerPort),
+	})
+	if !s.manifest.HTTPEnabled() {
+		return svcParams, nil
+	}
+	targetContainer, targetPort, err := httpLoadBalancerTarget(s.name, s.manifest.ImageConfig.Port, &s.manifest.RoutingRule, s.manifest.Sidecars)
+	if err != nil {
+		return nil, err
+	}
 	return append(svcParams, []*cloudformation.Parameter{
 		{
-			ParameterKey:   aws.String(BackendServiceContainerPortParamKey),
-			ParameterValue: aws.String(containerPort),
+			ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
+			ParameterValue: s.manifest.RoutingRule.Path,
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+			ParameterValue: targetContainer,
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+			ParameterValue: targetPort,
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(s.manifest.RoutingRule.Stickiness))),
 		},
 	}...), nil
 }
 
+// validateHTTP returns an error if the service can't be placed behind the internal load balancer of the environment.
+func (s *BackendService) validateHTTP() error {
+	if s.manifest.ImageConfig.Port == nil {
+		return errHTTPWithoutPort
+	}
+	if s.manifest.RoutingRule.Alias != nil {
+		return errAliasForInternalHTTP
+	}
+	return nil
+}
+
 // SerializedParameters returns the CloudFormation stack's parameters serialized
 // to a YAML document annotated with comments for readability to users.
 func (s *BackendService) SerializedParameters() (string, error) {
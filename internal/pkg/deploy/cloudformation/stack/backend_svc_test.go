@@ -131,6 +131,31 @@ Outputs:
 			},
 			wantedErr: fmt.Errorf("convert the advanced count configuration for service frontend: %w", errors.New("invalid range value badRange. Should be in format of ${min}-${max}")),
 		},
+		"queue delay is not supported": {
+			setUpManifest: func(svc *BackendService) {
+				mft := manifest.NewBackendService(baseProps)
+				mft.Count.AdvancedCount = manifest.AdvancedCount{
+					QueueScaling: &manifest.QueueScaling{},
+				}
+				svc.manifest = mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `
+Resources:
+  AdditionalResourcesPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf("convert the advanced count configuration for service frontend: %w", errQueueDelayOnlyForWorkers),
+		},
 		"failed parsing svc template": {
 			setUpManifest: func(svc *BackendService) {
 				svc.manifest = manifest.NewBackendService(baseProps)
@@ -153,6 +178,55 @@ Outputs:
 			},
 			wantedErr: fmt.Errorf("parse backend service template: %w", errors.New("some error")),
 		},
+		"alias is not supported with http": {
+			setUpManifest: func(svc *BackendService) {
+				mft := manifest.NewBackendService(baseProps)
+				mft.RoutingRule = manifest.RoutingRule{
+					Path:  aws.String("api"),
+					Alias: &manifest.Alias{String: aws.String("example.com")},
+				}
+				svc.manifest = mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedErr: fmt.Errorf(`convert "http" field for service frontend: %w`, errAliasForInternalHTTP),
+		},
+		"render template with http": {
+			setUpManifest: func(svc *BackendService) {
+				mft := manifest.NewBackendService(baseProps)
+				mft.RoutingRule = manifest.RoutingRule{
+					Path: aws.String("api"),
+					HealthCheck: manifest.HealthCheckArgsOrString{
+						HealthCheckPath: aws.String("/_health"),
+					},
+					AllowedSourceIps: &[]string{"10.0.0.0/16"},
+				}
+				svc.manifest = mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("rule priority")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, opts.ALBEnabled)
+					require.Equal(t, "rule priority", opts.RulePriorityLambda)
+					require.Equal(t, "/_health", opts.HTTPHealthCheck.HealthCheckPath)
+					require.Equal(t, aws.Int64(60), opts.DeregistrationDelay)
+					require.Equal(t, []string{"10.0.0.0/16"}, opts.AllowedSourceIps)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedTemplate: "template",
+		},
 		"render template": {
 			setUpManifest: func(svc *BackendService) {
 				svc.manifest = manifest.NewBackendService(manifest.BackendServiceProps{
@@ -344,3 +418,82 @@ func TestBackendService_Parameters(t *testing.T) {
 		},
 	}, params)
 }
+
+func TestBackendService_ParametersWithHTTP(t *testing.T) {
+	testCases := map[string]struct {
+		inRoutingRule manifest.RoutingRule
+
+		wantedParams []*cloudformation.Parameter
+		wantedErr    error
+	}{
+		"routes to the main container": {
+			inRoutingRule: manifest.RoutingRule{
+				Path: aws.String("api"),
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
+					ParameterValue: aws.String("api"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("8080"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+					ParameterValue: aws.String("false"),
+				},
+			},
+		},
+		"error if the target container does not exist": {
+			inRoutingRule: manifest.RoutingRule{
+				Path:            aws.String("api"),
+				TargetContainer: aws.String("envoy"),
+			},
+			wantedErr: errors.New("target container envoy doesn't exist"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := manifest.NewBackendService(manifest.BackendServiceProps{
+				WorkloadProps: manifest.WorkloadProps{
+					Name:       testServiceName,
+					Dockerfile: testDockerfile,
+				},
+				Port: 8080,
+			})
+			mft.RoutingRule = tc.inRoutingRule
+			conf := &BackendService{
+				ecsWkld: &ecsWkld{
+					wkld: &wkld{
+						name: aws.StringValue(mft.Name),
+						env:  testEnvName,
+						app:  testAppName,
+						image: manifest.Image{
+							Location: aws.String("mockLocation"),
+						},
+					},
+					tc: mft.BackendServiceConfig.TaskConfig,
+				},
+				manifest: mft,
+			}
+
+			// WHEN
+			params, err := conf.Parameters()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Subset(t, params, tc.wantedParams)
+		})
+	}
+}
@@ -31,10 +31,13 @@ func TestBB_Pipeline_Template(t *testing.T) {
 		Build: deploy.PipelineBuildFromManifest(nil),
 		Stages: []deploy.PipelineStage{
 			{
-				AssociatedEnvironment: &deploy.AssociatedEnvironment{
-					Name:      "test",
-					Region:    "us-west-2",
-					AccountID: "1111",
+				Name: "test",
+				Environments: []*deploy.AssociatedEnvironment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "1111",
+					},
 				},
 				LocalWorkloads:   []string{"api"},
 				RequiresApproval: false,
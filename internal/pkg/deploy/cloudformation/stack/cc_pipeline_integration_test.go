@@ -31,10 +31,13 @@ func TestCC_Pipeline_Template(t *testing.T) {
 		Build: deploy.PipelineBuildFromManifest(nil),
 		Stages: []deploy.PipelineStage{
 			{
-				AssociatedEnvironment: &deploy.AssociatedEnvironment{
-					Name:      "staging-test",
-					Region:    "us-west-2",
-					AccountID: "1111",
+				Name: "staging-test",
+				Environments: []*deploy.AssociatedEnvironment{
+					{
+						Name:      "staging-test",
+						Region:    "us-west-2",
+						AccountID: "1111",
+					},
 				},
 				LocalWorkloads:   []string{"api"},
 				RequiresApproval: false,
@@ -101,6 +101,10 @@ func (e *EnvStackConfig) Template() (string, error) {
 		ScriptBucketName:          bucket,
 		ImportVPC:                 e.in.ImportVPCConfig,
 		VPCConfig:                 vpcConf,
+		ForceNATGateways:          e.in.ImportVPCConfig == nil && vpcConf.ForceNATGateways,
+		ImportCertARNs:            e.in.ImportCertARNs,
+		ImportALB:                 e.in.ImportALB,
+		Telemetry:                 e.in.Telemetry,
 		Version:                   e.in.Version,
 		LatestVersion:             deploy.LatestEnvTemplateVersion,
 	}, template.WithFuncs(map[string]interface{}{
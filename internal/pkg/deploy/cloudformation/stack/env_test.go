@@ -21,6 +21,7 @@ import (
 
 func TestEnv_Template(t *testing.T) {
 	testCases := map[string]struct {
+		modifyInput      func(in *deploy.CreateEnvironmentInput)
 		mockDependencies func(ctrl *gomock.Controller, e *EnvStackConfig)
 		expectedOutput   string
 		want             error
@@ -47,6 +48,51 @@ func TestEnv_Template(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should pass the settings of the environment manifest to the template": {
+			modifyInput: func(in *deploy.CreateEnvironmentInput) {
+				in.AdjustVPCConfig = &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PrivateSubnetCIDRs: []string{"10.1.2.0/24", "10.1.3.0/24"},
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+					ForceNATGateways:   true,
+				}
+				in.ImportCertARNs = []string{"mockCertARN"}
+				in.ImportALB = &config.ImportALB{
+					ARN: "mockALBARN",
+				}
+				in.Telemetry = &config.Telemetry{
+					EnableContainerInsights: true,
+				}
+			},
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					AppName:                   "project",
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					CustomDomainLambda:        "mockkey4",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               "10.1.0.0/16",
+						PrivateSubnetCIDRs: []string{"10.1.2.0/24", "10.1.3.0/24"},
+						PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+						ForceNATGateways:   true,
+					},
+					ForceNATGateways: true,
+					ImportCertARNs:   []string{"mockCertARN"},
+					ImportALB: &config.ImportALB{
+						ARN: "mockALBARN",
+					},
+					Telemetry: &config.Telemetry{
+						EnableContainerInsights: true,
+					},
+					LatestVersion: deploy.LatestEnvTemplateVersion,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -54,8 +100,12 @@ func TestEnv_Template(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			in := mockDeployEnvironmentInput()
+			if tc.modifyInput != nil {
+				tc.modifyInput(in)
+			}
 			envStack := &EnvStackConfig{
-				in: mockDeployEnvironmentInput(),
+				in: in,
 			}
 			tc.mockDependencies(ctrl, envStack)
 
@@ -32,10 +32,13 @@ func TestGHv1Pipeline_Template(t *testing.T) {
 		},
 		Stages: []deploy.PipelineStage{
 			{
-				AssociatedEnvironment: &deploy.AssociatedEnvironment{
-					Name:      "test",
-					Region:    "us-west-2",
-					AccountID: "1111",
+				Name: "test",
+				Environments: []*deploy.AssociatedEnvironment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "1111",
+					},
 				},
 				LocalWorkloads:   []string{"api"},
 				RequiresApproval: false,
@@ -20,6 +20,7 @@ const (
 	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
 	desiredCountGeneratorPath         = "custom-resources/desired-count-delegation.js"
 	envControllerPath                 = "custom-resources/env-controller.js"
+	backlogPerTaskCalculatorPath      = "custom-resources/backlog-per-task-calculator.js"
 )
 
 // Parameter logical IDs for a load balanced web service.
@@ -30,8 +31,24 @@ const (
 	LBWebServiceTargetContainerParamKey = "TargetContainer"
 	LBWebServiceTargetPortParamKey      = "TargetPort"
 	LBWebServiceStickinessParamKey      = "Stickiness"
+
+	LBWebServiceTargetGroupWeightParamKey          = "TargetGroupWeight"
+	LBWebServiceAlternateTargetGroupWeightParamKey = "AlternateTargetGroupWeight"
 )
 
+// TrafficWeights are the weights that the listener rule of a load balanced web service forwards requests to its target groups with.
+// ECS changes them outside of CloudFormation when it shifts traffic between the target groups during a deployment.
+type TrafficWeights struct {
+	TargetGroup          int
+	AlternateTargetGroup int
+}
+
+// defaultTrafficWeights forward all requests to the target group of a service that isn't deployed yet.
+var defaultTrafficWeights = TrafficWeights{
+	TargetGroup:          100,
+	AlternateTargetGroup: 0,
+}
+
 type loadBalancedWebSvcReadParser interface {
 	template.ReadParser
 	ParseLoadBalancedWebService(template.WorkloadOpts) (*template.Content, error)
@@ -122,6 +139,9 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
 
+	if s.manifest.Count.AdvancedCount.QueueScaling != nil {
+		return "", fmt.Errorf("convert the advanced count configuration for service %s: %w", s.name, errQueueDelayOnlyForWorkers)
+	}
 	advancedCount, err := convertAdvancedCount(&s.manifest.Count.AdvancedCount)
 	if err != nil {
 		return "", fmt.Errorf("convert the advanced count configuration for service %s: %w", s.name, err)
@@ -162,6 +182,16 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		deregistrationDelay = aws.Int64(int64(s.manifest.RoutingRule.DeregistrationDelay.Seconds()))
 	}
 
+	deployment, err := convertDeployment(&s.manifest.Deployment)
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+
+	nlb, err := convertNLB(&s.manifest.NLBConfig, s.name, s.manifest.ImageConfig.Port, s.manifest.Sidecars)
+	if err != nil {
+		return "", fmt.Errorf(`convert "nlb" field for service %s: %w`, s.name, err)
+	}
+
 	var allowedSourceIPs []string
 	if s.manifest.AllowedSourceIps != nil {
 		allowedSourceIPs = *s.manifest.AllowedSourceIps
@@ -178,11 +208,13 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		Platform:                 convertPlatform(s.manifest.Platform),
 		WorkloadType:             manifest.LoadBalancedWebServiceType,
 		HealthCheck:              s.manifest.ImageConfig.HealthCheckOpts(),
 		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.HealthCheck),
 		DeregistrationDelay:      deregistrationDelay,
 		AllowedSourceIps:         allowedSourceIPs,
+		NLB:                      nlb,
 		RulePriorityLambda:       rulePriorityLambda.String(),
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
@@ -194,6 +226,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
+		Deployment:               deployment,
 	})
 	if err != nil {
 		return "", err
@@ -206,17 +239,22 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 }
 
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
-	containerName := s.name
-	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
+	return httpLoadBalancerTarget(s.name, s.manifest.ImageConfig.Port, &s.manifest.RoutingRule, s.manifest.Sidecars)
+}
+
+// httpLoadBalancerTarget returns the container and port that a load balancer routes traffic to given the routing rule of a service.
+func httpLoadBalancerTarget(svcName string, svcPort *uint16, rule *manifest.RoutingRule, sidecars map[string]*manifest.SidecarConfig) (targetContainer *string, targetPort *string, err error) {
+	containerName := svcName
+	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(svcPort)), 10)
 	// Route load balancer traffic to main container by default.
 	targetContainer = aws.String(containerName)
 	targetPort = aws.String(containerPort)
-	if s.manifest.TargetContainer == nil && s.manifest.TargetContainerCamelCase != nil {
-		s.manifest.TargetContainer = s.manifest.TargetContainerCamelCase
+	if rule.TargetContainer == nil && rule.TargetContainerCamelCase != nil {
+		rule.TargetContainer = rule.TargetContainerCamelCase
 	}
-	mftTargetContainer := s.manifest.TargetContainer
+	mftTargetContainer := rule.TargetContainer
 	if mftTargetContainer != nil {
-		sidecar, ok := s.manifest.Sidecars[*mftTargetContainer]
+		sidecar, ok := sidecars[*mftTargetContainer]
 		if ok {
 			if sidecar.Port == nil {
 				return nil, nil, fmt.Errorf("target container %s doesn't expose any port", *mftTargetContainer)
@@ -240,7 +278,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 	if err != nil {
 		return nil, err
 	}
-	return append(wkldParams, []*cloudformation.Parameter{
+	params := append(wkldParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceContainerPortParamKey),
 			ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)),
@@ -265,6 +303,28 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
 			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(s.manifest.Stickiness))),
 		},
+	}...)
+	deployment, err := convertDeployment(&s.manifest.Deployment)
+	if err != nil {
+		return nil, fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	if deployment == nil || !deployment.ShiftsTraffic() {
+		return params, nil
+	}
+	// Keep the weights that ECS last set, otherwise the update would send the requests to the target group without tasks.
+	weights := defaultTrafficWeights
+	if s.rc.TrafficWeights != nil {
+		weights = *s.rc.TrafficWeights
+	}
+	return append(params, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(LBWebServiceTargetGroupWeightParamKey),
+			ParameterValue: aws.String(strconv.Itoa(weights.TargetGroup)),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceAlternateTargetGroupWeightParamKey),
+			ParameterValue: aws.String(strconv.Itoa(weights.AlternateTargetGroup)),
+		},
 	}...), nil
 }
 
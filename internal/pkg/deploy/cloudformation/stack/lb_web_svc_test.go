@@ -371,6 +371,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 			Enable: aws.Bool(true),
 		},
 	}
+	testLBWebServiceManifestWithBlueGreen := manifest.NewLoadBalancedWebService(baseProps)
+	testLBWebServiceManifestWithBlueGreen.Deployment = manifest.DeploymentConfig{
+		Strategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+	}
 	testLBWebServiceManifestWithBadSidecarName := manifest.NewLoadBalancedWebService(baseProps)
 	testLBWebServiceManifestWithBadSidecarName.TargetContainer = aws.String("xray")
 
@@ -421,9 +425,42 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 			ParameterValue: aws.String(""),
 		},
 	}
+	blueGreenParams := func(targetGroupWeight, alternateTargetGroupWeight string) []*cloudformation.Parameter {
+		return append(expectedParams, []*cloudformation.Parameter{
+			{
+				ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+				ParameterValue: aws.String("false"),
+			},
+			{
+				ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+				ParameterValue: aws.String("frontend"),
+			},
+			{
+				ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+				ParameterValue: aws.String("80"),
+			},
+			{
+				ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+				ParameterValue: aws.String("1"),
+			},
+			{
+				ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+				ParameterValue: aws.String("false"),
+			},
+			{
+				ParameterKey:   aws.String(LBWebServiceTargetGroupWeightParamKey),
+				ParameterValue: aws.String(targetGroupWeight),
+			},
+			{
+				ParameterKey:   aws.String(LBWebServiceAlternateTargetGroupWeightParamKey),
+				ParameterValue: aws.String(alternateTargetGroupWeight),
+			},
+		}...)
+	}
 	testCases := map[string]struct {
-		httpsEnabled bool
-		manifest     *manifest.LoadBalancedWebService
+		httpsEnabled   bool
+		manifest       *manifest.LoadBalancedWebService
+		trafficWeights *TrafficWeights
 
 		expectedParams []*cloudformation.Parameter
 		expectedErr    error
@@ -563,6 +600,20 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"forwards all requests to the target group of a new blue/green service": {
+			manifest: testLBWebServiceManifestWithBlueGreen,
+
+			expectedParams: blueGreenParams("100", "0"),
+		},
+		"keeps the traffic weights of a deployed blue/green service": {
+			manifest: testLBWebServiceManifestWithBlueGreen,
+			trafficWeights: &TrafficWeights{
+				TargetGroup:          0,
+				AlternateTargetGroup: 100,
+			},
+
+			expectedParams: blueGreenParams("0", "100"),
+		},
 		"with bad sidecar container": {
 			httpsEnabled: true,
 			manifest:     testLBWebServiceManifestWithBadSidecarName,
@@ -597,6 +648,7 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 								RepoURL:  testImageRepoURL,
 								ImageTag: testImageTag,
 							},
+							TrafficWeights: tc.trafficWeights,
 						},
 					},
 					tc: tc.manifest.TaskConfig,
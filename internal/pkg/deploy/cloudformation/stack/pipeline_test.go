@@ -131,13 +131,15 @@ func mockCreatePipelineInput() *deploy.CreatePipelineInput {
 		},
 		Stages: []deploy.PipelineStage{
 			{
-				AssociatedEnvironment: mockAssociatedEnv("test-chicken", "us-west-2"),
-				LocalWorkloads:        []string{"frontend", "backend"},
-				TestCommands:          []string{"echo 'bok bok bok'", "make test"},
+				Name:           "test-chicken",
+				Environments:   []*deploy.AssociatedEnvironment{mockAssociatedEnv("test-chicken", "us-west-2")},
+				LocalWorkloads: []string{"frontend", "backend"},
+				TestCommands:   []string{"echo 'bok bok bok'", "make test"},
 			},
 			{
-				AssociatedEnvironment: mockAssociatedEnv("prod-can-fly", "us-east-1"),
-				LocalWorkloads:        []string{"frontend", "backend"},
+				Name:           "prod-can-fly",
+				Environments:   []*deploy.AssociatedEnvironment{mockAssociatedEnv("prod-can-fly", "us-east-1")},
+				LocalWorkloads: []string{"frontend", "backend"},
 			},
 		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
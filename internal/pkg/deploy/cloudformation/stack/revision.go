@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// deployedStack is the configuration that a stack is deployed with.
+type deployedStack interface {
+	StackName() string
+	Template() (string, error)
+	Parameters() ([]*cloudformation.Parameter, error)
+	Tags() []*cloudformation.Tag
+}
+
+// workloadRevisionRecord is the serialized configuration of a workload stack at a revision of the workload.
+type workloadRevisionRecord struct {
+	StackName  string            `json:"stackName"`
+	Template   string            `json:"template"`
+	Parameters map[string]string `json:"parameters"`
+	Tags       map[string]string `json:"tags,omitempty"`
+}
+
+// MarshalWorkloadRevision serializes the template, parameters and tags that a workload stack is deployed with,
+// so that the workload can be redeployed with them with UnmarshalWorkloadRevision.
+func MarshalWorkloadRevision(conf deployedStack) ([]byte, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", conf.StackName(), err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of stack %s: %w", conf.StackName(), err)
+	}
+	record := workloadRevisionRecord{
+		StackName:  conf.StackName(),
+		Template:   tpl,
+		Parameters: make(map[string]string, len(params)),
+	}
+	for _, param := range params {
+		record.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	if tags := conf.Tags(); len(tags) != 0 {
+		record.Tags = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			record.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("marshal configuration of stack %s: %w", conf.StackName(), err)
+	}
+	return data, nil
+}
+
+// WorkloadRevision represents the configuration that a workload stack was deployed with at a previous revision,
+// with the main container switched to the image of that revision.
+type WorkloadRevision struct {
+	name       string
+	template   string
+	parameters []*cloudformation.Parameter
+	tags       []*cloudformation.Tag
+	image      string
+	deployedBy string
+
+	trafficWeights *TrafficWeights
+}
+
+// UnmarshalWorkloadRevision deserializes the configuration of a workload stack recorded with MarshalWorkloadRevision.
+// The main container runs image and deployedBy is recorded as the principal of the deployment.
+func UnmarshalWorkloadRevision(data []byte, image, deployedBy string) (*WorkloadRevision, error) {
+	var record workloadRevisionRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("unmarshal workload revision: %w", err)
+	}
+	return &WorkloadRevision{
+		name:       record.StackName,
+		template:   record.Template,
+		parameters: sortedParameters(record.Parameters),
+		tags:       mergeAndFlattenTags(record.Tags, nil),
+		image:      image,
+		deployedBy: deployedBy,
+	}, nil
+}
+
+// StackName returns the name of the stack.
+func (r *WorkloadRevision) StackName() string {
+	return r.name
+}
+
+// Template returns the template that the stack was deployed with at the revision.
+func (r *WorkloadRevision) Template() (string, error) {
+	return r.template, nil
+}
+
+// SetTrafficWeights replaces the recorded weights of the target groups of a load balanced web service that shifts traffic
+// with the weights that the service is deployed with, since ECS changes them outside of CloudFormation.
+func (r *WorkloadRevision) SetTrafficWeights(weights *TrafficWeights) {
+	r.trafficWeights = weights
+}
+
+// Parameters returns the parameters that the stack was deployed with at the revision, with the image of the revision.
+// The principal is only recorded if the template of the revision accepts it.
+func (r *WorkloadRevision) Parameters() ([]*cloudformation.Parameter, error) {
+	params := make([]*cloudformation.Parameter, len(r.parameters))
+	for i, param := range r.parameters {
+		value := aws.StringValue(param.ParameterValue)
+		switch aws.StringValue(param.ParameterKey) {
+		case WorkloadContainerImageParamKey:
+			value = r.image
+		case WorkloadDeployedByParamKey:
+			value = r.deployedBy
+		case LBWebServiceTargetGroupWeightParamKey:
+			if r.trafficWeights != nil {
+				value = strconv.Itoa(r.trafficWeights.TargetGroup)
+			}
+		case LBWebServiceAlternateTargetGroupWeightParamKey:
+			if r.trafficWeights != nil {
+				value = strconv.Itoa(r.trafficWeights.AlternateTargetGroup)
+			}
+		}
+		params[i] = &cloudformation.Parameter{
+			ParameterKey:   param.ParameterKey,
+			ParameterValue: aws.String(value),
+		}
+	}
+	return params, nil
+}
+
+// Tags returns the tags that the stack was deployed with at the revision.
+func (r *WorkloadRevision) Tags() []*cloudformation.Tag {
+	return r.tags
+}
+
+func sortedParameters(params map[string]string) []*cloudformation.Parameter {
+	var out []*cloudformation.Parameter
+	for k, v := range params {
+		out = append(out, &cloudformation.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(v),
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return aws.StringValue(out[i].ParameterKey) < aws.StringValue(out[j].ParameterKey)
+	})
+	return out
+}
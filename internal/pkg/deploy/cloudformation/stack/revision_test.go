@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadRevision(t *testing.T) {
+	const (
+		mockPinnedImage = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+		mockAlice       = "arn:aws:sts::123456789012:assumed-role/Admin/alice"
+	)
+	testCases := map[string]struct {
+		inParams         []*cloudformation.Parameter
+		inTags           []*cloudformation.Tag
+		inTrafficWeights *TrafficWeights
+
+		wantedParams []*cloudformation.Parameter
+		wantedTags   []*cloudformation.Tag
+	}{
+		"replaces the image and keeps the other parameters and tags": {
+			inParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+					ParameterValue: aws.String("3"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+					ParameterValue: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2"),
+				},
+			},
+			inTags: []*cloudformation.Tag{
+				{
+					Key:   aws.String("copilot-application"),
+					Value: aws.String("phonetool"),
+				},
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+					ParameterValue: aws.String(mockPinnedImage),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+					ParameterValue: aws.String("3"),
+				},
+			},
+			wantedTags: []*cloudformation.Tag{
+				{
+					Key:   aws.String("copilot-application"),
+					Value: aws.String("phonetool"),
+				},
+			},
+		},
+		"records the principal if the template accepts it": {
+			inParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+					ParameterValue: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadDeployedByParamKey),
+					ParameterValue: aws.String("arn:aws:sts::123456789012:assumed-role/Admin/bob"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadImageDigestParamKey),
+					ParameterValue: aws.String("sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"),
+				},
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+					ParameterValue: aws.String(mockPinnedImage),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadDeployedByParamKey),
+					ParameterValue: aws.String(mockAlice),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadImageDigestParamKey),
+					ParameterValue: aws.String("sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"),
+				},
+			},
+		},
+		"keeps the traffic weights that the service is deployed with": {
+			inParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceAlternateTargetGroupWeightParamKey),
+					ParameterValue: aws.String("0"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetGroupWeightParamKey),
+					ParameterValue: aws.String("100"),
+				},
+			},
+			inTrafficWeights: &TrafficWeights{
+				TargetGroup:          0,
+				AlternateTargetGroup: 100,
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceAlternateTargetGroupWeightParamKey),
+					ParameterValue: aws.String("100"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetGroupWeightParamKey),
+					ParameterValue: aws.String("0"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			deployed := &WorkloadRevision{
+				name:       "phonetool-test-api",
+				template:   "template",
+				parameters: tc.inParams,
+				tags:       tc.inTags,
+				image:      "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2",
+			}
+			data, err := MarshalWorkloadRevision(deployed)
+			require.NoError(t, err)
+
+			// WHEN
+			conf, err := UnmarshalWorkloadRevision(data, mockPinnedImage, mockAlice)
+			require.NoError(t, err)
+			conf.SetTrafficWeights(tc.inTrafficWeights)
+
+			// THEN
+			params, err := conf.Parameters()
+			require.NoError(t, err)
+			tpl, err := conf.Template()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedParams, params)
+			require.Equal(t, "phonetool-test-api", conf.StackName())
+			require.Equal(t, "template", tpl)
+			require.Equal(t, tc.wantedTags, conf.Tags())
+		})
+	}
+}
+
+func TestUnmarshalWorkloadRevision_Error(t *testing.T) {
+	_, err := UnmarshalWorkloadRevision([]byte("not json"), "nginx", "")
+
+	require.EqualError(t, err, "unmarshal workload revision: invalid character 'o' in literal null (expecting 'u')")
+}
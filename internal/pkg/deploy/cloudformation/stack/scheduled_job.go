@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -144,6 +145,11 @@ func (j *ScheduledJob) Template() (string, error) {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
 	}
 
+	triggers, err := convertJobTriggers(j.manifest.On, outputs)
+	if err != nil {
+		return "", fmt.Errorf(`convert "on" field for job %s: %w`, j.name, err)
+	}
+
 	stateMachine, err := j.stateMachineOpts()
 	if err != nil {
 		return "", fmt.Errorf("convert retry/timeout config for job %s: %w", j.name, err)
@@ -175,11 +181,13 @@ func (j *ScheduledJob) Template() (string, error) {
 		Sidecars:                 sidecars,
 		ScheduleExpression:       schedule,
 		StateMachine:             stateMachine,
+		JobTriggers:              triggers,
 		HealthCheck:              j.manifest.ImageConfig.HealthCheckOpts(),
 		LogConfig:                convertLogging(j.manifest.Logging),
 		DockerLabels:             j.manifest.ImageConfig.DockerLabels,
 		Storage:                  storage,
 		Network:                  convertNetworkConfig(j.manifest.Network),
+		Platform:                 convertPlatform(j.manifest.Platform),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                dependencies,
@@ -235,6 +243,10 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 func (j *ScheduledJob) awsSchedule() (string, error) {
 	schedule := aws.StringValue(j.manifest.On.Schedule)
 	if schedule == "" {
+		if !j.manifest.On.IsEmpty() {
+			// The job is only triggered by events.
+			return "", nil
+		}
 		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
 	}
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
@@ -384,6 +396,61 @@ func toAWSCron(schedule string) (string, error) {
 	return fmt.Sprintf(fmtCronScheduleExpression, strings.Join(sched, " ")), nil
 }
 
+// convertJobTriggers converts the event triggers of a job to template options.
+// Buckets must be S3 storage addons of the job so that the event rules can reference their names.
+func convertJobTriggers(on manifest.JobTriggerConfig, addons *template.WorkloadNestedStackOpts) (*template.JobTriggerOpts, error) {
+	if len(on.EventPatterns) == 0 && len(on.S3) == 0 && len(on.Topics) == 0 {
+		return nil, nil
+	}
+	var opts template.JobTriggerOpts
+	for i, pattern := range on.EventPatterns {
+		if len(pattern) == 0 {
+			return nil, fmt.Errorf("event pattern %d is empty", i+1)
+		}
+		out, err := json.Marshal(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("marshal event pattern %d: %w", i+1, err)
+		}
+		opts.EventPatterns = append(opts.EventPatterns, string(out))
+	}
+	for _, trigger := range on.S3 {
+		output := template.EnvVarNameFunc(trigger.Bucket)
+		if !hasVariableOutput(addons, output) {
+			return nil, fmt.Errorf(`bucket "%s" must be the name of an S3 storage addon of the job`, trigger.Bucket)
+		}
+		opts.S3 = append(opts.S3, &template.S3TriggerOpts{
+			Name:         template.StripNonAlphaNumFunc(trigger.Bucket),
+			BucketOutput: output,
+			Prefix:       aws.StringValue(trigger.Prefix),
+		})
+	}
+	for _, topic := range on.Topics {
+		if err := validateTopicSubscription(topic); err != nil {
+			return nil, fmt.Errorf(`invalid topic subscription "%s": %w`, topic.Name, err)
+		}
+		if topic.Queue != nil {
+			return nil, fmt.Errorf(`invalid topic subscription "%s": %w`, topic.Name, errJobTopicQueue)
+		}
+		opts.Topics = append(opts.Topics, &template.TopicSubscription{
+			Name:    aws.String(topic.Name),
+			Service: aws.String(topic.Service),
+		})
+	}
+	return &opts, nil
+}
+
+func hasVariableOutput(addons *template.WorkloadNestedStackOpts, name string) bool {
+	if addons == nil {
+		return false
+	}
+	for _, output := range addons.VariableOutputs {
+		if output == name {
+			return true
+		}
+	}
+	return false
+}
+
 // StateMachine converts the Timeout and Retries fields to an instance of template.StateMachineOpts
 // It also performs basic validations to provide a fast feedback loop to the customer.
 func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
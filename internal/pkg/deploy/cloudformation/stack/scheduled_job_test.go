@@ -196,6 +196,7 @@ Outputs:
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule   string
+		inputTopics     []manifest.TopicSubscription
 		wantedSchedule  string
 		wantedError     error
 		wantedErrorType interface{}
@@ -208,6 +209,16 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule: "",
 			wantedError:   errors.New(`missing required field "schedule" in manifest for job mailer`),
 		},
+		"no schedule for a job triggered by events": {
+			inputSchedule: "",
+			inputTopics: []manifest.TopicSubscription{
+				{
+					Name:    "orders",
+					Service: "api",
+				},
+			},
+			wantedSchedule: "",
+		},
 		"one minute rate": {
 			inputSchedule:  "@every 1m",
 			wantedSchedule: "rate(1 minute)",
@@ -318,6 +329,7 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						On: manifest.JobTriggerConfig{
 							Schedule: aws.String(tc.inputSchedule),
+							Topics:   tc.inputTopics,
 						},
 					},
 				},
@@ -340,6 +352,107 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 	}
 }
 
+func Test_convertJobTriggers(t *testing.T) {
+	testCases := map[string]struct {
+		inTriggers manifest.JobTriggerConfig
+		inAddons   *template.WorkloadNestedStackOpts
+
+		wanted      *template.JobTriggerOpts
+		wantedError error
+	}{
+		"returns nil for a job that only runs on a schedule": {
+			inTriggers: manifest.JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+			},
+		},
+		"error if an event pattern is empty": {
+			inTriggers: manifest.JobTriggerConfig{
+				EventPatterns: []map[string]interface{}{{}},
+			},
+			wantedError: errors.New("event pattern 1 is empty"),
+		},
+		"error if the bucket is not a storage addon": {
+			inTriggers: manifest.JobTriggerConfig{
+				S3: []manifest.S3Trigger{
+					{
+						Bucket: "uploads",
+					},
+				},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"reportsName"},
+			},
+			wantedError: errors.New(`bucket "uploads" must be the name of an S3 storage addon of the job`),
+		},
+		"error if a topic configures a queue": {
+			inTriggers: manifest.JobTriggerConfig{
+				Topics: []manifest.TopicSubscription{
+					{
+						Name:    "orders",
+						Service: "api",
+						Queue:   &manifest.SQSQueue{},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`invalid topic subscription "orders": %w`, errJobTopicQueue),
+		},
+		"converts all triggers": {
+			inTriggers: manifest.JobTriggerConfig{
+				EventPatterns: []map[string]interface{}{
+					{
+						"source": []interface{}{"aws.ecr"},
+					},
+				},
+				S3: []manifest.S3Trigger{
+					{
+						Bucket: "my-uploads",
+						Prefix: aws.String("incoming/"),
+					},
+				},
+				Topics: []manifest.TopicSubscription{
+					{
+						Name:    "orders",
+						Service: "api",
+					},
+				},
+			},
+			inAddons: &template.WorkloadNestedStackOpts{
+				VariableOutputs: []string{"myuploadsName"},
+			},
+			wanted: &template.JobTriggerOpts{
+				EventPatterns: []string{`{"source":["aws.ecr"]}`},
+				S3: []*template.S3TriggerOpts{
+					{
+						Name:         "myuploads",
+						BucketOutput: "myuploadsName",
+						Prefix:       "incoming/",
+					},
+				},
+				Topics: []*template.TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := convertJobTriggers(tc.inTriggers, tc.inAddons)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
 		inputTimeout    string
@@ -0,0 +1,100 @@
+// +build integration localintegration
+
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// TestStageTest_Pipeline_Template ensures that the CloudFormation template generated for a pipeline with stage tests
+// and approval notifications matches our pre-defined template.
+func TestStageTest_Pipeline_Template(t *testing.T) {
+	staging := &deploy.AssociatedEnvironment{
+		Name:      "staging-test",
+		Region:    "us-west-2",
+		AccountID: "1111",
+	}
+	ps := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
+		AppName: "phonetool",
+		Name:    "phonetool-pipeline",
+		Source: &deploy.CodeCommitSource{
+			ProviderName:  manifest.CodeCommitProviderName,
+			RepositoryURL: "https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/aws-sample/browse",
+			Branch:        "main",
+		},
+		Build: deploy.PipelineBuildFromManifest(nil),
+		Stages: []deploy.PipelineStage{
+			{
+				Name:             "staging-test",
+				Environments:     []*deploy.AssociatedEnvironment{staging},
+				LocalWorkloads:   []string{"api"},
+				RequiresApproval: false,
+				Test: &deploy.PipelineTest{
+					Image:     "aws/codebuild/standard:5.0",
+					Buildspec: "copilot/tests/buildspec.yml",
+					Variables: map[string]string{
+						"API_ENDPOINT": "http://api.staging-test.phonetool.local:8080",
+					},
+					Secrets: map[string]string{
+						"DB_PASSWORD": "arn:aws:secretsmanager:us-west-2:1111:secret:phonetool-db-AbCdEf:password::",
+						"API_TOKEN":   "/copilot/phonetool/staging-test/secrets/api-token",
+					},
+					Environment: staging,
+				},
+			},
+			{
+				Name: "prod",
+				Environments: []*deploy.AssociatedEnvironment{
+					{
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "2222",
+					},
+				},
+				LocalWorkloads:   []string{"api"},
+				RequiresApproval: true,
+				Approval: &deploy.PipelineApproval{
+					TopicARN:         "arn:aws:sns:us-west-2:1111:phonetool-releases",
+					TimeoutInMinutes: 60,
+				},
+			},
+		},
+		ArtifactBuckets: []deploy.ArtifactBucket{
+			{
+				BucketName: "fancy-bucket",
+				KeyArn:     "arn:aws:kms:us-west-2:1111:key/abcd",
+			},
+			{
+				BucketName: "other-bucket",
+				KeyArn:     "arn:aws:kms:us-east-1:1111:key/efgh",
+			},
+		},
+		AdditionalTags: nil,
+	})
+
+	actual, err := ps.Template()
+	require.NoError(t, err, "template should have rendered successfully")
+	actualInBytes := []byte(actual)
+	m1 := make(map[interface{}]interface{})
+	require.NoError(t, yaml.Unmarshal(actualInBytes, m1))
+
+	wanted, err := ioutil.ReadFile(filepath.Join("testdata", "pipeline", "stage_test_template.yaml"))
+	require.NoError(t, err, "should be able to read expected template file")
+	wantedInBytes := []byte(wanted)
+	m2 := make(map[interface{}]interface{})
+	require.NoError(t, yaml.Unmarshal(wantedInBytes, m2))
+
+	require.Equal(t, m2, m1)
+}
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"hash/crc32"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
@@ -55,11 +57,25 @@ const (
 	delayMaxValueSeconds     = 900
 	timeoutMinValueSeconds   = 0
 	timeoutMaxValueSeconds   = 43200
+	bakeTimeMaxValueMinutes  = 1440
+)
+
+// Canary traffic options.
+const (
+	canaryPercentMinValue = 1
+	canaryPercentMaxValue = 99
 )
 
 var (
-	errEphemeralBadSize  = errors.New("ephemeral storage must be between 20 GiB and 200 GiB")
-	errInvalidSpotConfig = errors.New(`"count.spot" and "count.range" cannot be specified together`)
+	errEphemeralBadSize         = errors.New("ephemeral storage must be between 20 GiB and 200 GiB")
+	errInvalidSpotConfig        = errors.New(`"count.spot" and "count.range" cannot be specified together`)
+	errQueueDelayOnlyForWorkers = errors.New(`"count.queue_delay" can only be specified for Worker Services`)
+	errHTTPWithoutPort          = errors.New(`"image.port" must be specified to route requests with "http"`)
+	errAliasForInternalHTTP     = errors.New(`"http.alias" is not supported for Backend Services`)
+	errNLBWithoutPort           = errors.New(`"nlb.port" must be specified`)
+	errNLBTLSWithoutCertificate = errors.New(`"nlb.certificate" must be specified for TLS listeners`)
+	errNLBCertificateWithoutTLS = errors.New(`"nlb.certificate" can only be specified for TLS listeners`)
+	errNLBUDPWithoutHealthCheck = errors.New(`"nlb.healthcheck.port" must be specified for UDP listeners since health checks are performed over TCP`)
 
 	taskDefOverrideRulePrefixes      = []string{"Resources", "TaskDefinition", "Properties"}
 	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
@@ -280,6 +296,15 @@ func convertAutoscaling(a *manifest.AdvancedCount) (*template.AutoscalingOpts, e
 		responseTime := float64(*a.ResponseTime) / float64(time.Second)
 		autoscalingOpts.ResponseTime = aws.Float64(responseTime)
 	}
+	if a.QueueScaling != nil {
+		backlog, err := a.QueueScaling.AcceptableBacklogPerTask()
+		if err != nil {
+			return nil, fmt.Errorf(`convert "queue_delay": %w`, err)
+		}
+		autoscalingOpts.QueueDelay = &template.AutoscalingQueueDelayOpts{
+			AcceptableBacklogPerTask: backlog,
+		}
+	}
 	return &autoscalingOpts, nil
 }
 
@@ -311,6 +336,82 @@ func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPH
 	return opts
 }
 
+// convertNLB returns the network load balancer configuration of a service, or nil if it's not configured.
+func convertNLB(nlb *manifest.NetworkLoadBalancerConfiguration, svcName string, svcPort *uint16, sidecars map[string]*manifest.SidecarConfig) (*template.NetworkLoadBalancer, error) {
+	if nlb.IsEmpty() {
+		return nil, nil
+	}
+	if nlb.Port == nil {
+		return nil, errNLBWithoutPort
+	}
+	protocol := manifest.NLBProtocolTCP
+	if nlb.Protocol != nil {
+		protocol = strings.ToUpper(aws.StringValue(nlb.Protocol))
+	}
+	switch protocol {
+	case manifest.NLBProtocolTCP, manifest.NLBProtocolUDP, manifest.NLBProtocolTLS:
+	default:
+		return nil, fmt.Errorf(`"nlb.protocol" %s must be one of %s`, aws.StringValue(nlb.Protocol), strings.Join(manifest.NLBProtocols, ", "))
+	}
+	certificate := aws.StringValue(nlb.Certificate)
+	if protocol == manifest.NLBProtocolTLS && certificate == "" {
+		return nil, errNLBTLSWithoutCertificate
+	}
+	if protocol != manifest.NLBProtocolTLS && certificate != "" {
+		return nil, errNLBCertificateWithoutTLS
+	}
+	if protocol == manifest.NLBProtocolUDP && nlb.HealthCheck.Port == nil {
+		return nil, errNLBUDPWithoutHealthCheck
+	}
+
+	opts := &template.NetworkLoadBalancer{
+		Port:           strconv.FormatUint(uint64(aws.Uint16Value(nlb.Port)), 10),
+		Protocol:       protocol,
+		CertificateARN: certificate,
+		HealthCheck: template.NLBHealthCheck{
+			HealthyThreshold:   nlb.HealthCheck.HealthyThreshold,
+			UnhealthyThreshold: nlb.HealthCheck.UnhealthyThreshold,
+		},
+	}
+	if nlb.HealthCheck.Port != nil {
+		opts.HealthCheck.Port = strconv.FormatUint(uint64(aws.Uint16Value(nlb.HealthCheck.Port)), 10)
+	}
+	if nlb.HealthCheck.Interval != nil {
+		opts.HealthCheck.Interval = aws.Int64(int64(nlb.HealthCheck.Interval.Seconds()))
+	}
+	if nlb.HealthCheck.Timeout != nil {
+		opts.HealthCheck.Timeout = aws.Int64(int64(nlb.HealthCheck.Timeout.Seconds()))
+	}
+
+	targetContainer := aws.StringValue(nlb.TargetContainer)
+	if targetContainer == "" || targetContainer == svcName {
+		if svcPort == nil {
+			return nil, errors.New(`"image.port" must be specified to route traffic from "nlb" to the main container`)
+		}
+		opts.TargetContainer = svcName
+		opts.TargetPort = strconv.FormatUint(uint64(aws.Uint16Value(svcPort)), 10)
+		opts.UDPOnMainContainer = protocol == manifest.NLBProtocolUDP
+		return opts, nil
+	}
+	sidecar, ok := sidecars[targetContainer]
+	if !ok {
+		return nil, fmt.Errorf("target container %s doesn't exist", targetContainer)
+	}
+	port, sidecarProtocol, err := parsePortMapping(sidecar.Port)
+	if err != nil {
+		return nil, err
+	}
+	if port == nil {
+		return nil, fmt.Errorf("target container %s doesn't expose any port", targetContainer)
+	}
+	if isUDP := strings.EqualFold(aws.StringValue(sidecarProtocol), "udp"); isUDP != (protocol == manifest.NLBProtocolUDP) {
+		return nil, fmt.Errorf("target container %s must expose port %s over %s", targetContainer, aws.StringValue(port), strings.ToLower(opts.TargetProtocol()))
+	}
+	opts.TargetContainer = targetContainer
+	opts.TargetPort = aws.StringValue(port)
+	return opts, nil
+}
+
 func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandOpts {
 	if e.Config.IsEmpty() && !aws.BoolValue(e.Enable) {
 		return nil
@@ -318,6 +419,17 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+// convertPlatform returns the runtime platform of the tasks if they don't run on the default linux/amd64 platform.
+func convertPlatform(p *manifest.PlatformArgsOrString) *template.RuntimePlatformOpts {
+	if p == nil || p.Arch() != dockerengine.Arm64Arch {
+		return nil
+	}
+	return &template.RuntimePlatformOpts{
+		OS:   template.OSLinux,
+		Arch: template.ArchARM64,
+	}
+}
+
 func convertLogging(lc *manifest.Logging) *template.LogConfigOpts {
 	if lc == nil {
 		return nil
@@ -797,6 +909,65 @@ func convertDeadLetter(d *manifest.DeadLetterQueue) (*template.DeadLetterQueue,
 	}, nil
 }
 
+// convertDeployment returns the deployment configuration of a service, or nil if the service uses the default rolling update.
+// Settings that don't apply to the strategy, for example inherited from the manifest when an environment overrides
+// the strategy, are ignored.
+func convertDeployment(d *manifest.DeploymentConfig) (*template.DeploymentOpts, error) {
+	strategy := aws.StringValue(d.Strategy)
+	if strategy == "" && len(d.RollbackAlarms) == 0 && d.CircuitBreaker == nil {
+		return nil, nil
+	}
+	if err := validateDeployment(d); err != nil {
+		return nil, err
+	}
+	opts := &template.DeploymentOpts{
+		Strategy:              template.DeploymentStrategyRolling,
+		RollbackAlarms:        d.RollbackAlarms,
+		DisableCircuitBreaker: d.CircuitBreaker != nil && !aws.BoolValue(d.CircuitBreaker),
+	}
+	if strategy == manifest.RollingDeploymentStrategy || strategy == "" {
+		return opts, nil
+	}
+	bakeTime, err := convertBakeTime(d.BakeTime)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "deployment.bake_time": %w`, err)
+	}
+	opts.Strategy = template.DeploymentStrategyBlueGreen
+	opts.BakeTimeInMinutes = bakeTime
+	if strategy != manifest.CanaryDeploymentStrategy {
+		return opts, nil
+	}
+	canaryBakeTime, err := convertBakeTime(d.Canary.BakeTime)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "deployment.canary.bake_time": %w`, err)
+	}
+	opts.Strategy = template.DeploymentStrategyCanary
+	opts.CanaryPercent = d.Canary.Percent
+	opts.CanaryBakeTimeInMinutes = canaryBakeTime
+	return opts, nil
+}
+
+// convertRollingDeployment is convertDeployment for services without a load balancer to shift traffic with.
+func convertRollingDeployment(d *manifest.DeploymentConfig) (*template.DeploymentOpts, error) {
+	if err := validateRollingDeployment(d); err != nil {
+		return nil, err
+	}
+	return convertDeployment(d)
+}
+
+func convertBakeTime(t *time.Duration) (*int, error) {
+	if t == nil {
+		return nil, nil
+	}
+	if err := validateTime(*t, 0, bakeTimeMaxValueMinutes*time.Minute); err != nil {
+		return nil, err
+	}
+	if *t%time.Minute != 0 {
+		return nil, errors.New("must be a whole number of minutes")
+	}
+	return aws.Int(int(t.Minutes())), nil
+}
+
 func parseS3URLs(nameToS3URL map[string]string) (bucket *string, s3ObjectKeys map[string]*string, err error) {
 	if len(nameToS3URL) == 0 {
 		return nil, nil, nil
@@ -467,6 +467,8 @@ func Test_convertAutoscaling(t *testing.T) {
 	badRange := manifest.IntRangeBand("badRange")
 	mockRequests := 1000
 	mockResponseTime := 512 * time.Millisecond
+	mockLatency := 10 * time.Minute
+	mockProcessingTime := 6 * time.Second
 	testCases := map[string]struct {
 		input *manifest.AdvancedCount
 
@@ -532,6 +534,37 @@ func Test_convertAutoscaling(t *testing.T) {
 			},
 			wanted: nil,
 		},
+		"invalid queue delay": {
+			input: &manifest.AdvancedCount{
+				Range: &manifest.Range{
+					Value: &mockRange,
+				},
+				QueueScaling: &manifest.QueueScaling{
+					AcceptableLatency: &mockResponseTime,
+				},
+			},
+
+			wantedErr: fmt.Errorf(`convert "queue_delay": must specify both "acceptable_latency" and "msg_processing_time" for "queue_delay"`),
+		},
+		"success with queue delay": {
+			input: &manifest.AdvancedCount{
+				Range: &manifest.Range{
+					Value: &mockRange,
+				},
+				QueueScaling: &manifest.QueueScaling{
+					AcceptableLatency: &mockLatency,
+					AvgProcessingTime: &mockProcessingTime,
+				},
+			},
+
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				QueueDelay: &template.AutoscalingQueueDelayOpts{
+					AcceptableBacklogPerTask: 100,
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1256,6 +1289,50 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertPlatform(t *testing.T) {
+	testCases := map[string]struct {
+		in *manifest.PlatformArgsOrString
+
+		wanted *template.RuntimePlatformOpts
+	}{
+		"without platform": {
+			wanted: nil,
+		},
+		"default platform": {
+			in: &manifest.PlatformArgsOrString{
+				PlatformString: aws.String("linux/amd64"),
+			},
+			wanted: nil,
+		},
+		"arm64 platform args": {
+			in: &manifest.PlatformArgsOrString{
+				PlatformArgs: manifest.PlatformArgs{
+					OSFamily: aws.String("linux"),
+					Arch:     aws.String("arm64"),
+				},
+			},
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "ARM64",
+			},
+		},
+		"runs on the first platform of the list": {
+			in: &manifest.PlatformArgsOrString{
+				PlatformList: []string{"linux/arm64", "linux/amd64"},
+			},
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "ARM64",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertPlatform(tc.in))
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1725,3 +1802,327 @@ func Test_convertSubscribe(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertDeployment(t *testing.T) {
+	duration90Seconds := 90 * time.Second
+	duration5Minutes := 5 * time.Minute
+	duration10Minutes := 10 * time.Minute
+	duration25Hours := 25 * time.Hour
+	testCases := map[string]struct {
+		in manifest.DeploymentConfig
+
+		wanted      *template.DeploymentOpts
+		wantedError error
+	}{
+		"returns nil for the default rolling update": {
+			in:     manifest.DeploymentConfig{},
+			wanted: nil,
+		},
+		"errors on an unknown strategy": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("recreate"),
+			},
+			wantedError: errInvalidDeploymentStrategy,
+		},
+		"ignores settings that don't apply to the strategy": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("blue_green"),
+				BakeTime: &duration5Minutes,
+				Canary: manifest.CanaryConfig{
+					Percent: aws.Int(10),
+				},
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy:          template.DeploymentStrategyBlueGreen,
+				BakeTimeInMinutes: aws.Int(5),
+			},
+		},
+		"errors if the canary strategy has no percent": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("canary"),
+			},
+			wantedError: errCanaryWithoutPercent,
+		},
+		"errors if the canary percent is out of range": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("canary"),
+				Canary: manifest.CanaryConfig{
+					Percent: aws.Int(100),
+				},
+			},
+			wantedError: errInvalidCanaryPercent,
+		},
+		"errors if the bake time is not a whole number of minutes": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("blue_green"),
+				BakeTime: &duration90Seconds,
+			},
+			wantedError: fmt.Errorf(`convert "deployment.bake_time": must be a whole number of minutes`),
+		},
+		"errors if the canary bake time is too long": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("canary"),
+				Canary: manifest.CanaryConfig{
+					Percent:  aws.Int(10),
+					BakeTime: &duration25Hours,
+				},
+			},
+			wantedError: fmt.Errorf(`convert "deployment.canary.bake_time": must be between 0s and 24h0m0s`),
+		},
+		"rolling update with rollback alarms": {
+			in: manifest.DeploymentConfig{
+				RollbackAlarms: []string{"frontend-5xx"},
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy:       template.DeploymentStrategyRolling,
+				RollbackAlarms: []string{"frontend-5xx"},
+			},
+		},
+		"rolling update without the circuit breaker": {
+			in: manifest.DeploymentConfig{
+				CircuitBreaker: aws.Bool(false),
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy:              template.DeploymentStrategyRolling,
+				DisableCircuitBreaker: true,
+			},
+		},
+		"rolling update with the circuit breaker explicitly enabled": {
+			in: manifest.DeploymentConfig{
+				CircuitBreaker: aws.Bool(true),
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy: template.DeploymentStrategyRolling,
+			},
+		},
+		"blue/green deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("blue_green"),
+				BakeTime: &duration10Minutes,
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy:          template.DeploymentStrategyBlueGreen,
+				BakeTimeInMinutes: aws.Int(10),
+			},
+		},
+		"canary deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("canary"),
+				BakeTime: &duration10Minutes,
+				Canary: manifest.CanaryConfig{
+					Percent:  aws.Int(20),
+					BakeTime: &duration5Minutes,
+				},
+				RollbackAlarms: []string{"frontend-5xx", "frontend-latency"},
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy:                template.DeploymentStrategyCanary,
+				BakeTimeInMinutes:       aws.Int(10),
+				CanaryPercent:           aws.Int(20),
+				CanaryBakeTimeInMinutes: aws.Int(5),
+				RollbackAlarms:          []string{"frontend-5xx", "frontend-latency"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertDeployment(&tc.in)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertRollingDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.DeploymentConfig
+
+		wanted      *template.DeploymentOpts
+		wantedError error
+	}{
+		"errors if the strategy shifts traffic": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("blue_green"),
+			},
+			wantedError: errTrafficShiftingWithoutLB,
+		},
+		"rolling update without the circuit breaker": {
+			in: manifest.DeploymentConfig{
+				Strategy:       aws.String("rolling"),
+				CircuitBreaker: aws.Bool(false),
+			},
+			wanted: &template.DeploymentOpts{
+				Strategy:              template.DeploymentStrategyRolling,
+				DisableCircuitBreaker: true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertRollingDeployment(&tc.in)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertNLB(t *testing.T) {
+	duration10Seconds := 10 * time.Second
+	sidecars := map[string]*manifest.SidecarConfig{
+		"envoy": {
+			Port: aws.String("9090"),
+		},
+		"game": {
+			Port: aws.String("27015/udp"),
+		},
+		"logs": {},
+	}
+	testCases := map[string]struct {
+		in      manifest.NetworkLoadBalancerConfiguration
+		inPort  *uint16
+		wanted  *template.NetworkLoadBalancer
+		wantErr string
+	}{
+		"returns nil if the network load balancer is not configured": {
+			inPort: aws.Uint16(80),
+		},
+		"errors without a port": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Protocol: aws.String("tcp"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: errNLBWithoutPort.Error(),
+		},
+		"errors on an unknown protocol": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:     aws.Uint16(443),
+				Protocol: aws.String("http"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: `"nlb.protocol" http must be one of TCP, UDP, TLS`,
+		},
+		"errors on a TLS listener without a certificate": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:     aws.Uint16(443),
+				Protocol: aws.String("tls"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: errNLBTLSWithoutCertificate.Error(),
+		},
+		"errors on a certificate without a TLS listener": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:        aws.Uint16(443),
+				Certificate: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: errNLBCertificateWithoutTLS.Error(),
+		},
+		"errors on a UDP listener without a health check port": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:     aws.Uint16(27015),
+				Protocol: aws.String("udp"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: errNLBUDPWithoutHealthCheck.Error(),
+		},
+		"errors if the target container doesn't exist": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.Uint16(443),
+				TargetContainer: aws.String("nginx"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: "target container nginx doesn't exist",
+		},
+		"errors if the target container doesn't expose a port": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.Uint16(443),
+				TargetContainer: aws.String("logs"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: "target container logs doesn't expose any port",
+		},
+		"errors if the target container port protocol doesn't match the listener": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.Uint16(443),
+				TargetContainer: aws.String("game"),
+			},
+			inPort:  aws.Uint16(80),
+			wantErr: "target container game must expose port 27015 over tcp",
+		},
+		"routes TCP traffic to the main container by default": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.Uint16(443),
+				HealthCheck: manifest.NLBHealthCheckArgs{
+					HealthyThreshold: aws.Int64(3),
+					Interval:         &duration10Seconds,
+				},
+			},
+			inPort: aws.Uint16(80),
+			wanted: &template.NetworkLoadBalancer{
+				Port:            "443",
+				Protocol:        "TCP",
+				TargetContainer: "frontend",
+				TargetPort:      "80",
+				HealthCheck: template.NLBHealthCheck{
+					HealthyThreshold: aws.Int64(3),
+					Interval:         aws.Int64(10),
+				},
+			},
+		},
+		"routes UDP traffic to the main container": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:     aws.Uint16(27015),
+				Protocol: aws.String("udp"),
+				HealthCheck: manifest.NLBHealthCheckArgs{
+					Port: aws.Uint16(80),
+				},
+			},
+			inPort: aws.Uint16(27015),
+			wanted: &template.NetworkLoadBalancer{
+				Port:               "27015",
+				Protocol:           "UDP",
+				TargetContainer:    "frontend",
+				TargetPort:         "27015",
+				UDPOnMainContainer: true,
+				HealthCheck: template.NLBHealthCheck{
+					Port: "80",
+				},
+			},
+		},
+		"routes TLS traffic to a sidecar": {
+			in: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.Uint16(443),
+				Protocol:        aws.String("TLS"),
+				Certificate:     aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"),
+				TargetContainer: aws.String("envoy"),
+			},
+			inPort: aws.Uint16(80),
+			wanted: &template.NetworkLoadBalancer{
+				Port:            "443",
+				Protocol:        "TLS",
+				CertificateARN:  "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+				TargetContainer: "envoy",
+				TargetPort:      "9090",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertNLB(&tc.in, "frontend", tc.inPort, sidecars)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
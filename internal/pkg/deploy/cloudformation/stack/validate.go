@@ -48,6 +48,11 @@ var (
 	errInvalidSvcName                = errors.New("service names cannot be empty")
 	errSvcNameTooLong                = errors.New("service names must not exceed 255 characters")
 	errSvcNameBadFormat              = errors.New("service names must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
+	errInvalidDeploymentStrategy     = fmt.Errorf("`deployment.strategy` must be one of < %s | %s | %s >", manifest.RollingDeploymentStrategy, manifest.BlueGreenDeploymentStrategy, manifest.CanaryDeploymentStrategy)
+	errCanaryWithoutPercent          = errors.New("`deployment.canary.percent` must be specified with the canary strategy")
+	errInvalidCanaryPercent          = fmt.Errorf("`deployment.canary.percent` must be between %d and %d", canaryPercentMinValue, canaryPercentMaxValue)
+	errTrafficShiftingWithoutLB      = fmt.Errorf("`deployment.strategy` must be %s for services without a load balancer", manifest.RollingDeploymentStrategy)
+	errJobTopicQueue                 = errors.New("`on.topics[].queue` is not supported for jobs")
 )
 
 // Container dependency status options.
@@ -491,6 +496,32 @@ func validateTime(t, floor, ceiling time.Duration) error {
 	return nil
 }
 
+func validateDeployment(d *manifest.DeploymentConfig) error {
+	switch aws.StringValue(d.Strategy) {
+	case "", manifest.RollingDeploymentStrategy, manifest.BlueGreenDeploymentStrategy:
+		return nil
+	case manifest.CanaryDeploymentStrategy:
+		if d.Canary.Percent == nil {
+			return errCanaryWithoutPercent
+		}
+		if p := aws.IntValue(d.Canary.Percent); p < canaryPercentMinValue || p > canaryPercentMaxValue {
+			return errInvalidCanaryPercent
+		}
+		return nil
+	default:
+		return errInvalidDeploymentStrategy
+	}
+}
+
+func validateRollingDeployment(d *manifest.DeploymentConfig) error {
+	switch aws.StringValue(d.Strategy) {
+	case "", manifest.RollingDeploymentStrategy:
+		return nil
+	default:
+		return errTrafficShiftingWithoutLB
+	}
+}
+
 func validateDeadLetter(dl *manifest.DeadLetterQueue) error {
 	if aws.Uint16Value(dl.Tries) > uint16(deadLetterTriesMaxValue) {
 		return errDeadLetterQueueTries
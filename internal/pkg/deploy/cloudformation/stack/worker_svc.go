@@ -97,6 +97,14 @@ func (s *WorkerService) Template() (string, error) {
 		desiredCountOnSpot = advancedCount.Spot
 		capacityProviders = advancedCount.Cps
 	}
+	var backlogPerTaskCalculatorLambda string
+	if autoscaling != nil && autoscaling.QueueDelay != nil {
+		lambda, err := s.parser.Read(backlogPerTaskCalculatorPath)
+		if err != nil {
+			return "", fmt.Errorf("read backlog per task calculator lambda: %w", err)
+		}
+		backlogPerTaskCalculatorLambda = lambda.String()
+	}
 	storage, err := convertStorageOpts(s.manifest.Name, s.manifest.Storage)
 	if err != nil {
 		return "", fmt.Errorf("convert storage options for service %s: %w", s.name, err)
@@ -113,6 +121,10 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	deployment, err := convertRollingDeployment(&s.manifest.Deployment)
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseWorkerService(template.WorkloadOpts{
 		Variables:                s.manifest.WorkerServiceConfig.Variables,
 		Secrets:                  s.manifest.WorkerServiceConfig.Secrets,
@@ -122,12 +134,14 @@ func (s *WorkerService) Template() (string, error) {
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		Platform:                 convertPlatform(s.manifest.Platform),
 		WorkloadType:             manifest.WorkerServiceType,
 		HealthCheck:              s.manifest.WorkerServiceConfig.ImageConfig.HealthCheckOpts(),
 		LogConfig:                convertLogging(s.manifest.Logging),
 		DockerLabels:             s.manifest.ImageConfig.DockerLabels,
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
+		BacklogCalculatorLambda:  backlogPerTaskCalculatorLambda,
 		Storage:                  storage,
 		Network:                  convertNetworkConfig(s.manifest.Network),
 		EntryPoint:               entrypoint,
@@ -136,6 +150,7 @@ func (s *WorkerService) Template() (string, error) {
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Subscribe:                subscribe,
+		Deployment:               deployment,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse worker service template: %w", err)
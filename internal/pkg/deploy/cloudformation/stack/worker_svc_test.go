@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -148,6 +149,40 @@ Outputs:
 			},
 			wantedErr: fmt.Errorf("convert the advanced count configuration for service frontend: %w", errors.New("invalid range value badRange. Should be in format of ${min}-${max}")),
 		},
+		"unavailable backlog per task calculator lambda template": {
+			setUpManifest: func(svc *WorkerService) {
+				mft := manifest.NewWorkerService(baseProps)
+				mockRange := manifest.IntRangeBand("1-10")
+				latency, processingTime := 10*time.Minute, 5*time.Second
+				mft.Count.AdvancedCount = manifest.AdvancedCount{
+					Range: &manifest.Range{
+						Value: &mockRange,
+					},
+					QueueScaling: &manifest.QueueScaling{
+						AcceptableLatency: &latency,
+						AvgProcessingTime: &processingTime,
+					},
+				}
+				svc.manifest = mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(backlogPerTaskCalculatorPath).Return(nil, errors.New("some error"))
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `
+Resources:
+  AdditionalResourcesPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf("read backlog per task calculator lambda: some error"),
+		},
 		"failed parsing svc template": {
 			setUpManifest: func(svc *WorkerService) {
 				svc.manifest = manifest.NewWorkerService(baseProps)
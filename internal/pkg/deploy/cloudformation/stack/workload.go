@@ -40,6 +40,8 @@ const (
 	WorkloadTaskMemoryParamKey   = "TaskMemory"
 	WorkloadTaskCountParamKey    = "TaskCount"
 	WorkloadLogRetentionParamKey = "LogRetention"
+	WorkloadDeployedByParamKey   = "DeployedBy"
+	WorkloadImageDigestParamKey  = "ImageDigest"
 )
 
 // Parameter logical IDs for workloads on App Runner.
@@ -75,6 +77,8 @@ type RuntimeConfig struct {
 	ServiceDiscoveryEndpoint string            // Endpoint for the service discovery namespace in the environment.
 	AccountID                string            // Account ID for constructing ARNs
 	Region                   string            // Region for constructing ARNs
+	DeployedBy               string            // Optional. ARN of the principal deploying the workload.
+	TrafficWeights           *TrafficWeights   // Optional. Weights that the deployed load balanced web service forwards requests to its target groups with.
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -273,7 +277,7 @@ func (w *ecsWkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
-	return append(wkldParameters, []*cloudformation.Parameter{
+	params := append(wkldParameters, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadTaskCPUParamKey),
 			ParameterValue: aws.String(strconv.Itoa(aws.IntValue(w.tc.CPU))),
@@ -290,7 +294,20 @@ func (w *ecsWkld) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
 			ParameterValue: aws.String("30"),
 		},
-	}...), nil
+	}...)
+	if w.rc.DeployedBy != "" {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(WorkloadDeployedByParamKey),
+			ParameterValue: aws.String(w.rc.DeployedBy),
+		})
+	}
+	if w.rc.Image != nil && w.rc.Image.Digest != "" {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(WorkloadImageDigestParamKey),
+			ParameterValue: aws.String(w.rc.Image.Digest),
+		})
+	}
+	return params, nil
 }
 
 type appRunnerWkld struct {
@@ -6,6 +6,9 @@ package stack
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +47,119 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestEcsWkld_ParametersDeployedBy(t *testing.T) {
+	testCases := map[string]struct {
+		inDeployedBy string
+
+		wantedParam *cloudformation.Parameter
+	}{
+		"omits the parameter if the principal is unknown": {},
+		"records the principal deploying the workload": {
+			inDeployedBy: "arn:aws:sts::123456789012:assumed-role/Admin/jane",
+			wantedParam: &cloudformation.Parameter{
+				ParameterKey:   aws.String(WorkloadDeployedByParamKey),
+				ParameterValue: aws.String("arn:aws:sts::123456789012:assumed-role/Admin/jane"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &ecsWkld{
+				wkld: &wkld{
+					name: "frontend",
+					env:  "test",
+					app:  "phonetool",
+					rc: RuntimeConfig{
+						DeployedBy: tc.inDeployedBy,
+					},
+				},
+				tc: manifest.TaskConfig{
+					CPU:    aws.Int(256),
+					Memory: aws.Int(512),
+					Count: manifest.Count{
+						Value: aws.Int(1),
+					},
+				},
+			}
+
+			// WHEN
+			params, err := w.Parameters()
+
+			// THEN
+			require.NoError(t, err)
+			var got *cloudformation.Parameter
+			for _, param := range params {
+				if aws.StringValue(param.ParameterKey) == WorkloadDeployedByParamKey {
+					got = param
+				}
+			}
+			require.Equal(t, tc.wantedParam, got)
+		})
+	}
+}
+
+func TestEcsWkld_ParametersImageDigest(t *testing.T) {
+	testCases := map[string]struct {
+		inImage *ECRImage
+
+		wantedParam *cloudformation.Parameter
+	}{
+		"omits the parameter if the image is not built": {},
+		"omits the parameter if the digest is unknown": {
+			inImage: &ECRImage{
+				RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				ImageTag: "v1",
+			},
+		},
+		"records the digest of the pushed image": {
+			inImage: &ECRImage{
+				RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				ImageTag: "v1",
+				Digest:   "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+			},
+			wantedParam: &cloudformation.Parameter{
+				ParameterKey:   aws.String(WorkloadImageDigestParamKey),
+				ParameterValue: aws.String("sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &ecsWkld{
+				wkld: &wkld{
+					name: "frontend",
+					env:  "test",
+					app:  "phonetool",
+					rc: RuntimeConfig{
+						Image: tc.inImage,
+					},
+				},
+				tc: manifest.TaskConfig{
+					CPU:    aws.Int(256),
+					Memory: aws.Int(512),
+					Count: manifest.Count{
+						Value: aws.Int(1),
+					},
+				},
+			}
+
+			// WHEN
+			params, err := w.Parameters()
+
+			// THEN
+			require.NoError(t, err)
+			var got *cloudformation.Parameter
+			for _, param := range params {
+				if aws.StringValue(param.ParameterKey) == WorkloadImageDigestParamKey {
+					got = param
+				}
+			}
+			require.Equal(t, tc.wantedParam, got)
+		})
+	}
+}
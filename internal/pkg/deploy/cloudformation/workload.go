@@ -4,10 +4,15 @@
 package cloudformation
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 )
 
@@ -25,6 +30,181 @@ func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfig
 	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
 }
 
+// DiffService creates a change set for the service stack without executing it, and returns the
+// resource and parameter changes between the deployed stack and the proposed one.
+// The change set must then be either executed with ExecuteStackDiff or discarded with DiscardStackDiff.
+func (cf CloudFormation) DiffService(out progress.FileWriter, conf StackConfiguration, opts ...cloudformation.StackOption) (*StackDiff, error) {
+	return cf.diffService(out, conf, false, opts...)
+}
+
+// PreviewService is like DiffService for a change set that is only described and then discarded with DiscardStackDiff.
+// It leaves the deployed stack untouched: a stack that failed to be created is not deleted to be re-created.
+func (cf CloudFormation) PreviewService(out progress.FileWriter, conf StackConfiguration, opts ...cloudformation.StackOption) (*StackDiff, error) {
+	return cf.diffService(out, conf, true, opts...)
+}
+
+func (cf CloudFormation) diffService(out progress.FileWriter, conf StackConfiguration, preview bool, opts ...cloudformation.StackOption) (_ *StackDiff, err error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	diff := &StackDiff{
+		StackName: stack.Name,
+	}
+	var deployedParams []*sdkcloudformation.Parameter
+	descr, err := cf.cfnClient.Describe(stack.Name)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return nil, err
+		}
+		diff.isNewStack = true
+	} else {
+		switch status := aws.StringValue(descr.StackStatus); status {
+		case sdkcloudformation.StackStatusRollbackComplete, sdkcloudformation.StackStatusRollbackFailed:
+			if preview {
+				return nil, fmt.Errorf("stack %s is in %s state and must be deleted before its changes can be previewed", stack.Name, status)
+			}
+			// The stack failed to be created and gets re-created by the change set.
+			diff.isNewStack = true
+		default:
+			deployedParams = descr.Parameters
+		}
+	}
+
+	if diff.isNewStack {
+		// There are no previous values for a stack that's created, the parameters get their default values instead.
+		stack.Parameters = withoutPreviousValues(stack.Parameters)
+	}
+	spinner := progress.NewSpinner(out)
+	label := fmt.Sprintf("Proposing infrastructure changes for stack %s", stack.Name)
+	spinner.Start(label)
+	changeSetID, err := cf.cfnClient.CreateChangeSet(stack)
+	if err != nil {
+		msg := log.Serrorf("%s\n", label)
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errChangeSetEmpty) {
+			msg = fmt.Sprintf("- No new infrastructure changes for stack %s\n", stack.Name)
+		}
+		spinner.Stop(msg)
+		return nil, err
+	}
+	spinner.Stop(log.Ssuccessf("%s\n", label))
+	diff.ChangeSetID = changeSetID
+	defer func() {
+		if err == nil {
+			return
+		}
+		// The change set is never reviewed, so it's deleted instead of being left behind.
+		if discardErr := cf.DiscardStackDiff(diff); discardErr != nil {
+			err = fmt.Errorf("%w: delete change set %s: %v", err, changeSetID, discardErr)
+		}
+	}()
+
+	changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	diff.Resources = newResourceDiffs(changeSet.Changes)
+	diff.Parameters = newParameterDiffs(deployedParams, stack.Parameters)
+	return diff, nil
+}
+
+func withoutPreviousValues(params []*sdkcloudformation.Parameter) []*sdkcloudformation.Parameter {
+	var out []*sdkcloudformation.Parameter
+	for _, param := range params {
+		if aws.BoolValue(param.UsePreviousValue) {
+			continue
+		}
+		out = append(out, param)
+	}
+	return out
+}
+
+// ExecuteStackDiff executes the change set of a diff returned by DiffService and renders progress updates to out
+// until the deployment is done.
+func (cf CloudFormation) ExecuteStackDiff(out progress.FileWriter, diff *StackDiff) error {
+	description := fmt.Sprintf("Updating the infrastructure for stack %s", diff.StackName)
+	if diff.isNewStack {
+		description = fmt.Sprintf("Creating the infrastructure for stack %s", diff.StackName)
+	}
+	return cf.renderStackChanges(&renderStackChangesInput{
+		w:                out,
+		stackName:        diff.StackName,
+		stackDescription: description,
+		createChangeSet: func() (string, error) {
+			if err := cf.cfnClient.ExecuteChangeSet(diff.ChangeSetID, diff.StackName); err != nil {
+				return "", cf.handleStackError(diff.StackName, err)
+			}
+			return diff.ChangeSetID, nil
+		},
+	})
+}
+
+// DiscardStackDiff deletes the change set of a diff returned by DiffService without executing it.
+func (cf CloudFormation) DiscardStackDiff(diff *StackDiff) error {
+	if diff.isNewStack {
+		// A change set that creates a stack leaves behind an empty stack in REVIEW_IN_PROGRESS status.
+		// Deleting the stack also deletes its change sets.
+		return cf.cfnClient.DeleteAndWait(diff.StackName)
+	}
+	return cf.cfnClient.DeleteChangeSet(diff.ChangeSetID, diff.StackName)
+}
+
+// Logical IDs of the resources of a load balanced web service that shifts traffic between target groups.
+const (
+	lbWebSvcTargetGroupLogicalID          = "TargetGroup"
+	lbWebSvcAlternateTargetGroupLogicalID = "AlternateTargetGroup"
+	lbWebSvcHTTPListenerRuleLogicalID     = "HTTPListenerRule"
+	lbWebSvcHTTPSListenerRuleLogicalID    = "HTTPSListenerRule"
+)
+
+// TrafficWeights returns the weights that the listener rule of a deployed load balanced web service forwards requests
+// to its target groups with, since ECS changes them outside of CloudFormation when it shifts traffic.
+// It returns nil if the service isn't deployed with an alternate target group.
+func (cf CloudFormation) TrafficWeights(stackName string) (*stack.TrafficWeights, error) {
+	if _, err := cf.cfnClient.Describe(stackName); err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return nil, err
+	}
+	physicalIDs := make(map[string]string, len(resources))
+	for _, r := range resources {
+		physicalIDs[aws.StringValue(r.LogicalResourceId)] = aws.StringValue(r.PhysicalResourceId)
+	}
+	ruleARN := physicalIDs[lbWebSvcHTTPSListenerRuleLogicalID]
+	if ruleARN == "" {
+		ruleARN = physicalIDs[lbWebSvcHTTPListenerRuleLogicalID]
+	}
+	tgARN, altTGARN := physicalIDs[lbWebSvcTargetGroupLogicalID], physicalIDs[lbWebSvcAlternateTargetGroupLogicalID]
+	if ruleARN == "" || altTGARN == "" {
+		return nil, nil
+	}
+	tgWeights, err := cf.elbv2Client.TargetGroupWeights(ruleARN)
+	if err != nil {
+		return nil, err
+	}
+	var weights stack.TrafficWeights
+	for _, w := range tgWeights {
+		switch w.TargetGroupARN {
+		case tgARN:
+			weights.TargetGroup = w.Weight
+		case altTGARN:
+			weights.AlternateTargetGroup = w.Weight
+		}
+	}
+	return &weights, nil
+}
+
 func (cf CloudFormation) handleStackError(stackName string, err error) error {
 	if err == nil {
 		return nil
@@ -4,12 +4,17 @@
 package cloudformation
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -133,3 +138,387 @@ func TestCloudFormation_DeleteWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_DiffService(t *testing.T) {
+	serviceConfig := &mockStackConfig{
+		name:     "myapp-myenv-mysvc",
+		template: "template",
+		parameters: map[string]string{
+			"ContainerImage": "image:v2",
+		},
+	}
+	testCases := map[string]struct {
+		inPreview  bool
+		inOpts     []cloudformation.StackOption
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedDiff *StackDiff
+		wantedErr  error
+	}{
+		"returns an error if the stack cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns ErrChangeSetEmpty if there are no changes": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("", cloudformation.NewMockErrChangeSetEmpty())
+				return m
+			},
+			wantedErr: cloudformation.NewMockErrChangeSetEmpty(),
+		},
+		"deletes the change set if it cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(nil, errors.New("some error"))
+				m.EXPECT().DeleteChangeSet("1234", "myapp-myenv-mysvc").Return(nil)
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"deletes the stack of a new stack if the change set cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(nil, errors.New("some error"))
+				m.EXPECT().DeleteAndWait("myapp-myenv-mysvc").Return(nil)
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns both errors if the change set cannot be deleted": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(nil, errors.New("some error"))
+				m.EXPECT().DeleteChangeSet("1234", "myapp-myenv-mysvc").Return(errors.New("access denied"))
+				return m
+			},
+			wantedErr: errors.New("some error: delete change set 1234: access denied"),
+		},
+		"returns the resource and parameter changes for an existing stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateComplete),
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("image:v1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(&cloudformation.ChangeSetDescription{
+					Changes: []*sdkcloudformation.Change{
+						{
+							ResourceChange: &sdkcloudformation.ResourceChange{
+								Action:            aws.String(sdkcloudformation.ChangeActionModify),
+								LogicalResourceId: aws.String("TaskDefinition"),
+								ResourceType:      aws.String("AWS::ECS::TaskDefinition"),
+								Replacement:       aws.String(sdkcloudformation.ReplacementTrue),
+							},
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedDiff: &StackDiff{
+				StackName:   "myapp-myenv-mysvc",
+				ChangeSetID: "1234",
+				Resources: []ResourceDiff{
+					{
+						Action:    ResourceActionReplace,
+						LogicalID: "TaskDefinition",
+						Type:      "AWS::ECS::TaskDefinition",
+					},
+				},
+				Parameters: []ParameterDiff{
+					{
+						Key:      "ContainerImage",
+						Previous: aws.String("image:v1"),
+						Proposed: aws.String("image:v2"),
+					},
+				},
+			},
+		},
+		"preview returns an error instead of deleting a stack that failed to be created": {
+			inPreview: true,
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusRollbackComplete),
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: errors.New("stack myapp-myenv-mysvc is in ROLLBACK_COMPLETE state and must be deleted before its changes can be previewed"),
+		},
+		"keeps the deployed value of a parameter that uses its previous value": {
+			inPreview: true,
+			inOpts:    []cloudformation.StackOption{cloudformation.WithPreviousParameterValue("ContainerImage")},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateComplete),
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("image:v1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (string, error) {
+					require.Equal(t, []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:     aws.String("ContainerImage"),
+							UsePreviousValue: aws.Bool(true),
+						},
+					}, stack.Parameters)
+					return "1234", nil
+				})
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(&cloudformation.ChangeSetDescription{}, nil)
+				return m
+			},
+			wantedDiff: &StackDiff{
+				StackName:   "myapp-myenv-mysvc",
+				ChangeSetID: "1234",
+			},
+		},
+		"drops the parameters that use their previous value from a new stack": {
+			inOpts: []cloudformation.StackOption{cloudformation.WithPreviousParameterValue("ContainerImage")},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (string, error) {
+					require.Empty(t, stack.Parameters)
+					return "1234", nil
+				})
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(&cloudformation.ChangeSetDescription{}, nil)
+				return m
+			},
+			wantedDiff: &StackDiff{
+				StackName:   "myapp-myenv-mysvc",
+				ChangeSetID: "1234",
+				isNewStack:  true,
+			},
+		},
+		"marks the diff as a new stack if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("myapp-myenv-mysvc").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("1234", nil)
+				m.EXPECT().DescribeChangeSet("1234", "myapp-myenv-mysvc").Return(&cloudformation.ChangeSetDescription{}, nil)
+				return m
+			},
+			wantedDiff: &StackDiff{
+				StackName:   "myapp-myenv-mysvc",
+				ChangeSetID: "1234",
+				Parameters: []ParameterDiff{
+					{
+						Key:      "ContainerImage",
+						Proposed: aws.String("image:v2"),
+					},
+				},
+				isNewStack: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			diffService := c.DiffService
+			if tc.inPreview {
+				diffService = c.PreviewService
+			}
+			diff, err := diffService(mockFileWriter{Writer: new(strings.Builder)}, serviceConfig, tc.inOpts...)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiff, diff)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteStackDiff(t *testing.T) {
+	t.Run("returns a wrapped error if the change set cannot be executed", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		wantedErr := errors.New("some error")
+		m := mocks.NewMockcfnClient(ctrl)
+		m.EXPECT().ExecuteChangeSet("1234", "myapp-myenv-mysvc").Return(wantedErr)
+		m.EXPECT().ErrorEvents("myapp-myenv-mysvc").Return(nil, nil)
+		c := CloudFormation{cfnClient: m}
+
+		// WHEN
+		err := c.ExecuteStackDiff(mockFileWriter{Writer: new(strings.Builder)}, &StackDiff{
+			StackName:   "myapp-myenv-mysvc",
+			ChangeSetID: "1234",
+		})
+
+		// THEN
+		require.True(t, errors.Is(err, wantedErr), `expected returned error to be wrapped with "some error"`)
+	})
+}
+
+func TestCloudFormation_DiscardStackDiff(t *testing.T) {
+	testCases := map[string]struct {
+		inDiff     *StackDiff
+		createMock func(ctrl *gomock.Controller) cfnClient
+	}{
+		"deletes the change set of an existing stack": {
+			inDiff: &StackDiff{
+				StackName:   "myapp-myenv-mysvc",
+				ChangeSetID: "1234",
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DeleteChangeSet("1234", "myapp-myenv-mysvc").Return(nil)
+				return m
+			},
+		},
+		"deletes the stack under review if the change set creates the stack": {
+			inDiff: &StackDiff{
+				StackName:   "myapp-myenv-mysvc",
+				ChangeSetID: "1234",
+				isNewStack:  true,
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DeleteAndWait("myapp-myenv-mysvc").Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.DiscardStackDiff(tc.inDiff)
+
+			// THEN
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_TrafficWeights(t *testing.T) {
+	const (
+		mockTG    = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg/1"
+		mockAltTG = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/alt/2"
+		mockRule  = "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/lb/3/4/5"
+	)
+	resources := func(logicalToPhysicalIDs ...string) []*cloudformation.StackResource {
+		var out []*cloudformation.StackResource
+		for i := 0; i < len(logicalToPhysicalIDs); i += 2 {
+			out = append(out, &cloudformation.StackResource{
+				LogicalResourceId:  aws.String(logicalToPhysicalIDs[i]),
+				PhysicalResourceId: aws.String(logicalToPhysicalIDs[i+1]),
+			})
+		}
+		return out
+	}
+	testCases := map[string]struct {
+		setupMocks func(cfn *mocks.MockcfnClient, lbs *mocks.Mockelbv2Client)
+
+		wanted    *stack.TrafficWeights
+		wantedErr error
+	}{
+		"returns nil if the service is not deployed": {
+			setupMocks: func(cfn *mocks.MockcfnClient, lbs *mocks.Mockelbv2Client) {
+				cfn.EXPECT().Describe("phonetool-test-frontend").Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+		},
+		"returns nil if the service has no alternate target group": {
+			setupMocks: func(cfn *mocks.MockcfnClient, lbs *mocks.Mockelbv2Client) {
+				cfn.EXPECT().Describe("phonetool-test-frontend").Return(&cloudformation.StackDescription{}, nil)
+				cfn.EXPECT().StackResources("phonetool-test-frontend").Return(resources(
+					"TargetGroup", mockTG,
+					"HTTPListenerRule", mockRule,
+				), nil)
+			},
+		},
+		"returns an error if the resources cannot be listed": {
+			setupMocks: func(cfn *mocks.MockcfnClient, lbs *mocks.Mockelbv2Client) {
+				cfn.EXPECT().Describe("phonetool-test-frontend").Return(&cloudformation.StackDescription{}, nil)
+				cfn.EXPECT().StackResources("phonetool-test-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the weights that ECS set on the listener rule": {
+			setupMocks: func(cfn *mocks.MockcfnClient, lbs *mocks.Mockelbv2Client) {
+				cfn.EXPECT().Describe("phonetool-test-frontend").Return(&cloudformation.StackDescription{}, nil)
+				cfn.EXPECT().StackResources("phonetool-test-frontend").Return(resources(
+					"TargetGroup", mockTG,
+					"AlternateTargetGroup", mockAltTG,
+					"HTTPSListenerRule", mockRule,
+				), nil)
+				lbs.EXPECT().TargetGroupWeights(mockRule).Return([]elbv2.TargetGroupWeight{
+					{TargetGroupARN: mockTG, Weight: 0},
+					{TargetGroupARN: mockAltTG, Weight: 100},
+				}, nil)
+			},
+			wanted: &stack.TrafficWeights{
+				TargetGroup:          0,
+				AlternateTargetGroup: 100,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cfn := mocks.NewMockcfnClient(ctrl)
+			lbs := mocks.NewMockelbv2Client(ctrl)
+			tc.setupMocks(cfn, lbs)
+			c := CloudFormation{
+				cfnClient:   cfn,
+				elbv2Client: lbs,
+			}
+
+			// WHEN
+			weights, err := c.TrafficWeights("phonetool-test-frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, weights)
+		})
+	}
+}
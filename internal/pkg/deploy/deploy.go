@@ -26,6 +26,12 @@ const (
 	TaskTagKey = "copilot-task"
 )
 
+// DeployedByLabelKey is the docker label key on the main container of a workload that holds the principal who deployed it.
+const DeployedByLabelKey = "copilot-deployed-by"
+
+// ImageDigestLabelKey is the docker label key on the main container of a workload that holds the digest of the image it was deployed with.
+const ImageDigestLabelKey = "copilot-image-digest"
+
 const (
 	stackResourceType = "cloudformation:stack"
 	snsResourceType   = "sns"
@@ -13,7 +13,7 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.6.1"
+	LatestEnvTemplateVersion = "v1.8.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -28,6 +28,9 @@ type CreateEnvironmentInput struct {
 	CustomResourcesURLs map[string]string // Environment custom resource script S3 object URLs.
 	ImportVPCConfig     *config.ImportVPC // Optional configuration if users have an existing VPC.
 	AdjustVPCConfig     *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	ImportCertARNs      []string          // Optional existing ACM certificates to attach to the HTTPS listener of the public load balancer.
+	ImportALB           *config.ImportALB // Optional existing Application Load Balancer to use instead of creating one.
+	Telemetry           *config.Telemetry // Optional observability settings of the environment.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
@@ -19,6 +21,7 @@ const (
 	fmtInvalidRepo = "unable to locate the repository URL from the properties: %+v"
 
 	defaultPipelineBuildImage = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultECRSourceTag       = "latest"
 )
 
 var (
@@ -57,6 +60,63 @@ type CreatePipelineInput struct {
 	AdditionalTags map[string]string
 }
 
+// ApprovalTopicARNs returns the ARNs of the SNS topics notified by the manual approvals of the pipeline.
+func (in *CreatePipelineInput) ApprovalTopicARNs() []string {
+	var arns []string
+	for _, stage := range in.Stages {
+		if stage.Approval != nil && stage.Approval.TopicARN != "" && !contains(stage.Approval.TopicARN, arns) {
+			arns = append(arns, stage.Approval.TopicARN)
+		}
+	}
+	return arns
+}
+
+// TestSecretARNs returns the ARNs of the Secrets Manager secrets read by the tests of the pipeline.
+func (in *CreatePipelineInput) TestSecretARNs() []string {
+	var arns []string
+	for _, stage := range in.Stages {
+		if stage.Test == nil {
+			continue
+		}
+		for _, secret := range stage.Test.secretsManagerSecretARNs() {
+			if !contains(secret, arns) {
+				arns = append(arns, secret)
+			}
+		}
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+// TestParameterARNs returns the ARNs of the SSM parameters read by the tests of the pipeline.
+// The ARNs of the parameters referenced by name are in the account and region of the pipeline, and are meant to be
+// passed to the Fn::Sub function.
+func (in *CreatePipelineInput) TestParameterARNs() []string {
+	var arns []string
+	for _, stage := range in.Stages {
+		if stage.Test == nil {
+			continue
+		}
+		for _, param := range stage.Test.ssmParameterARNs() {
+			if !contains(param, arns) {
+				arns = append(arns, param)
+			}
+		}
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+// HasTestsInVPC returns true if the tests of a stage of the pipeline run in the VPC of an environment.
+func (in *CreatePipelineInput) HasTestsInVPC() bool {
+	for _, stage := range in.Stages {
+		if stage.Test != nil && stage.Test.Environment != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Build represents CodeBuild project used in the CodePipeline
 // to build and test Docker image.
 type Build struct {
@@ -123,6 +183,22 @@ type BitbucketSource struct {
 	ConnectionARN string
 }
 
+// ECRSource defines the (ECR) source of the image to be deployed. The pipeline
+// skips its build stage and deploys the image pushed with the tag.
+type ECRSource struct {
+	ProviderName string
+	Repository   string
+	Tag          string
+}
+
+// S3Source defines the (S3) source of the artifacts to be built and deployed.
+// The object is a zip archive of the workspace.
+type S3Source struct {
+	ProviderName string
+	Bucket       string
+	ObjectKey    string
+}
+
 // PipelineSourceFromManifest processes manifest info about the source based on provider type.
 // The return boolean is true for CodeStar Connections sources that require a polling prompt.
 func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{}, shouldPrompt bool, err error) {
@@ -178,6 +254,22 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 		}
 		repo.ConnectionARN = connection.(string)
 		return repo, false, nil
+	case manifest.ECRProviderName:
+		tag := defaultECRSourceTag
+		if t, ok := mfSource.Properties["tag"].(string); ok && t != "" {
+			tag = t
+		}
+		return &ECRSource{
+			ProviderName: manifest.ECRProviderName,
+			Repository:   (mfSource.Properties["repository"]).(string),
+			Tag:          tag,
+		}, false, nil
+	case manifest.S3ProviderName:
+		return &S3Source{
+			ProviderName: manifest.S3ProviderName,
+			Bucket:       (mfSource.Properties["bucket"]).(string),
+			ObjectKey:    (mfSource.Properties["object_key"]).(string),
+		}, false, nil
 	default:
 		return nil, false, fmt.Errorf("invalid repo source provider: %s", mfSource.ProviderName)
 	}
@@ -214,6 +306,12 @@ func (s *GitHubSource) Connection() string {
 	return s.ConnectionARN
 }
 
+// Workload returns the name of the service or job whose image is stored in the repository.
+// Copilot names the repositories of the workloads "{app}/{workload}".
+func (s *ECRSource) Workload() string {
+	return s.Repository[strings.LastIndex(s.Repository, "/")+1:]
+}
+
 // parse parses the owner and repo name from the GH repo URL, which was formatted and assigned in cli/pipeline_init.go.
 func (url GitHubURL) parse() (owner, repo string, err error) {
 	if url == "" {
@@ -374,35 +472,198 @@ func (s *GitHubV1Source) Owner() (string, error) {
 }
 
 // PipelineStage represents configuration for each deployment stage
-// of a workspace. A stage consists of the Config Environment the pipeline
-// is deploying to, the containerized services that will be deployed, and
-// test commands, if the user has opted to add any.
+// of a workspace. A stage consists of the Config Environments the pipeline
+// is deploying to in parallel, the containerized services that will be deployed,
+// the actions to run before and after the deployments, and test commands,
+// if the user has opted to add any.
 type PipelineStage struct {
-	*AssociatedEnvironment
+	// Name of the stage.
+	Name             string
+	Environments     []*AssociatedEnvironment
 	LocalWorkloads   []string
 	RequiresApproval bool
+	Approval         *PipelineApproval
+	PreDeployments   []PipelineAction
+	PostDeployments  []PipelineAction
 	TestCommands     []string
+	Test             *PipelineTest
 }
 
-// WorkloadTemplatePath returns the full path to the workload CFN template
-// built during the build stage.
-func (s *PipelineStage) WorkloadTemplatePath(wlName string) string {
-	return fmt.Sprintf(WorkloadCfnTemplateNameFormat, wlName, s.Name)
+// PipelineApproval holds the settings of the manual approval of a stage.
+type PipelineApproval struct {
+	// ARN of the SNS topic notified when the approval is pending.
+	TopicARN string
+
+	// Minutes after which the approval is rejected. Zero keeps the CodePipeline default of 7 days.
+	TimeoutInMinutes int
 }
 
-// WorkloadTemplateConfigurationPath returns the full path to the workload CFN
-// template configuration file built during the build stage.
-func (s *PipelineStage) WorkloadTemplateConfigurationPath(wlName string) string {
-	return fmt.Sprintf(WorkloadCfnTemplateConfigurationNameFormat,
-		wlName, s.Name,
-	)
+// PipelineTest holds the settings of the CodeBuild project that tests a stage after its deployments.
+type PipelineTest struct {
+	Image     string
+	Buildspec string
+	Variables map[string]string
+	Secrets   map[string]string
+
+	// The environment whose private subnets the tests run in, so that they can reach its services.
+	// Nil if the tests run outside of a VPC.
+	Environment *AssociatedEnvironment
+}
+
+// PipelineTestVariable is an environment variable of the CodeBuild project that tests a stage.
+type PipelineTestVariable struct {
+	Name  string
+	Type  string
+	Value string
+}
+
+// Types of the environment variables of a CodeBuild project.
+const (
+	codebuildPlaintextVariable      = "PLAINTEXT"
+	codebuildParameterStoreVariable = "PARAMETER_STORE"
+	codebuildSecretsManagerVariable = "SECRETS_MANAGER"
+)
+
+// EnvironmentVariables returns the variables and secrets of the tests sorted by name.
+// Secrets are read from Secrets Manager if they are referenced by ARN, and from SSM Parameter Store otherwise.
+func (t *PipelineTest) EnvironmentVariables() []PipelineTestVariable {
+	var vars []PipelineTestVariable
+	for name, value := range t.Variables {
+		vars = append(vars, PipelineTestVariable{
+			Name:  name,
+			Type:  codebuildPlaintextVariable,
+			Value: value,
+		})
+	}
+	for name, value := range t.Secrets {
+		varType := codebuildParameterStoreVariable
+		if isSecretsManagerARN(value) {
+			varType = codebuildSecretsManagerVariable
+		}
+		vars = append(vars, PipelineTestVariable{
+			Name:  name,
+			Type:  varType,
+			Value: value,
+		})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	return vars
+}
+
+// secretsManagerSecretARNs returns the ARNs of the Secrets Manager secrets read by the tests.
+func (t *PipelineTest) secretsManagerSecretARNs() []string {
+	var arns []string
+	for _, value := range t.Secrets {
+		if !isSecretsManagerARN(value) {
+			continue
+		}
+		// Drop the JSON key, version stage and version ID that can follow the ARN of the secret.
+		parts := strings.SplitN(value, ":", 8)
+		arns = append(arns, strings.Join(parts[:7], ":"))
+	}
+	return arns
+}
+
+// ssmParameterARNs returns the ARNs of the SSM parameters read by the tests.
+func (t *PipelineTest) ssmParameterARNs() []string {
+	var arns []string
+	for _, value := range t.Secrets {
+		if isSecretsManagerARN(value) {
+			continue
+		}
+		if _, err := arn.Parse(value); err == nil {
+			arns = append(arns, value)
+			continue
+		}
+		arns = append(arns, fmt.Sprintf("arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:parameter/%s", strings.TrimPrefix(value, "/")))
+	}
+	return arns
+}
+
+func isSecretsManagerARN(value string) bool {
+	parsed, err := arn.Parse(value)
+	return err == nil && parsed.Service == "secretsmanager"
+}
+
+// HasTests returns true if the stage runs tests after its deployments.
+func (s *PipelineStage) HasTests() bool {
+	return len(s.TestCommands) > 0 || (s.Test != nil && s.Test.Buildspec != "")
+}
+
+// TestImage returns the image of the CodeBuild project that tests the stage.
+func (s *PipelineStage) TestImage() string {
+	if s.Test != nil && s.Test.Image != "" {
+		return s.Test.Image
+	}
+	return defaultPipelineBuildImage
+}
+
+// TestBuildspec returns the path to the buildspec of the tests in the source repository, empty if the tests
+// run the commands of the stage instead.
+func (s *PipelineStage) TestBuildspec() string {
+	if s.Test == nil {
+		return ""
+	}
+	return s.Test.Buildspec
+}
+
+// TestEnvironmentVariables returns the environment variables of the CodeBuild project that tests the stage.
+func (s *PipelineStage) TestEnvironmentVariables() []PipelineTestVariable {
+	if s.Test == nil {
+		return nil
+	}
+	return s.Test.EnvironmentVariables()
+}
+
+// TestNetworkEnvironment returns the environment whose VPC the tests of the stage run in, nil if there is none.
+func (s *PipelineStage) TestNetworkEnvironment() *AssociatedEnvironment {
+	if s.Test == nil {
+		return nil
+	}
+	return s.Test.Environment
+}
+
+// PipelineAction represents commands run by CodeBuild in a stage before or after its deployments.
+type PipelineAction struct {
+	Name     string
+	Commands []string
+}
+
+// Actions returns the pre-deployment actions followed by the post-deployment actions of the stage.
+func (s *PipelineStage) Actions() []PipelineAction {
+	return append(append([]PipelineAction{}, s.PreDeployments...), s.PostDeployments...)
+}
+
+// PreDeploymentRunOrder returns the run order of the pre-deployment action at index i.
+// Pre-deployment actions run one after the other, after the manual approval.
+func (s *PipelineStage) PreDeploymentRunOrder(i int) int {
+	return 2 + i
+}
+
+// DeployRunOrder returns the run order of the deployments of the stage.
+// The workloads of all the environments of the stage are deployed in parallel.
+func (s *PipelineStage) DeployRunOrder() int {
+	return s.PreDeploymentRunOrder(len(s.PreDeployments))
+}
+
+// TestCommandsRunOrder returns the run order of the test commands, right after the deployments.
+func (s *PipelineStage) TestCommandsRunOrder() int {
+	return s.DeployRunOrder() + 1
+}
+
+// PostDeploymentRunOrder returns the run order of the post-deployment action at index i.
+// Post-deployment actions run one after the other, after the test commands.
+func (s *PipelineStage) PostDeploymentRunOrder(i int) int {
+	if s.HasTests() {
+		return s.TestCommandsRunOrder() + 1 + i
+	}
+	return s.DeployRunOrder() + 1 + i
 }
 
 // AssociatedEnvironment defines the necessary information a pipeline stage
 // needs for an Config Environment.
 type AssociatedEnvironment struct {
 	// Name of the environment, must be unique within an application.
-	// This is also the name of the pipeline stage.
 	Name string
 
 	// The region this environment is stored in.
@@ -411,3 +672,17 @@ type AssociatedEnvironment struct {
 	// AccountID of the account this environment is stored in.
 	AccountID string
 }
+
+// WorkloadTemplatePath returns the full path to the workload CFN template
+// built during the build stage.
+func (e *AssociatedEnvironment) WorkloadTemplatePath(wlName string) string {
+	return fmt.Sprintf(WorkloadCfnTemplateNameFormat, wlName, e.Name)
+}
+
+// WorkloadTemplateConfigurationPath returns the full path to the workload CFN
+// template configuration file built during the build stage.
+func (e *AssociatedEnvironment) WorkloadTemplateConfigurationPath(wlName string) string {
+	return fmt.Sprintf(WorkloadCfnTemplateConfigurationNameFormat,
+		wlName, e.Name,
+	)
+}
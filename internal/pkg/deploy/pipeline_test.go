@@ -121,6 +121,53 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          nil,
 		},
+		"transforms ECR source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "phonetool/api",
+					"tag":        "release",
+				},
+			},
+			expectedDeploySource: &ECRSource{
+				ProviderName: manifest.ECRProviderName,
+				Repository:   "phonetool/api",
+				Tag:          "release",
+			},
+			expectedShouldPrompt: false,
+			expectedErr:          nil,
+		},
+		"transforms ECR source without tag": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "phonetool/api",
+				},
+			},
+			expectedDeploySource: &ECRSource{
+				ProviderName: manifest.ECRProviderName,
+				Repository:   "phonetool/api",
+				Tag:          "latest",
+			},
+			expectedShouldPrompt: false,
+			expectedErr:          nil,
+		},
+		"transforms S3 source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"bucket":     "phonetool-sources",
+					"object_key": "workspace.zip",
+				},
+			},
+			expectedDeploySource: &S3Source{
+				ProviderName: manifest.S3ProviderName,
+				Bucket:       "phonetool-sources",
+				ObjectKey:    "workspace.zip",
+			},
+			expectedShouldPrompt: false,
+			expectedErr:          nil,
+		},
 		"errors if user changed provider name in manifest to unsupported source": {
 			mfSource: &manifest.Source{
 				ProviderName: "BitCommitHubBucket",
@@ -259,3 +306,188 @@ func TestParseRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineStage_RunOrders(t *testing.T) {
+	testCases := map[string]struct {
+		in PipelineStage
+
+		wantedPre     []int
+		wantedDeploy  int
+		wantedTest    int
+		wantedPost    []int
+		wantedActions []PipelineAction
+	}{
+		"deploys right after the approval without pre deployments": {
+			in: PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test"},
+			},
+			wantedDeploy: 2,
+			wantedTest:   3,
+		},
+		"runs pre deployments, deployments, test commands and post deployments in order": {
+			in: PipelineStage{
+				Name: "prod",
+				PreDeployments: []PipelineAction{
+					{Name: "backup"}, {Name: "migrate"},
+				},
+				TestCommands: []string{"make test"},
+				PostDeployments: []PipelineAction{
+					{Name: "smoke"},
+				},
+			},
+			wantedPre:    []int{2, 3},
+			wantedDeploy: 4,
+			wantedTest:   5,
+			wantedPost:   []int{6},
+			wantedActions: []PipelineAction{
+				{Name: "backup"}, {Name: "migrate"}, {Name: "smoke"},
+			},
+		},
+		"runs post deployments right after the deployments without test commands": {
+			in: PipelineStage{
+				Name: "prod",
+				PostDeployments: []PipelineAction{
+					{Name: "smoke"}, {Name: "notify"},
+				},
+			},
+			wantedDeploy: 2,
+			wantedTest:   3,
+			wantedPost:   []int{3, 4},
+			wantedActions: []PipelineAction{
+				{Name: "smoke"}, {Name: "notify"},
+			},
+		},
+		"runs post deployments after the tests of a buildspec": {
+			in: PipelineStage{
+				Name: "prod",
+				Test: &PipelineTest{
+					Buildspec: "copilot/tests/buildspec.yml",
+				},
+				PostDeployments: []PipelineAction{
+					{Name: "notify"},
+				},
+			},
+			wantedDeploy: 2,
+			wantedTest:   3,
+			wantedPost:   []int{4},
+			wantedActions: []PipelineAction{
+				{Name: "notify"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var pre, post []int
+			for i := range tc.in.PreDeployments {
+				pre = append(pre, tc.in.PreDeploymentRunOrder(i))
+			}
+			for i := range tc.in.PostDeployments {
+				post = append(post, tc.in.PostDeploymentRunOrder(i))
+			}
+
+			require.Equal(t, tc.wantedPre, pre)
+			require.Equal(t, tc.wantedDeploy, tc.in.DeployRunOrder())
+			require.Equal(t, tc.wantedTest, tc.in.TestCommandsRunOrder())
+			require.Equal(t, tc.wantedPost, post)
+			if tc.wantedActions == nil {
+				require.Empty(t, tc.in.Actions())
+			} else {
+				require.Equal(t, tc.wantedActions, tc.in.Actions())
+			}
+		})
+	}
+}
+
+func TestECRSource_Workload(t *testing.T) {
+	testCases := map[string]struct {
+		inRepository string
+		wanted       string
+	}{
+		"repository of a workload": {
+			inRepository: "phonetool/api",
+			wanted:       "api",
+		},
+		"repository without namespace": {
+			inRepository: "api",
+			wanted:       "api",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := &ECRSource{Repository: tc.inRepository}
+			require.Equal(t, tc.wanted, s.Workload())
+		})
+	}
+}
+
+func TestPipelineTest_EnvironmentVariables(t *testing.T) {
+	testCases := map[string]struct {
+		in     *PipelineTest
+		wanted []PipelineTestVariable
+	}{
+		"no variables": {
+			in: &PipelineTest{},
+		},
+		"sorts variables and secrets by name": {
+			in: &PipelineTest{
+				Variables: map[string]string{
+					"LOG_LEVEL": "debug",
+					"ENDPOINT":  "http://api.test.phonetool.local",
+				},
+				Secrets: map[string]string{
+					"TOKEN":    "/copilot/phonetool/test/secrets/token",
+					"PASSWORD": "arn:aws:secretsmanager:us-west-2:1111:secret:db-AbCdEf:password::",
+				},
+			},
+			wanted: []PipelineTestVariable{
+				{Name: "ENDPOINT", Type: "PLAINTEXT", Value: "http://api.test.phonetool.local"},
+				{Name: "LOG_LEVEL", Type: "PLAINTEXT", Value: "debug"},
+				{Name: "PASSWORD", Type: "SECRETS_MANAGER", Value: "arn:aws:secretsmanager:us-west-2:1111:secret:db-AbCdEf:password::"},
+				{Name: "TOKEN", Type: "PARAMETER_STORE", Value: "/copilot/phonetool/test/secrets/token"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.EnvironmentVariables())
+		})
+	}
+}
+
+func TestCreatePipelineInput_StageTestsAndApprovals(t *testing.T) {
+	env := &AssociatedEnvironment{Name: "test", Region: "us-west-2", AccountID: "1111"}
+	in := &CreatePipelineInput{
+		Stages: []PipelineStage{
+			{
+				Name: "test",
+				Test: &PipelineTest{
+					Secrets: map[string]string{
+						"PASSWORD": "arn:aws:secretsmanager:us-west-2:1111:secret:db-AbCdEf:password::",
+						"KEY":      "arn:aws:secretsmanager:us-west-2:1111:secret:db-AbCdEf",
+						"TOKEN":    "/copilot/phonetool/test/secrets/token",
+						"SHARED":   "arn:aws:ssm:us-east-1:2222:parameter/shared/token",
+					},
+					Environment: env,
+				},
+				Approval: &PipelineApproval{TopicARN: "arn:aws:sns:us-west-2:1111:releases"},
+			},
+			{
+				Name:     "prod",
+				Approval: &PipelineApproval{TopicARN: "arn:aws:sns:us-west-2:1111:releases", TimeoutInMinutes: 60},
+			},
+		},
+	}
+
+	require.True(t, in.HasTestsInVPC())
+	require.Equal(t, []string{"arn:aws:secretsmanager:us-west-2:1111:secret:db-AbCdEf"}, in.TestSecretARNs())
+	require.Equal(t, []string{
+		"arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:parameter/copilot/phonetool/test/secrets/token",
+		"arn:aws:ssm:us-east-1:2222:parameter/shared/token",
+	}, in.TestParameterARNs())
+	require.Equal(t, []string{"arn:aws:sns:us-west-2:1111:releases"}, in.ApprovalTopicARNs())
+	require.False(t, (&CreatePipelineInput{Stages: []PipelineStage{{Name: "test"}}}).HasTestsInVPC())
+}
@@ -16,6 +16,9 @@ const (
 	// AddonsCfnTemplateNameFormat is the addons output file name when `service package`
 	// is called.
 	AddonsCfnTemplateNameFormat = "%s.addons.stack.yml"
+	// ServiceRevisionKeyFormat is the key of the object in the application's regional bucket that holds
+	// the template and parameters a service is deployed with in an environment at a task definition revision.
+	ServiceRevisionKeyFormat = "revisions/%s/%s/%d.json"
 )
 
 // DeleteWorkloadInput holds the fields required to delete a workload.
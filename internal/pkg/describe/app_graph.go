@@ -0,0 +1,414 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// Kinds of nodes in the graph of an application.
+const (
+	GraphNodeWorkload = "workload"
+	GraphNodeTopic    = "topic"
+	GraphNodeVolume   = "volume"
+	GraphNodeAddon    = "addon"
+)
+
+// Relationships between the nodes of the graph of an application.
+const (
+	graphEdgePublishes = "publishes"
+	graphEdgeNotifies  = "notifies"
+	graphEdgeCalls     = "calls"
+	graphEdgeMounts    = "mounts"
+	graphEdgeUses      = "uses"
+)
+
+// GraphNode is a workload, or a resource that workloads depend on, in the graph of an application.
+type GraphNode struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"`
+	Label    string `json:"label"`
+	Details  string `json:"details,omitempty"`  // Details is the type of a workload or of an addon output, for example.
+	Endpoint string `json:"endpoint,omitempty"` // Endpoint is the service discovery endpoint of a service.
+}
+
+// GraphEdge is a relationship between two nodes of the graph of an application.
+type GraphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Label string `json:"label"`
+}
+
+// GraphWorkload is a workload to add to the graph of an application.
+type GraphWorkload struct {
+	Name        string
+	Type        string
+	Manifest    interface{}    // Manifest is the workload manifest with the overrides of the environment applied, if any.
+	Addons      []addon.Output // Addons are the outputs of the addons template of the workload.
+	NotDeployed bool           // NotDeployed is true if the workload isn't deployed in the environment of the graph.
+}
+
+// AppGraphInput holds the workloads of an application to draw the graph of.
+type AppGraphInput struct {
+	App       string
+	Env       string // Env is the optional environment that the manifests and service discovery namespace come from.
+	Namespace string // Namespace is the service discovery namespace of the environment, for example "test.phonetool.local".
+	Workloads []GraphWorkload
+}
+
+// AppGraph is the graph of the workloads of an application, the workloads they talk to, and the resources they share.
+type AppGraph struct {
+	App   string      `json:"application"`
+	Env   string      `json:"environment,omitempty"`
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+
+	endpoints map[string]string
+}
+
+// NewAppGraph returns the graph of the workloads from their manifests and addons.
+// Workloads are connected through SNS topics, service discovery endpoints referenced in their variables,
+// EFS file systems and the outputs of their addons.
+func NewAppGraph(in AppGraphInput) *AppGraph {
+	g := &AppGraph{
+		App:       in.App,
+		Env:       in.Env,
+		endpoints: make(map[string]string),
+	}
+	namespace := in.Namespace
+	if namespace == "" {
+		namespace = fmt.Sprintf("{env}.%s.local", in.App)
+	}
+	for _, wkld := range in.Workloads {
+		node := GraphNode{
+			ID:      wkld.Name,
+			Kind:    GraphNodeWorkload,
+			Label:   wkld.Name,
+			Details: wkld.Type,
+		}
+		if port, ok := serviceDiscoveryPort(wkld.Manifest); ok {
+			node.Endpoint = fmt.Sprintf("%s.%s:%d", wkld.Name, namespace, port)
+			g.endpoints[wkld.Name] = node.Endpoint
+		}
+		if wkld.NotDeployed {
+			node.Details = fmt.Sprintf("%s, not deployed", node.Details)
+		}
+		g.addNode(node)
+	}
+	for _, wkld := range in.Workloads {
+		g.addTopics(wkld)
+		g.addVolumes(wkld)
+		g.addAddons(wkld)
+	}
+	for _, wkld := range in.Workloads {
+		g.addCalls(wkld, in.Workloads)
+	}
+	return g
+}
+
+func (g *AppGraph) addTopics(wkld GraphWorkload) {
+	if publisher, ok := wkld.Manifest.(interface{ Publish() []manifest.Topic }); ok {
+		for _, topic := range publisher.Publish() {
+			id := g.addTopic(wkld.Name, aws.StringValue(topic.Name))
+			g.addEdge(wkld.Name, id, graphEdgePublishes)
+		}
+	}
+	if subscriber, ok := wkld.Manifest.(interface {
+		Subscriptions() []manifest.TopicSubscription
+	}); ok {
+		for _, sub := range subscriber.Subscriptions() {
+			id := g.addTopic(sub.Service, sub.Name)
+			g.addEdge(id, wkld.Name, graphEdgeNotifies)
+		}
+	}
+}
+
+func (g *AppGraph) addTopic(publisher, name string) string {
+	id := fmt.Sprintf("topic/%s/%s", publisher, name)
+	g.addNode(GraphNode{
+		ID:      id,
+		Kind:    GraphNodeTopic,
+		Label:   name,
+		Details: fmt.Sprintf("SNS topic of %s", publisher),
+	})
+	return id
+}
+
+func (g *AppGraph) addVolumes(wkld GraphWorkload) {
+	storage := workloadStorage(wkld.Manifest)
+	if storage == nil {
+		return
+	}
+	names := make([]string, 0, len(storage.Volumes))
+	for name := range storage.Volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		vol := storage.Volumes[name]
+		if vol == nil || vol.EFS == nil || vol.EFS.Disabled() {
+			continue
+		}
+		node := GraphNode{
+			ID:      fmt.Sprintf("efs/managed/%s", wkld.Name),
+			Kind:    GraphNodeVolume,
+			Label:   fmt.Sprintf("%s-efs", wkld.Name),
+			Details: "EFS file system managed by Copilot",
+		}
+		if id := aws.StringValue(vol.EFS.Advanced.FileSystemID); id != "" && !vol.EFS.UseManagedFS() {
+			node = GraphNode{
+				ID:      fmt.Sprintf("efs/%s", id),
+				Kind:    GraphNodeVolume,
+				Label:   id,
+				Details: "EFS file system",
+			}
+		}
+		g.addNode(node)
+		g.addEdge(wkld.Name, node.ID, fmt.Sprintf("%s %s", graphEdgeMounts, aws.StringValue(vol.ContainerPath)))
+	}
+}
+
+func (g *AppGraph) addAddons(wkld GraphWorkload) {
+	for _, out := range wkld.Addons {
+		details := "addon output"
+		switch {
+		case out.IsSecret:
+			details = "addon secret"
+		case out.IsManagedPolicy:
+			details = "addon managed policy"
+		case out.IsSecurityGroup:
+			details = "addon security group"
+		}
+		id := fmt.Sprintf("addon/%s/%s", wkld.Name, out.Name)
+		g.addNode(GraphNode{
+			ID:      id,
+			Kind:    GraphNodeAddon,
+			Label:   out.Name,
+			Details: details,
+		})
+		g.addEdge(wkld.Name, id, graphEdgeUses)
+	}
+}
+
+// addCalls connects the workload to the services whose service discovery endpoint is referenced by its variables.
+func (g *AppGraph) addCalls(wkld GraphWorkload, wklds []GraphWorkload) {
+	vars := workloadVariables(wkld.Manifest)
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, other := range wklds {
+		if other.Name == wkld.Name || g.endpoints[other.Name] == "" {
+			continue
+		}
+		// Matches hosts like "api.test.phonetool.local" or "api.phonetool.local".
+		host := regexp.MustCompile(fmt.Sprintf(`(^|[^a-zA-Z0-9-])%s\.([a-zA-Z0-9-]+\.)*local\b`, regexp.QuoteMeta(other.Name)))
+		for _, key := range keys {
+			if host.MatchString(vars[key]) {
+				g.addEdge(wkld.Name, other.Name, graphEdgeCalls)
+				break
+			}
+		}
+	}
+}
+
+func (g *AppGraph) addNode(node GraphNode) {
+	for _, n := range g.Nodes {
+		if n.ID == node.ID {
+			return
+		}
+	}
+	g.Nodes = append(g.Nodes, node)
+}
+
+func (g *AppGraph) addEdge(from, to, label string) {
+	edge := GraphEdge{
+		From:  from,
+		To:    to,
+		Label: strings.TrimSpace(label),
+	}
+	for _, e := range g.Edges {
+		if e == edge {
+			return
+		}
+	}
+	g.Edges = append(g.Edges, edge)
+}
+
+func (g *AppGraph) node(id string) GraphNode {
+	for _, n := range g.Nodes {
+		if n.ID == id {
+			return n
+		}
+	}
+	return GraphNode{ID: id, Label: id}
+}
+
+// JSONString returns the stringified AppGraph struct with json format.
+func (g *AppGraph) JSONString() (string, error) {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return "", fmt.Errorf("marshal application graph: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the workloads and their relationships as tables.
+func (g *AppGraph) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, "Workloads\n\n")
+	headers := []string{"Name", "Type", "Endpoint"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, node := range g.Nodes {
+		if node.Kind != GraphNodeWorkload {
+			continue
+		}
+		endpoint := node.Endpoint
+		if endpoint == "" {
+			endpoint = "-"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", node.Label, node.Details, endpoint)
+	}
+	fmt.Fprint(writer, "\nRelationships\n\n")
+	if len(g.Edges) == 0 {
+		fmt.Fprint(writer, "  No relationships found between the workloads.\n")
+		writer.Flush()
+		return b.String()
+	}
+	headers = []string{"From", "Relationship", "To"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, edge := range g.Edges {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", g.humanNode(edge.From), edge.Label, g.humanNode(edge.To))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (g *AppGraph) humanNode(id string) string {
+	node := g.node(id)
+	if node.Kind == GraphNodeWorkload {
+		return node.Label
+	}
+	return fmt.Sprintf("%s (%s)", node.Label, node.Details)
+}
+
+// DOTString returns the graph in the DOT language of Graphviz.
+func (g *AppGraph) DOTString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(g.App))
+	b.WriteString("  rankdir=LR;\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", dotQuote(node.ID), dotQuote(node.Label+`\n`+node.Details), dotShape(node.Kind))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(edge.From), dotQuote(edge.To), dotQuote(edge.Label))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// MermaidString returns the graph as a Mermaid flowchart.
+func (g *AppGraph) MermaidString() string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, node := range g.Nodes {
+		label := mermaidQuote(fmt.Sprintf("%s<br/>%s", node.Label, node.Details))
+		switch node.Kind {
+		case GraphNodeTopic:
+			fmt.Fprintf(&b, "  %s([%s])\n", mermaidID(node.ID), label)
+		case GraphNodeVolume:
+			fmt.Fprintf(&b, "  %s[(%s)]\n", mermaidID(node.ID), label)
+		case GraphNodeAddon:
+			fmt.Fprintf(&b, "  %s{{%s}}\n", mermaidID(node.ID), label)
+		default:
+			fmt.Fprintf(&b, "  %s[%s]\n", mermaidID(node.ID), label)
+		}
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", mermaidID(edge.From), mermaidQuote(edge.Label), mermaidID(edge.To))
+	}
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `\"`))
+}
+
+func dotShape(kind string) string {
+	switch kind {
+	case GraphNodeTopic:
+		return "ellipse"
+	case GraphNodeVolume:
+		return "cylinder"
+	case GraphNodeAddon:
+		return "hexagon"
+	}
+	return "box"
+}
+
+var mermaidInvalidIDChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// mermaidID returns an identifier that Mermaid accepts, for example "topic_api_orders" for "topic/api/orders".
+func mermaidID(id string) string {
+	return mermaidInvalidIDChars.ReplaceAllString(id, "_")
+}
+
+func mermaidQuote(s string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, "#quot;"))
+}
+
+// serviceDiscoveryPort returns the port that the service is reachable at through service discovery.
+func serviceDiscoveryPort(mft interface{}) (uint16, bool) {
+	switch m := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return m.Port()
+	case *manifest.BackendService:
+		return m.Port()
+	}
+	return 0, false
+}
+
+func workloadStorage(mft interface{}) *manifest.Storage {
+	switch m := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return m.Storage
+	case *manifest.BackendService:
+		return m.Storage
+	case *manifest.WorkerService:
+		return m.Storage
+	case *manifest.ScheduledJob:
+		return m.Storage
+	}
+	return nil
+}
+
+func workloadVariables(mft interface{}) map[string]string {
+	switch m := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return m.Variables
+	case *manifest.BackendService:
+		return m.Variables
+	case *manifest.WorkerService:
+		return m.Variables
+	case *manifest.ScheduledJob:
+		return m.Variables
+	case *manifest.RequestDrivenWebService:
+		return m.Variables
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGraphAPIManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+publish:
+  topics:
+    - name: orders
+storage:
+  volumes:
+    shared:
+      path: /data
+      efs:
+        id: fs-1234
+`
+	testGraphWorkerManifest = `name: worker
+type: Worker Service
+image:
+  build: worker/Dockerfile
+variables:
+  API_URL: http://api.test.phonetool.local:8080
+subscribe:
+  topics:
+    - name: orders
+      service: api
+storage:
+  volumes:
+    shared:
+      path: /shared
+      efs:
+        id: fs-1234
+    scratch:
+      path: /scratch
+      efs: true
+`
+	testGraphFrontendManifest = `name: fe
+type: Load Balanced Web Service
+image:
+  build: fe/Dockerfile
+  port: 80
+http:
+  path: '/'
+`
+)
+
+func testAppGraph(t *testing.T) *AppGraph {
+	var wklds []GraphWorkload
+	for _, raw := range []string{testGraphAPIManifest, testGraphWorkerManifest, testGraphFrontendManifest} {
+		mft, err := manifest.UnmarshalWorkload([]byte(raw))
+		require.NoError(t, err)
+		wklds = append(wklds, GraphWorkload{Manifest: mft})
+	}
+	wklds[0].Name, wklds[0].Type = "api", manifest.BackendServiceType
+	wklds[1].Name, wklds[1].Type = "worker", manifest.WorkerServiceType
+	wklds[2].Name, wklds[2].Type = "fe", manifest.LoadBalancedWebServiceType
+	wklds[2].Addons = []addon.Output{
+		{Name: "MyTable"},
+		{Name: "MyTableAccessPolicy", IsManagedPolicy: true},
+	}
+	wklds[2].NotDeployed = true
+	return NewAppGraph(AppGraphInput{
+		App:       "phonetool",
+		Env:       "test",
+		Namespace: "test.phonetool.local",
+		Workloads: wklds,
+	})
+}
+
+func TestNewAppGraph(t *testing.T) {
+	graph := testAppGraph(t)
+
+	require.Equal(t, []GraphNode{
+		{ID: "api", Kind: GraphNodeWorkload, Label: "api", Details: "Backend Service", Endpoint: "api.test.phonetool.local:8080"},
+		{ID: "worker", Kind: GraphNodeWorkload, Label: "worker", Details: "Worker Service"},
+		{ID: "fe", Kind: GraphNodeWorkload, Label: "fe", Details: "Load Balanced Web Service, not deployed", Endpoint: "fe.test.phonetool.local:80"},
+		{ID: "topic/api/orders", Kind: GraphNodeTopic, Label: "orders", Details: "SNS topic of api"},
+		{ID: "efs/fs-1234", Kind: GraphNodeVolume, Label: "fs-1234", Details: "EFS file system"},
+		{ID: "efs/managed/worker", Kind: GraphNodeVolume, Label: "worker-efs", Details: "EFS file system managed by Copilot"},
+		{ID: "addon/fe/MyTable", Kind: GraphNodeAddon, Label: "MyTable", Details: "addon output"},
+		{ID: "addon/fe/MyTableAccessPolicy", Kind: GraphNodeAddon, Label: "MyTableAccessPolicy", Details: "addon managed policy"},
+	}, graph.Nodes)
+	require.Equal(t, []GraphEdge{
+		{From: "api", To: "topic/api/orders", Label: "publishes"},
+		{From: "api", To: "efs/fs-1234", Label: "mounts /data"},
+		{From: "topic/api/orders", To: "worker", Label: "notifies"},
+		{From: "worker", To: "efs/managed/worker", Label: "mounts /scratch"},
+		{From: "worker", To: "efs/fs-1234", Label: "mounts /shared"},
+		{From: "fe", To: "addon/fe/MyTable", Label: "uses"},
+		{From: "fe", To: "addon/fe/MyTableAccessPolicy", Label: "uses"},
+		{From: "worker", To: "api", Label: "calls"},
+	}, graph.Edges)
+}
+
+func TestAppGraph_HumanString(t *testing.T) {
+	t.Run("without relationships", func(t *testing.T) {
+		graph := NewAppGraph(AppGraphInput{
+			App: "phonetool",
+			Workloads: []GraphWorkload{
+				{
+					Name:     "api",
+					Type:     manifest.BackendServiceType,
+					Manifest: &manifest.BackendService{},
+				},
+			},
+		})
+
+		require.Equal(t, `Workloads
+
+  Name              Type                Endpoint
+  ----              ----                --------
+  api               Backend Service     -
+
+Relationships
+
+  No relationships found between the workloads.
+`, graph.HumanString())
+	})
+	t.Run("with relationships", func(t *testing.T) {
+		require.Equal(t, `Workloads
+
+  Name              Type                                     Endpoint
+  ----              ----                                     --------
+  api               Backend Service                          api.test.phonetool.local:8080
+  worker            Worker Service                           -
+  fe                Load Balanced Web Service, not deployed  fe.test.phonetool.local:80
+
+Relationships
+
+  From                       Relationship        To
+  ----                       ------------        --
+  api                        publishes           orders (SNS topic of api)
+  api                        mounts /data        fs-1234 (EFS file system)
+  orders (SNS topic of api)  notifies            worker
+  worker                     mounts /scratch     worker-efs (EFS file system managed by Copilot)
+  worker                     mounts /shared      fs-1234 (EFS file system)
+  fe                         uses                MyTable (addon output)
+  fe                         uses                MyTableAccessPolicy (addon managed policy)
+  worker                     calls               api
+`, testAppGraph(t).HumanString())
+	})
+}
+
+func TestAppGraph_DOTString(t *testing.T) {
+	require.Equal(t, `digraph "phonetool" {
+  rankdir=LR;
+  "api" [label="api\nBackend Service", shape=box];
+  "worker" [label="worker\nWorker Service", shape=box];
+  "fe" [label="fe\nLoad Balanced Web Service, not deployed", shape=box];
+  "topic/api/orders" [label="orders\nSNS topic of api", shape=ellipse];
+  "efs/fs-1234" [label="fs-1234\nEFS file system", shape=cylinder];
+  "efs/managed/worker" [label="worker-efs\nEFS file system managed by Copilot", shape=cylinder];
+  "addon/fe/MyTable" [label="MyTable\naddon output", shape=hexagon];
+  "addon/fe/MyTableAccessPolicy" [label="MyTableAccessPolicy\naddon managed policy", shape=hexagon];
+  "api" -> "topic/api/orders" [label="publishes"];
+  "api" -> "efs/fs-1234" [label="mounts /data"];
+  "topic/api/orders" -> "worker" [label="notifies"];
+  "worker" -> "efs/managed/worker" [label="mounts /scratch"];
+  "worker" -> "efs/fs-1234" [label="mounts /shared"];
+  "fe" -> "addon/fe/MyTable" [label="uses"];
+  "fe" -> "addon/fe/MyTableAccessPolicy" [label="uses"];
+  "worker" -> "api" [label="calls"];
+}
+`, testAppGraph(t).DOTString())
+}
+
+func TestAppGraph_MermaidString(t *testing.T) {
+	require.Equal(t, `flowchart LR
+  api["api<br/>Backend Service"]
+  worker["worker<br/>Worker Service"]
+  fe["fe<br/>Load Balanced Web Service, not deployed"]
+  topic_api_orders(["orders<br/>SNS topic of api"])
+  efs_fs_1234[("fs-1234<br/>EFS file system")]
+  efs_managed_worker[("worker-efs<br/>EFS file system managed by Copilot")]
+  addon_fe_MyTable{{"MyTable<br/>addon output"}}
+  addon_fe_MyTableAccessPolicy{{"MyTableAccessPolicy<br/>addon managed policy"}}
+  api -->|"publishes"| topic_api_orders
+  api -->|"mounts /data"| efs_fs_1234
+  topic_api_orders -->|"notifies"| worker
+  worker -->|"mounts /scratch"| efs_managed_worker
+  worker -->|"mounts /shared"| efs_fs_1234
+  fe -->|"uses"| addon_fe_MyTable
+  fe -->|"uses"| addon_fe_MyTableAccessPolicy
+  worker -->|"calls"| api
+`, testAppGraph(t).MermaidString())
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -78,6 +79,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	var configs []*ECSServiceConfig
+	var routes []*WebServiceRoute
 	var services []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
@@ -90,6 +92,16 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		if err != nil {
 			return nil, fmt.Errorf("get stack parameters for environment %s: %w", env, err)
 		}
+		if path, ok := svcParams[cfnstack.LBWebServiceRulePathParamKey]; ok {
+			uri, err := d.internalURI(env, path)
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, &WebServiceRoute{
+				Environment: env,
+				URL:         uri,
+			})
+		}
 		port := blankContainerPort
 		if svcParams[cfnstack.LBWebServiceContainerPortParamKey] != cfnstack.NoExposedContainerPort {
 			endpoint, err := d.envDescriber[env].ServiceDiscoveryEndpoint()
@@ -144,6 +156,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Type:             manifest.BackendServiceType,
 		App:              d.app,
 		Configurations:   configs,
+		Routes:           routes,
 		ServiceDiscovery: services,
 		Variables:        envVars,
 		Secrets:          secrets,
@@ -159,6 +172,7 @@ type backendSvcDesc struct {
 	Type             string               `json:"type"`
 	App              string               `json:"application"`
 	Configurations   ecsConfigurations    `json:"configurations"`
+	Routes           []*WebServiceRoute   `json:"routes,omitempty"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
@@ -188,6 +202,16 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if len(w.Routes) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
+		writer.Flush()
+		headers := []string{"Environment", "URL"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range w.Routes {
+			fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
+		}
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
@@ -106,6 +106,56 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve secrets: some error"),
 		},
+		"return routes of the internal load balancer": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "5000",
+						cfnstack.LBWebServiceRulePathParamKey:      "jobs",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputInternalLoadBalancerDNSName: "internal-phonetool-test-1234.us-west-2.elb.amazonaws.com",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsStackDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsStackDescriber.EXPECT().Secrets().Return(nil, nil),
+				)
+			},
+			wantedBackendSvc: &backendSvcDesc{
+				Service: testSvc,
+				Type:    "Backend Service",
+				App:     testApp,
+				Configurations: []*ECSServiceConfig{
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "256",
+							Environment: "test",
+							Memory:      "512",
+							Port:        "5000",
+						},
+						Tasks: "1",
+					},
+				},
+				Routes: []*WebServiceRoute{
+					{
+						Environment: "test",
+						URL:         "http://internal-phonetool-test-1234.us-west-2.elb.amazonaws.com/jobs",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"test"},
+						Namespace:   "jobs.test.phonetool.local:5000",
+					},
+				},
+				Resources:    map[string][]*stack.Resource{},
+				environments: []string{"test"},
+			},
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// Statuses of the deployment of a workload.
+const (
+	DeploymentStatusDeployed = "deployed"
+	DeploymentStatusFailed   = "failed"
+	DeploymentStatusSkipped  = "skipped"
+)
+
+// WorkloadDeployment is the outcome of the deployment of a workload.
+type WorkloadDeployment struct {
+	Name    string
+	Status  string
+	Details string // Details is the reason a deployment failed or was skipped, for example.
+}
+
+// WorkloadDeployments summarizes the deployments of several workloads to an environment.
+type WorkloadDeployments []WorkloadDeployment
+
+// HumanString returns a table with the status of each deployment.
+func (d WorkloadDeployments) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Name", "Status", "Details"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, deployment := range d {
+		details := deployment.Details
+		if details == "" {
+			details = "-"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", deployment.Name, deployment.Status, details)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// Failed returns the names of the workloads that failed to deploy.
+func (d WorkloadDeployments) Failed() []string {
+	var names []string
+	for _, deployment := range d {
+		if deployment.Status == DeploymentStatusFailed {
+			names = append(names, deployment.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadDeployments_HumanString(t *testing.T) {
+	deployments := WorkloadDeployments{
+		{
+			Name:   "frontend",
+			Status: DeploymentStatusDeployed,
+		},
+		{
+			Name:    "events",
+			Status:  DeploymentStatusFailed,
+			Details: "deploy service: some error",
+		},
+		{
+			Name:    "worker",
+			Status:  DeploymentStatusSkipped,
+			Details: "events failed to deploy",
+		},
+	}
+
+	human := deployments.HumanString()
+
+	require.Equal(t, `  Name              Status              Details
+  ----              ------              -------
+  frontend          deployed            -
+  events            failed              deploy service: some error
+  worker            skipped             events failed to deploy
+`, human)
+	require.Equal(t, []string{"events"}, deployments.Failed())
+}
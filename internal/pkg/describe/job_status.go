@@ -0,0 +1,339 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// DefaultJobExecutionsLimit is the number of most recent executions displayed by default.
+	DefaultJobExecutionsLimit = 10
+
+	maxFailureCauseColumnWidth = 50
+)
+
+var rateExpressionRegex = regexp.MustCompile(`^rate\((\d+) (minutes?|hours?|days?)\)$`)
+
+type stateMachineGetter interface {
+	StateMachineARN(app, env, job string) (string, error)
+}
+
+type executionsGetter interface {
+	Executions(stateMachineARN string, maxResults int) ([]*stepfunctions.Execution, error)
+	ExecutionHistory(executionARN string) (*stepfunctions.ExecutionHistory, error)
+}
+
+type jobStatusDescriber struct {
+	app   string
+	env   string
+	job   string
+	limit int
+
+	stateMachineGetter stateMachineGetter
+	executionsGetter   executionsGetter
+	cwSvcGetter        alarmStatusGetter
+	stackDescriber     stackDescriber
+	now                func() time.Time
+}
+
+// NewJobStatusConfig contains fields that initiates the job status describer.
+type NewJobStatusConfig struct {
+	App         string
+	Env         string
+	Job         string
+	Limit       int // Limit is the number of most recent executions to describe.
+	ConfigStore ConfigStoreSvc
+}
+
+// NewJobStatusDescriber instantiates a new jobStatusDescriber struct.
+func NewJobStatusDescriber(opt *NewJobStatusConfig) (*jobStatusDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	limit := opt.Limit
+	if limit <= 0 {
+		limit = DefaultJobExecutionsLimit
+	}
+	return &jobStatusDescriber{
+		app:                opt.App,
+		env:                opt.Env,
+		job:                opt.Job,
+		limit:              limit,
+		stateMachineGetter: ecs.New(sess),
+		executionsGetter:   stepfunctions.New(sess),
+		cwSvcGetter:        cloudwatch.New(sess),
+		stackDescriber:     stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Job), sess),
+		now:                time.Now,
+	}, nil
+}
+
+// Describe returns the recent executions, the next scheduled run and the alarms of a job.
+func (d *jobStatusDescriber) Describe() (HumanJSONStringer, error) {
+	stateMachineARN, err := d.stateMachineGetter.StateMachineARN(d.app, d.env, d.job)
+	if err != nil {
+		return nil, fmt.Errorf("get state machine of job %s: %w", d.job, err)
+	}
+	executions, err := d.executionsGetter.Executions(stateMachineARN, d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("get executions of job %s: %w", d.job, err)
+	}
+	status := &jobStatus{
+		Executions: []jobExecution{},
+	}
+	for _, execution := range executions {
+		history, err := d.executionsGetter.ExecutionHistory(execution.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("get history of job %s: %w", d.job, err)
+		}
+		status.Executions = append(status.Executions, newJobExecution(execution, history))
+	}
+
+	stackDescr, err := d.stackDescriber.Describe()
+	if err != nil {
+		return nil, err
+	}
+	status.Schedule = stackDescr.Parameters[cfnstack.ScheduledJobScheduleParamKey]
+	var lastRun time.Time
+	if len(executions) > 0 {
+		lastRun = executions[0].StartDate
+	}
+	if next, ok := nextScheduledRun(status.Schedule, lastRun, d.now()); ok {
+		status.NextRun = &next
+	}
+
+	alarms, err := d.cwSvcGetter.AlarmsWithTags(map[string]string{
+		deploy.AppTagKey:     d.app,
+		deploy.EnvTagKey:     d.env,
+		deploy.ServiceTagKey: d.job,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
+	}
+	// The alarms are serialized as an empty list instead of null if the job has none.
+	status.Alarms = append([]cloudwatch.AlarmStatus{}, alarms...)
+	return status, nil
+}
+
+// jobStatus contains the status for a job.
+type jobStatus struct {
+	Schedule   string                   `json:"schedule,omitempty"`
+	NextRun    *time.Time               `json:"nextRun,omitempty"`
+	Executions []jobExecution           `json:"executions"`
+	Alarms     []cloudwatch.AlarmStatus `json:"alarms"`
+}
+
+// jobExecution contains the status of a single run of a job.
+type jobExecution struct {
+	Name         string     `json:"name"`
+	Status       string     `json:"status"`
+	StartedAt    time.Time  `json:"startedAt"`
+	StoppedAt    *time.Time `json:"stoppedAt,omitempty"` // StoppedAt is nil if the execution is still running.
+	Duration     string     `json:"duration,omitempty"`
+	Retries      int        `json:"retries"`
+	FailureCause string     `json:"failureCause,omitempty"`
+}
+
+func newJobExecution(execution *stepfunctions.Execution, history *stepfunctions.ExecutionHistory) jobExecution {
+	out := jobExecution{
+		Name:         execution.ARN[strings.LastIndex(execution.ARN, ":")+1:],
+		Status:       execution.Status,
+		StartedAt:    execution.StartDate,
+		FailureCause: failureCause(history),
+	}
+	if !execution.StopDate.IsZero() {
+		stoppedAt := execution.StopDate
+		out.StoppedAt = &stoppedAt
+		out.Duration = stoppedAt.Sub(execution.StartDate).Round(time.Second).String()
+	}
+	if len(history.Tasks) > 1 {
+		out.Retries = len(history.Tasks) - 1
+	}
+	return out
+}
+
+// failureCause returns the reason of a failed execution.
+// If the execution failed because of its task, the cause is the description of the stopped ECS task
+// so only its stopped reason is kept.
+func failureCause(history *stepfunctions.ExecutionHistory) string {
+	if history.Cause == "" {
+		return history.Error
+	}
+	var task struct {
+		StoppedReason string `json:"StoppedReason"`
+	}
+	if err := json.Unmarshal([]byte(history.Cause), &task); err == nil && task.StoppedReason != "" {
+		return task.StoppedReason
+	}
+	return history.Cause
+}
+
+// nextScheduledRun returns the next time after now that a job with the EventBridge schedule expression is triggered.
+// Rate expressions are relative to when the rule was created, so the next run is estimated from the last run.
+// It returns false if the schedule is empty or cannot be evaluated.
+func nextScheduledRun(schedule string, lastRun, now time.Time) (time.Time, bool) {
+	switch {
+	case rateExpressionRegex.MatchString(schedule):
+		if lastRun.IsZero() {
+			return time.Time{}, false
+		}
+		match := rateExpressionRegex.FindStringSubmatch(schedule)
+		value, err := strconv.Atoi(match[1])
+		if err != nil || value == 0 {
+			return time.Time{}, false
+		}
+		unit := time.Minute
+		switch strings.TrimSuffix(match[2], "s") {
+		case "hour":
+			unit = time.Hour
+		case "day":
+			unit = 24 * time.Hour
+		}
+		interval := time.Duration(value) * unit
+		next := lastRun.Add(interval)
+		for !next.After(now) {
+			next = next.Add(interval)
+		}
+		return next, true
+	case strings.HasPrefix(schedule, "cron(") && strings.HasSuffix(schedule, ")"):
+		sched, err := parseAWSCron(strings.TrimSuffix(strings.TrimPrefix(schedule, "cron("), ")"))
+		if err != nil {
+			return time.Time{}, false
+		}
+		// EventBridge evaluates cron expressions in UTC.
+		return sched.Next(now.UTC()), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// parseAWSCron converts a 6-field AWS cron expression such as "0 9 ? * 2-6 *"
+// into the standard cron schedule "0 9 * * 1-5".
+func parseAWSCron(expr string) (cron.Schedule, error) {
+	const (
+		MIN = iota
+		HOU
+		DOM
+		MON
+		DOW
+		YEA
+	)
+	fields := strings.Fields(expr)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression %s must have 6 fields", expr)
+	}
+	if strings.ContainsAny(expr, "LW#") || fields[YEA] != "*" {
+		return nil, fmt.Errorf("cron expression %s is not supported", expr)
+	}
+	for _, i := range []int{DOM, DOW} {
+		if fields[i] == "?" {
+			fields[i] = "*"
+		}
+	}
+	// AWS days of week run 1-7 instead of 0-6.
+	var dow []rune
+	for _, c := range fields[DOW] {
+		if unicode.IsDigit(c) {
+			c = c - 1
+		}
+		dow = append(dow, c)
+	}
+	fields[DOW] = string(dow)
+	return cron.ParseStandard(strings.Join(fields[MIN:YEA], " "))
+}
+
+// JSONString returns the stringified jobStatus struct with json format.
+func (s *jobStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal job status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified jobStatus struct with human readable format.
+func (s *jobStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+
+	if s.Schedule != "" {
+		fmt.Fprint(writer, color.Bold.Sprint("Schedule\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\n", "Expression", s.Schedule)
+		nextRun := "-"
+		if s.NextRun != nil {
+			nextRun = fmt.Sprintf("%s (%s)", humanizeTime(*s.NextRun), s.NextRun.UTC().Format(time.RFC3339))
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", "Next Run", nextRun)
+		writer.Flush()
+		fmt.Fprint(writer, "\n")
+	}
+
+	fmt.Fprint(writer, color.Bold.Sprint("Executions\n\n"))
+	writer.Flush()
+	s.writeExecutions(writer)
+	writer.Flush()
+
+	if len(s.Alarms) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
+		writer.Flush()
+		writeAlarms(writer, s.Alarms)
+		writer.Flush()
+	}
+	return b.String()
+}
+
+func (s *jobStatus) writeExecutions(writer io.Writer) {
+	if len(s.Executions) == 0 {
+		fmt.Fprint(writer, "  The job has not run yet.\n")
+		return
+	}
+	headers := []string{"Name", "Status", "Started At", "Duration", "Retries", "Failure Cause"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, e := range s.Executions {
+		duration := e.Duration
+		if duration == "" {
+			duration = "-"
+		}
+		cause := e.FailureCause
+		if cause == "" {
+			cause = "-"
+		}
+		printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", maxFailureCauseColumnWidth,
+			shortExecutionName(e.Name), statusColor(e.Status), humanizeTime(e.StartedAt), duration, strconv.Itoa(e.Retries), cause)
+	}
+}
+
+// shortExecutionName truncates the generated name of an execution the same way task IDs are shortened.
+func shortExecutionName(name string) string {
+	if len(name) <= shortTaskIDLength {
+		return name
+	}
+	return name[:shortTaskIDLength]
+}
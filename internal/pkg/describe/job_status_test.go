@@ -0,0 +1,334 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobStatusDescriberMocks struct {
+	stateMachineGetter *mocks.MockstateMachineGetter
+	executionsGetter   *mocks.MockexecutionsGetter
+	alarmStatusGetter  *mocks.MockalarmStatusGetter
+	stackDescriber     *mocks.MockstackDescriber
+}
+
+func TestJobStatusDescriber_Describe(t *testing.T) {
+	const (
+		mockStateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:mockApp-mockEnv-mockJob"
+		mockExecutionARN1   = "arn:aws:states:us-west-2:123456789012:execution:mockApp-mockEnv-mockJob:d7e8ac18-2a6f-4d52-8a7c-1b2f2f7e0a31"
+		mockExecutionARN2   = "arn:aws:states:us-west-2:123456789012:execution:mockApp-mockEnv-mockJob:6a1b3c2e-91d0-4f3e-b0a1-0c3b9f1d2e44"
+	)
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	startTime := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	stopTime := time.Date(2021, 9, 1, 9, 3, 30, 0, time.UTC)
+	updateTime := time.Date(2021, 9, 1, 9, 5, 0, 0, time.UTC)
+	nextRun := time.Date(2021, 9, 2, 9, 0, 0, 0, time.UTC)
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m jobStatusDescriberMocks)
+
+		wantedError   error
+		wantedContent *jobStatus
+	}{
+		"errors if failed to get the state machine": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("mockApp", "mockEnv", "mockJob").Return("", mockError)
+			},
+			wantedError: fmt.Errorf("get state machine of job mockJob: some error"),
+		},
+		"errors if failed to list executions": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("mockApp", "mockEnv", "mockJob").Return(mockStateMachineARN, nil)
+				m.executionsGetter.EXPECT().Executions(mockStateMachineARN, 5).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get executions of job mockJob: some error"),
+		},
+		"errors if failed to get the history of an execution": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("mockApp", "mockEnv", "mockJob").Return(mockStateMachineARN, nil)
+				m.executionsGetter.EXPECT().Executions(mockStateMachineARN, 5).Return([]*stepfunctions.Execution{
+					{ARN: mockExecutionARN1},
+				}, nil)
+				m.executionsGetter.EXPECT().ExecutionHistory(mockExecutionARN1).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get history of job mockJob: some error"),
+		},
+		"errors if failed to describe the stack": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("mockApp", "mockEnv", "mockJob").Return(mockStateMachineARN, nil)
+				m.executionsGetter.EXPECT().Executions(mockStateMachineARN, 5).Return(nil, nil)
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, mockError)
+			},
+			wantedError: mockError,
+		},
+		"errors if failed to get alarms": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("mockApp", "mockEnv", "mockJob").Return(mockStateMachineARN, nil)
+				m.executionsGetter.EXPECT().Executions(mockStateMachineARN, 5).Return(nil, nil)
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, nil)
+				m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get tagged CloudWatch alarms: some error"),
+		},
+		"success": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("mockApp", "mockEnv", "mockJob").Return(mockStateMachineARN, nil)
+				m.executionsGetter.EXPECT().Executions(mockStateMachineARN, 5).Return([]*stepfunctions.Execution{
+					{
+						ARN:       mockExecutionARN1,
+						Status:    stepfunctions.ExecutionStatusRunning,
+						StartDate: startTime,
+					},
+					{
+						ARN:       mockExecutionARN2,
+						Status:    "FAILED",
+						StartDate: startTime.Add(-24 * time.Hour),
+						StopDate:  stopTime.Add(-24 * time.Hour),
+					},
+				}, nil)
+				m.executionsGetter.EXPECT().ExecutionHistory(mockExecutionARN1).Return(&stepfunctions.ExecutionHistory{
+					Tasks: []*stepfunctions.ExecutionTask{{TaskARN: "task3"}},
+				}, nil)
+				m.executionsGetter.EXPECT().ExecutionHistory(mockExecutionARN2).Return(&stepfunctions.ExecutionHistory{
+					Tasks: []*stepfunctions.ExecutionTask{{TaskARN: "task1"}, {TaskARN: "task2"}},
+					Error: "States.TaskFailed",
+					Cause: `{"LastStatus":"STOPPED","StoppedReason":"Essential container in task exited"}`,
+				}, nil)
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+					Parameters: map[string]string{
+						"Schedule": "cron(0 9 * * ? *)",
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().AlarmsWithTags(map[string]string{
+					"copilot-application": "mockApp",
+					"copilot-environment": "mockEnv",
+					"copilot-service":     "mockJob",
+				}).Return([]cloudwatch.AlarmStatus{
+					{
+						Name:         "mockAlarm",
+						Condition:    "mockCondition",
+						Status:       "OK",
+						UpdatedTimes: updateTime,
+					},
+				}, nil)
+			},
+			wantedContent: &jobStatus{
+				Schedule: "cron(0 9 * * ? *)",
+				NextRun:  &nextRun,
+				Executions: []jobExecution{
+					{
+						Name:      "d7e8ac18-2a6f-4d52-8a7c-1b2f2f7e0a31",
+						Status:    "RUNNING",
+						StartedAt: startTime,
+					},
+					{
+						Name:         "6a1b3c2e-91d0-4f3e-b0a1-0c3b9f1d2e44",
+						Status:       "FAILED",
+						StartedAt:    startTime.Add(-24 * time.Hour),
+						StoppedAt:    timePtr(stopTime.Add(-24 * time.Hour)),
+						Duration:     "3m30s",
+						Retries:      1,
+						FailureCause: "Essential container in task exited",
+					},
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Name:         "mockAlarm",
+						Condition:    "mockCondition",
+						Status:       "OK",
+						UpdatedTimes: updateTime,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobStatusDescriberMocks{
+				stateMachineGetter: mocks.NewMockstateMachineGetter(ctrl),
+				executionsGetter:   mocks.NewMockexecutionsGetter(ctrl),
+				alarmStatusGetter:  mocks.NewMockalarmStatusGetter(ctrl),
+				stackDescriber:     mocks.NewMockstackDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &jobStatusDescriber{
+				app:                "mockApp",
+				env:                "mockEnv",
+				job:                "mockJob",
+				limit:              5,
+				stateMachineGetter: m.stateMachineGetter,
+				executionsGetter:   m.executionsGetter,
+				cwSvcGetter:        m.alarmStatusGetter,
+				stackDescriber:     m.stackDescriber,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			// WHEN
+			status, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, status)
+			}
+		})
+	}
+}
+
+func Test_nextScheduledRun(t *testing.T) {
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC) // A Wednesday.
+	testCases := map[string]struct {
+		schedule string
+		lastRun  time.Time
+
+		wantedNextRun time.Time
+		wantedOK      bool
+	}{
+		"no schedule": {
+			schedule: "",
+		},
+		"daily cron": {
+			schedule:      "cron(0 9 * * ? *)",
+			wantedNextRun: time.Date(2021, 9, 2, 9, 0, 0, 0, time.UTC),
+			wantedOK:      true,
+		},
+		"cron on AWS days of week": {
+			schedule:      "cron(30 8 ? * 2 *)",
+			wantedNextRun: time.Date(2021, 9, 6, 8, 30, 0, 0, time.UTC),
+			wantedOK:      true,
+		},
+		"cron with a last day of month is not supported": {
+			schedule: "cron(0 9 L * ? *)",
+		},
+		"rate without a previous run": {
+			schedule: "rate(1 hour)",
+		},
+		"rate is estimated from the last run": {
+			schedule:      "rate(90 minutes)",
+			lastRun:       time.Date(2021, 9, 1, 8, 15, 0, 0, time.UTC),
+			wantedNextRun: time.Date(2021, 9, 1, 12, 45, 0, 0, time.UTC),
+			wantedOK:      true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			next, ok := nextScheduledRun(tc.schedule, tc.lastRun, now)
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedNextRun, next)
+		})
+	}
+}
+
+func TestJobStatus_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-09-01T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startTime := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	stopTime := time.Date(2021, 9, 1, 9, 3, 30, 0, time.UTC)
+	nextRun := time.Date(2021, 9, 2, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		status *jobStatus
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no executions": {
+			status: &jobStatus{
+				Executions: []jobExecution{},
+				Alarms:     []cloudwatch.AlarmStatus{},
+			},
+			wantedHumanString: `Executions
+
+  The job has not run yet.
+`,
+			wantedJSONString: "{\"executions\":[],\"alarms\":[]}\n",
+		},
+		"with executions, schedule and alarms": {
+			status: &jobStatus{
+				Schedule: "cron(0 9 * * ? *)",
+				NextRun:  &nextRun,
+				Executions: []jobExecution{
+					{
+						Name:         "6a1b3c2e-91d0-4f3e-b0a1-0c3b9f1d2e44",
+						Status:       "FAILED",
+						StartedAt:    startTime,
+						StoppedAt:    &stopTime,
+						Duration:     "3m30s",
+						Retries:      1,
+						FailureCause: "Essential container in task exited",
+					},
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Name:         "mockAlarm",
+						Condition:    "mockCondition",
+						Status:       "OK",
+						UpdatedTimes: startTime,
+					},
+				},
+			},
+			wantedHumanString: `Schedule
+
+  Expression  cron(0 9 * * ? *)
+  Next Run    21 hours from now (2021-09-02T09:00:00Z)
+
+Executions
+
+  Name      Status      Started At   Duration    Retries     Failure Cause
+  ----      ------      ----------   --------    -------     -------------
+  6a1b3c2e  FAILED      3 hours ago  3m30s       1           Essential container in task exited
+
+Alarms
+
+  Name       Condition      Last Updated  Health
+  ----       ---------      ------------  ------
+  mockAlarm  mockCondition  3 hours ago   OK
+                                          
+`,
+			wantedJSONString: "{\"schedule\":\"cron(0 9 * * ? *)\",\"nextRun\":\"2021-09-02T09:00:00Z\",\"executions\":[{\"name\":\"6a1b3c2e-91d0-4f3e-b0a1-0c3b9f1d2e44\",\"status\":\"FAILED\",\"startedAt\":\"2021-09-01T09:00:00Z\",\"stoppedAt\":\"2021-09-01T09:03:30Z\",\"duration\":\"3m30s\",\"retries\":1,\"failureCause\":\"Essential container in task exited\"}],\"alarms\":[{\"arn\":\"\",\"name\":\"mockAlarm\",\"condition\":\"mockCondition\",\"status\":\"OK\",\"type\":\"\",\"updatedTimes\":\"2021-09-01T09:00:00Z\"}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.status.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSONString, json)
+
+			human := tc.status.HumanString()
+			require.Equal(t, tc.wantedHumanString, human)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
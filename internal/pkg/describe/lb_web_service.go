@@ -23,8 +23,12 @@ import (
 )
 
 const (
-	envOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
-	envOutputSubdomain                 = "EnvironmentSubdomain"
+	envOutputPublicLoadBalancerDNSName   = "PublicLoadBalancerDNSName"
+	envOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
+	envOutputSubdomain                   = "EnvironmentSubdomain"
+
+	svcOutputPublicNLBDNSName = "PublicNetworkLoadBalancerDNSName"
+	svcOutputPublicNLBPort    = "PublicNetworkLoadBalancerPort"
 )
 
 type envDescriber interface {
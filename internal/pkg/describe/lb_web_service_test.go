@@ -74,6 +74,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("", errors.New("some error")),
 				)
 			},
@@ -94,6 +95,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsStackDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
@@ -115,6 +117,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsStackDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
@@ -145,6 +148,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsStackDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
@@ -187,6 +191,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsStackDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
@@ -213,6 +218,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "1024",
 						cfnstack.LBWebServiceRulePathParamKey:      prodSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
 					m.ecsStackDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
@@ -10,8 +10,11 @@ import (
 	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -311,3 +314,117 @@ func (mr *MockautoscalingAlarmNamesGetterMockRecorder) ECSServiceAlarmNames(clus
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingAlarmNamesGetter)(nil).ECSServiceAlarmNames), cluster, service)
 }
+
+// MockresourcesGetter is a mock of resourcesGetter interface.
+type MockresourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourcesGetterMockRecorder
+}
+
+// MockresourcesGetterMockRecorder is the mock recorder for MockresourcesGetter.
+type MockresourcesGetterMockRecorder struct {
+	mock *MockresourcesGetter
+}
+
+// NewMockresourcesGetter creates a new mock instance.
+func NewMockresourcesGetter(ctrl *gomock.Controller) *MockresourcesGetter {
+	mock := &MockresourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockresourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourcesGetter) EXPECT() *MockresourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// GetResourcesByTags mocks base method.
+func (m *MockresourcesGetter) GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourcesByTags", resourceType, tags)
+	ret0, _ := ret[0].([]*resourcegroups.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourcesByTags indicates an expected call of GetResourcesByTags.
+func (mr *MockresourcesGetterMockRecorder) GetResourcesByTags(resourceType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourcesGetter)(nil).GetResourcesByTags), resourceType, tags)
+}
+
+// MockqueueDepthGetter is a mock of queueDepthGetter interface.
+type MockqueueDepthGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueDepthGetterMockRecorder
+}
+
+// MockqueueDepthGetterMockRecorder is the mock recorder for MockqueueDepthGetter.
+type MockqueueDepthGetterMockRecorder struct {
+	mock *MockqueueDepthGetter
+}
+
+// NewMockqueueDepthGetter creates a new mock instance.
+func NewMockqueueDepthGetter(ctrl *gomock.Controller) *MockqueueDepthGetter {
+	mock := &MockqueueDepthGetter{ctrl: ctrl}
+	mock.recorder = &MockqueueDepthGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueDepthGetter) EXPECT() *MockqueueDepthGetterMockRecorder {
+	return m.recorder
+}
+
+// QueueDepth mocks base method.
+func (m *MockqueueDepthGetter) QueueDepth(queueARN string) (*sqs.QueueDepth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueDepth", queueARN)
+	ret0, _ := ret[0].(*sqs.QueueDepth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueDepth indicates an expected call of QueueDepth.
+func (mr *MockqueueDepthGetterMockRecorder) QueueDepth(queueARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueDepth", reflect.TypeOf((*MockqueueDepthGetter)(nil).QueueDepth), queueARN)
+}
+
+// MockimageScanGetter is a mock of imageScanGetter interface.
+type MockimageScanGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageScanGetterMockRecorder
+}
+
+// MockimageScanGetterMockRecorder is the mock recorder for MockimageScanGetter.
+type MockimageScanGetterMockRecorder struct {
+	mock *MockimageScanGetter
+}
+
+// NewMockimageScanGetter creates a new mock instance.
+func NewMockimageScanGetter(ctrl *gomock.Controller) *MockimageScanGetter {
+	mock := &MockimageScanGetter{ctrl: ctrl}
+	mock.recorder = &MockimageScanGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageScanGetter) EXPECT() *MockimageScanGetterMockRecorder {
+	return m.recorder
+}
+
+// ImageScanFindings mocks base method.
+func (m *MockimageScanGetter) ImageScanFindings(repoName, digest string) (*ecr.ImageScanFindings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScanFindings", repoName, digest)
+	ret0, _ := ret[0].(*ecr.ImageScanFindings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScanFindings indicates an expected call of ImageScanFindings.
+func (mr *MockimageScanGetterMockRecorder) ImageScanFindings(repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScanFindings", reflect.TypeOf((*MockimageScanGetter)(nil).ImageScanFindings), repoName, digest)
+}
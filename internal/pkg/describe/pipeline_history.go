@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// DefaultPipelineHistoryLimit is the number of most recent executions displayed by default.
+	DefaultPipelineHistoryLimit = 10
+	// MaxPipelineHistoryLimit is the maximum number of executions that CodePipeline lists at once.
+	MaxPipelineHistoryLimit = 100
+
+	gitCommitIDLength   = 40
+	shortCommitIDLength = 7
+)
+
+type pipelineExecutionLister interface {
+	ListExecutions(pipelineName string, maxResults int) ([]*codepipeline.Execution, error)
+}
+
+// PipelineHistoryDescriber retrieves the previous executions of a pipeline.
+type PipelineHistoryDescriber struct {
+	pipelineName string
+	limit        int
+	pipelineSvc  pipelineExecutionLister
+}
+
+// NewPipelineHistoryDescriber instantiates a new PipelineHistoryDescriber struct that describes
+// at most limit executions of the pipeline.
+func NewPipelineHistoryDescriber(pipelineName string, limit int) (*PipelineHistoryDescriber, error) {
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultPipelineHistoryLimit
+	}
+	return &PipelineHistoryDescriber{
+		pipelineName: pipelineName,
+		limit:        limit,
+		pipelineSvc:  codepipeline.New(sess),
+	}, nil
+}
+
+// Describe returns the most recent executions of the pipeline.
+func (d *PipelineHistoryDescriber) Describe() (HumanJSONStringer, error) {
+	executions, err := d.pipelineSvc.ListExecutions(d.pipelineName, d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("get executions of pipeline %s: %w", d.pipelineName, err)
+	}
+	if executions == nil {
+		executions = []*codepipeline.Execution{}
+	}
+	return &PipelineHistory{
+		Executions: executions,
+	}, nil
+}
+
+// PipelineHistory contains the previous executions of a pipeline.
+type PipelineHistory struct {
+	Executions []*codepipeline.Execution `json:"executions"`
+}
+
+// JSONString returns the stringified PipelineHistory struct with json format.
+func (h *PipelineHistory) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal pipeline history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified PipelineHistory struct with human readable format.
+func (h *PipelineHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Executions\n\n"))
+	writer.Flush()
+	h.writeExecutions(writer)
+	writer.Flush()
+	return b.String()
+}
+
+func (h *PipelineHistory) writeExecutions(writer io.Writer) {
+	if len(h.Executions) == 0 {
+		fmt.Fprint(writer, "  The pipeline has not run yet.\n")
+		return
+	}
+	headers := []string{"Execution ID", "Status", "Commit", "Trigger", "Started", "Duration", "Failed Actions"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, e := range h.Executions {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.ID, e.Status, humanCommitID(e.CommitID), e.Trigger,
+			humanizeTime(e.StartedAt), e.Duration().Round(time.Second), humanFailedActions(e.FailedActions))
+	}
+}
+
+// humanCommitID shortens git commit IDs, the revisions of other sources are returned as is.
+func humanCommitID(id string) string {
+	if id == "" {
+		return "-"
+	}
+	if len(id) == gitCommitIDLength {
+		return id[:shortCommitIDLength]
+	}
+	return id
+}
+
+func humanFailedActions(actions []codepipeline.ActionExecution) string {
+	if len(actions) == 0 {
+		return "-"
+	}
+	var names []string
+	for _, action := range actions {
+		names = append(names, action.ActionName)
+	}
+	return strings.Join(names, ", ")
+}
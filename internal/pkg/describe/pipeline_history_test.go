@@ -0,0 +1,152 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineHistoryDescriber_Describe(t *testing.T) {
+	startedAt := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockpipelineExecutionLister)
+
+		wantedError   error
+		wantedContent *PipelineHistory
+	}{
+		"errors if failed to list executions": {
+			setupMocks: func(m *mocks.MockpipelineExecutionLister) {
+				m.EXPECT().ListExecutions("pipeline-phonetool-repo", 5).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get executions of pipeline pipeline-phonetool-repo: some error"),
+		},
+		"returns an empty history if the pipeline has not run": {
+			setupMocks: func(m *mocks.MockpipelineExecutionLister) {
+				m.EXPECT().ListExecutions("pipeline-phonetool-repo", 5).Return(nil, nil)
+			},
+			wantedContent: &PipelineHistory{
+				Executions: []*codepipeline.Execution{},
+			},
+		},
+		"returns the executions of the pipeline": {
+			setupMocks: func(m *mocks.MockpipelineExecutionLister) {
+				m.EXPECT().ListExecutions("pipeline-phonetool-repo", 5).Return([]*codepipeline.Execution{
+					{ID: "exec-1", Status: "Succeeded", StartedAt: startedAt},
+				}, nil)
+			},
+			wantedContent: &PipelineHistory{
+				Executions: []*codepipeline.Execution{
+					{ID: "exec-1", Status: "Succeeded", StartedAt: startedAt},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockpipelineExecutionLister(ctrl)
+			tc.setupMocks(m)
+			d := &PipelineHistoryDescriber{
+				pipelineName: "pipeline-phonetool-repo",
+				limit:        5,
+				pipelineSvc:  m,
+			}
+
+			// WHEN
+			history, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, history)
+			}
+		})
+	}
+}
+
+func TestPipelineHistory_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-09-01T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startedAt := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		history *PipelineHistory
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no executions": {
+			history: &PipelineHistory{
+				Executions: []*codepipeline.Execution{},
+			},
+			wantedHumanString: `Executions
+
+  The pipeline has not run yet.
+`,
+			wantedJSONString: "{\"executions\":[]}\n",
+		},
+		"with executions": {
+			history: &PipelineHistory{
+				Executions: []*codepipeline.Execution{
+					{
+						ID:        "exec-2",
+						Status:    "Failed",
+						CommitID:  "a1b2c3d4e5f6a7b8c9d0a1b2c3d4e5f6a7b8c9d0",
+						Trigger:   "Webhook",
+						StartedAt: startedAt,
+						UpdatedAt: startedAt.Add(4*time.Minute + 30*time.Second),
+						FailedActions: []codepipeline.ActionExecution{
+							{StageName: "DeployTo-test", ActionName: "TestCommands", Status: "Failed", StartedAt: startedAt},
+						},
+					},
+					{
+						ID:        "exec-1",
+						Status:    "Succeeded",
+						Trigger:   "StartPipelineExecution",
+						StartedAt: startedAt,
+						UpdatedAt: startedAt.Add(10 * time.Minute),
+					},
+				},
+			},
+			wantedHumanString: `Executions
+
+  Execution ID  Status      Commit      Trigger                 Started      Duration    Failed Actions
+  ------------  ------      ------      -------                 -------      --------    --------------
+  exec-2        Failed      a1b2c3d     Webhook                 3 hours ago  4m30s       TestCommands
+  exec-1        Succeeded   -           StartPipelineExecution  3 hours ago  10m0s       -
+`,
+			wantedJSONString: "{\"executions\":[{\"id\":\"exec-2\",\"status\":\"Failed\",\"commitId\":\"a1b2c3d4e5f6a7b8c9d0a1b2c3d4e5f6a7b8c9d0\",\"trigger\":\"Webhook\",\"startedAt\":\"2021-09-01T09:00:00Z\",\"updatedAt\":\"2021-09-01T09:04:30Z\",\"failedActions\":[{\"stageName\":\"DeployTo-test\",\"actionName\":\"TestCommands\",\"status\":\"Failed\",\"startedAt\":\"2021-09-01T09:00:00Z\"}]},{\"id\":\"exec-1\",\"status\":\"Succeeded\",\"trigger\":\"StartPipelineExecution\",\"startedAt\":\"2021-09-01T09:00:00Z\",\"updatedAt\":\"2021-09-01T09:10:00Z\"}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.history.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSONString, json)
+
+			human := tc.history.HumanString()
+			require.Equal(t, tc.wantedHumanString, human)
+		})
+	}
+}
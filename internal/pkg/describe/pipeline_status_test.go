@@ -68,8 +68,12 @@ var mockPipelineState = &codepipeline.PipelineState{
 					Status: "Succeeded",
 				},
 				{
-					Name:   "TestCommands",
-					Status: "Failed",
+					Name:    "TestCommands",
+					Status:  "Failed",
+					Summary: "Error while executing command: make test",
+				},
+				{
+					Name: "smoke",
 				},
 			},
 		},
@@ -161,13 +165,14 @@ DeployTo-test       DISABLED            Succeeded
 └── action1                             Succeeded
 DeployTo-prod         -                 Failed
 ├── action1                             Succeeded
-└── TestCommands                        Failed
+├── TestCommands                        Failed              Error while executing command: make test
+└── smoke                                 -
 
 Last Deployment
 
   Updated At        4 months ago
 `,
-			expectedJSONString: "{\"pipelineName\":\"pipeline-dinder-badgoose-repo\",\"stageStates\":[{\"stageName\":\"Source\",\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"action1\",\"status\":\"Failed\"},{\"name\":\"action2\",\"status\":\"InProgress\"},{\"name\":\"action3\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"},{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"}],\"transition\":\"DISABLED\"},{\"stageName\":\"DeployTo-prod\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"},{\"name\":\"TestCommands\",\"status\":\"Failed\"}],\"transition\":\"\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
+			expectedJSONString: "{\"pipelineName\":\"pipeline-dinder-badgoose-repo\",\"stageStates\":[{\"stageName\":\"Source\",\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"action1\",\"status\":\"Failed\"},{\"name\":\"action2\",\"status\":\"InProgress\"},{\"name\":\"action3\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"},{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"}],\"transition\":\"DISABLED\"},{\"stageName\":\"DeployTo-prod\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"},{\"name\":\"TestCommands\",\"status\":\"Failed\",\"summary\":\"Error while executing command: make test\"},{\"name\":\"smoke\",\"status\":\"\"}],\"transition\":\"\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
 		},
 	}
 	for _, tc := range testCases {
@@ -19,10 +19,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 
+	ecrapi "github.com/aws/aws-sdk-go/service/ecr"
 	fcolor "github.com/fatih/color"
 )
 
@@ -46,6 +49,9 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	Queues                   []sqs.QueueDepth         `json:"queues,omitempty"`
+	ImageScans               []ecr.ImageScanFindings  `json:"imageScans,omitempty"`
+	ImageScanWarnings        []string                 `json:"imageScanWarnings,omitempty"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
@@ -127,6 +133,20 @@ func (s *ecsServiceStatus) HumanString() string {
 		s.writeAlarms(writer)
 		writer.Flush()
 	}
+
+	if len(s.Queues) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nQueues\n\n"))
+		writer.Flush()
+		s.writeQueues(writer)
+		writer.Flush()
+	}
+
+	if len(s.ImageScans) > 0 || len(s.ImageScanWarnings) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nImage Scans\n\n"))
+		writer.Flush()
+		s.writeImageScans(writer)
+		writer.Flush()
+	}
 	return b.String()
 }
 
@@ -353,12 +373,28 @@ func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 }
 
 func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
+	ECSStoppedTasks(s.StoppedTasks).write(writer)
+}
+
+// ECSStoppedTasks is a list of stopped ECS tasks summarized by the reason they stopped.
+type ECSStoppedTasks []awsecs.TaskStatus
+
+// HumanString returns the stopped tasks grouped by stopped reason, formatted like the stopped tasks of a service status.
+func (t ECSStoppedTasks) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	t.write(writer)
+	writer.Flush()
+	return b.String()
+}
+
+func (t ECSStoppedTasks) write(writer io.Writer) {
 	headers := []string{"Reason", "Task Count", "Sample Task IDs"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
 
 	reasonToTasks := make(map[string][]string)
-	for _, task := range s.StoppedTasks {
+	for _, task := range t {
 		reasonToTasks[task.StoppedReason] = append(reasonToTasks[task.StoppedReason], shortTaskID(task.ID))
 	}
 	for reason, ids := range reasonToTasks {
@@ -413,10 +449,46 @@ func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
 }
 
 func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
+	writeAlarms(writer, s.Alarms)
+}
+
+func (s *ecsServiceStatus) writeQueues(writer io.Writer) {
+	headers := []string{"Name", "Visible", "In Flight", "Delayed"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, q := range s.Queues {
+		fmt.Fprintf(writer, "  %s\t%d\t%d\t%d\n", q.Name, q.Visible, q.InFlight, q.Delayed)
+	}
+}
+
+func (s *ecsServiceStatus) writeImageScans(writer io.Writer) {
+	for _, warning := range s.ImageScanWarnings {
+		fmt.Fprintf(writer, "  %s\n", color.Yellow.Sprintf("Warning: %s", warning))
+	}
+	if len(s.ImageScans) == 0 {
+		return
+	}
+	headers := []string{"Digest", "Status", "Scanned", "Findings"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, scan := range s.ImageScans {
+		digest := scan.Digest
+		if len(digest) > shortDigestLength {
+			digest = digest[:shortDigestLength]
+		}
+		scanned, findings := "-", scan.StatusDescription
+		if scan.Status == ecrapi.ScanStatusComplete {
+			scanned, findings = humanizeTime(scan.CompletedAt), scan.Summary()
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", digest, imageScanStatusColor(scan.Status), scanned, findings)
+	}
+}
+
+func writeAlarms(writer io.Writer, alarms []cloudwatch.AlarmStatus) {
 	headers := []string{"Name", "Condition", "Last Updated", "Health"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, alarm := range s.Alarms {
+	for _, alarm := range alarms {
 		updatedTimeSince := humanizeTime(alarm.UpdatedTimes)
 		printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, alarm.Name, alarm.Condition, updatedTimeSince, alarmHealthColor(alarm.Status))
 		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "", "", "", "")
@@ -549,9 +621,22 @@ func statusColor(status string) string {
 		return color.Yellow.Sprint(status)
 	case "RUNNING":
 		return color.Green.Sprint(status)
+	case "SUCCEEDED":
+		return color.Green.Sprint(status)
 	case "UPDATING":
 		return color.Yellow.Sprint(status)
 	default:
 		return color.Red.Sprint(status)
 	}
 }
+
+func imageScanStatusColor(status string) string {
+	switch status {
+	case ecrapi.ScanStatusComplete:
+		return color.Green.Sprint(status)
+	case ecrapi.ScanStatusInProgress:
+		return color.Yellow.Sprint(status)
+	default:
+		return color.Red.Sprint(status)
+	}
+}
@@ -4,22 +4,31 @@
 package describe
 
 import (
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
 
-const fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+const (
+	fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+	imageDigestPrefix           = "sha256:"
+)
 
 type targetHealthGetter interface {
 	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
@@ -51,6 +60,18 @@ type autoscalingAlarmNamesGetter interface {
 	ECSServiceAlarmNames(cluster, service string) ([]string, error)
 }
 
+type resourcesGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
+}
+
+type queueDepthGetter interface {
+	QueueDepth(queueARN string) (*sqs.QueueDepth, error)
+}
+
+type imageScanGetter interface {
+	ImageScanFindings(repoName, digest string) (*ecr.ImageScanFindings, error)
+}
+
 type ecsStatusDescriber struct {
 	app string
 	env string
@@ -61,6 +82,9 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	queueLister        resourcesGetter  // Only set for Worker Services.
+	queueDepthGetter   queueDepthGetter // Only set for Worker Services.
+	imageScanGetter    imageScanGetter  // Only set if the image of the service is scanned.
 }
 
 type appRunnerStatusDescriber struct {
@@ -78,6 +102,7 @@ type NewServiceStatusConfig struct {
 	Env         string
 	Svc         string
 	ConfigStore ConfigStoreSvc
+	ImageScan   bool // Whether the image of the service is scanned for vulnerabilities when it's deployed.
 }
 
 // NewECSStatusDescriber instantiates a new ecsStatusDescriber struct.
@@ -86,11 +111,15 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 	if err != nil {
 		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
 	}
+	wkld, err := opt.ConfigStore.GetWorkload(opt.App, opt.Svc)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", opt.Svc, err)
+	}
 	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
-	return &ecsStatusDescriber{
+	d := &ecsStatusDescriber{
 		app:                opt.App,
 		env:                opt.Env,
 		svc:                opt.Svc,
@@ -99,7 +128,21 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		ecsSvcGetter:       awsecs.New(sess),
 		aasSvcGetter:       aas.New(sess),
 		targetHealthGetter: elbv2.New(sess),
-	}, nil
+	}
+	if wkld.Type == manifest.WorkerServiceType {
+		d.queueLister = resourcegroups.New(sess)
+		d.queueDepthGetter = sqs.New(sess)
+	}
+	if !opt.ImageScan {
+		return d, nil
+	}
+	// The ECR repository of the service is in the application account.
+	defaultSess, err := sessions.NewProvider().DefaultWithRegion(env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create default session with region %s: %w", env.Region, err)
+	}
+	d.imageScanGetter = ecr.New(defaultSess)
+	return d, nil
 }
 
 // NewAppRunnerStatusDescriber instantiates a new appRunnerStatusDescriber struct.
@@ -153,7 +196,8 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		stoppedTaskStatus = append(stoppedTaskStatus, *status)
 	}
 
-	var alarms []cloudwatch.AlarmStatus
+	// The alarms are serialized as an empty list instead of null if the service has none.
+	alarms := []cloudwatch.AlarmStatus{}
 	taggedAlarms, err := s.cwSvcGetter.AlarmsWithTags(map[string]string{
 		deploy.AppTagKey:     s.app,
 		deploy.EnvTagKey:     s.env,
@@ -169,6 +213,13 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 	}
 	alarms = append(alarms, autoscalingAlarms...)
 
+	queues, err := s.queueDepths()
+	if err != nil {
+		return nil, err
+	}
+
+	imageScans, imageScanWarnings := s.imageScans(taskStatus)
+
 	var tasksTargetHealth []taskTargetHealth
 	targetGroupsARN := service.TargetGroups()
 	for _, groupARN := range targetGroupsARN {
@@ -191,9 +242,84 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		Queues:                   queues,
+		ImageScans:               imageScans,
+		ImageScanWarnings:        imageScanWarnings,
 	}, nil
 }
 
+// queueDepths returns the approximate number of messages in each SQS queue of a Worker Service.
+func (s *ecsStatusDescriber) queueDepths() ([]sqs.QueueDepth, error) {
+	if s.queueLister == nil {
+		return nil, nil
+	}
+	resources, err := s.queueLister.GetResourcesByTags(resourcegroups.ResourceTypeSQSQueue, map[string]string{
+		deploy.AppTagKey:     s.app,
+		deploy.EnvTagKey:     s.env,
+		deploy.ServiceTagKey: s.svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get SQS queues of service %s: %w", s.svc, err)
+	}
+	var queues []sqs.QueueDepth
+	for _, resource := range resources {
+		depth, err := s.queueDepthGetter.QueueDepth(resource.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("get depth of queue %s: %w", resource.ARN, err)
+		}
+		queues = append(queues, *depth)
+	}
+	sort.SliceStable(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	return queues, nil
+}
+
+// imageScans returns the results of the latest scan of each image pushed by Copilot that runs in the tasks.
+// Images that were never scanned are skipped, and the findings that can't be retrieved are returned as warnings
+// so that the rest of the status is still shown.
+func (s *ecsStatusDescriber) imageScans(tasks []awsecs.TaskStatus) ([]ecr.ImageScanFindings, []string) {
+	if s.imageScanGetter == nil {
+		return nil, nil
+	}
+	repoName := fmt.Sprintf("%s/%s", s.app, s.svc)
+	var scans []ecr.ImageScanFindings
+	var warnings []string
+	scanned := make(map[string]bool)
+	for _, task := range tasks {
+		for _, image := range task.Images {
+			if image.Digest == "" || scanned[image.Digest] || !isECRImageOf(image.ID, repoName) {
+				continue
+			}
+			scanned[image.Digest] = true
+			// The digests of the images of a task are stored without their algorithm.
+			digest := imageDigestPrefix + image.Digest
+			findings, err := s.imageScanGetter.ImageScanFindings(repoName, digest)
+			if err != nil {
+				var errNotFound *ecr.ErrScanNotFound
+				if !errors.As(err, &errNotFound) {
+					warnings = append(warnings, fmt.Sprintf("get scan findings of image %s: %v", digest, err))
+				}
+				continue
+			}
+			scans = append(scans, *findings)
+		}
+	}
+	return scans, warnings
+}
+
+// isECRImageOf returns true if the image is in the ECR repository named repoName.
+func isECRImageOf(imageID, repoName string) bool {
+	name := imageID
+	if i := strings.Index(name, "@"); i != -1 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return strings.Contains(name, ".dkr.ecr.") && strings.HasSuffix(name, "/"+repoName)
+}
+
 func (s *ecsStatusDescriber) ecsServiceAutoscalingAlarms(cluster, service string) ([]cloudwatch.AlarmStatus, error) {
 	alarmNames, err := s.aasSvcGetter.ECSServiceAlarmNames(cluster, service)
 	if err != nil {
@@ -15,8 +15,11 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
@@ -185,7 +188,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 					},
 					LastDeploymentAt: startTime,
 				},
-				Alarms: nil,
+				Alarms: []cloudwatch.AlarmStatus{},
 				DesiredRunningTasks: []awsecs.TaskStatus{
 					{
 						ID:        "1234567890123456789",
@@ -314,7 +317,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 					LastDeploymentAt: startTime,
 					TaskDefinition:   "mockTaskDefinition",
 				},
-				Alarms: nil,
+				Alarms: []cloudwatch.AlarmStatus{},
 				DesiredRunningTasks: []awsecs.TaskStatus{
 					{
 						ID: "task-with-private-ip-being-target",
@@ -529,6 +532,220 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
+func TestECSStatusDescriber_queueDepths(t *testing.T) {
+	const (
+		mockEventsQueueARN = "arn:aws:sqs:us-west-2:123456789012:mockApp-mockEnv-mockSvc-EventsQueue"
+		mockDLQARN         = "arn:aws:sqs:us-west-2:123456789012:mockApp-mockEnv-mockSvc-DeadLetterQueue"
+	)
+	testCases := map[string]struct {
+		isWorker   bool
+		setupMocks func(lister *mocks.MockresourcesGetter, getter *mocks.MockqueueDepthGetter)
+
+		wanted      []sqs.QueueDepth
+		wantedError error
+	}{
+		"returns nothing if the service is not a worker service": {
+			setupMocks: func(lister *mocks.MockresourcesGetter, getter *mocks.MockqueueDepthGetter) {},
+		},
+		"errors if failed to list the queues of the service": {
+			isWorker: true,
+			setupMocks: func(lister *mocks.MockresourcesGetter, getter *mocks.MockqueueDepthGetter) {
+				lister.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get SQS queues of service mockSvc: some error"),
+		},
+		"errors if failed to get the depth of a queue": {
+			isWorker: true,
+			setupMocks: func(lister *mocks.MockresourcesGetter, getter *mocks.MockqueueDepthGetter) {
+				lister.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{ARN: mockEventsQueueARN},
+				}, nil)
+				getter.EXPECT().QueueDepth(mockEventsQueueARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get depth of queue %s: some error", mockEventsQueueARN),
+		},
+		"returns the depth of every queue sorted by name": {
+			isWorker: true,
+			setupMocks: func(lister *mocks.MockresourcesGetter, getter *mocks.MockqueueDepthGetter) {
+				lister.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeSQSQueue, map[string]string{
+					"copilot-application": "mockApp",
+					"copilot-environment": "mockEnv",
+					"copilot-service":     "mockSvc",
+				}).Return([]*resourcegroups.Resource{
+					{ARN: mockEventsQueueARN},
+					{ARN: mockDLQARN},
+				}, nil)
+				getter.EXPECT().QueueDepth(mockEventsQueueARN).Return(&sqs.QueueDepth{
+					Name:     "mockApp-mockEnv-mockSvc-EventsQueue",
+					Visible:  42,
+					InFlight: 3,
+				}, nil)
+				getter.EXPECT().QueueDepth(mockDLQARN).Return(&sqs.QueueDepth{
+					Name:    "mockApp-mockEnv-mockSvc-DeadLetterQueue",
+					Visible: 1,
+				}, nil)
+			},
+			wanted: []sqs.QueueDepth{
+				{
+					Name:    "mockApp-mockEnv-mockSvc-DeadLetterQueue",
+					Visible: 1,
+				},
+				{
+					Name:     "mockApp-mockEnv-mockSvc-EventsQueue",
+					Visible:  42,
+					InFlight: 3,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := mocks.NewMockresourcesGetter(ctrl)
+			mockGetter := mocks.NewMockqueueDepthGetter(ctrl)
+			tc.setupMocks(mockLister, mockGetter)
+			d := &ecsStatusDescriber{
+				app: "mockApp",
+				env: "mockEnv",
+				svc: "mockSvc",
+			}
+			if tc.isWorker {
+				d.queueLister = mockLister
+				d.queueDepthGetter = mockGetter
+			}
+
+			// WHEN
+			queues, err := d.queueDepths()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, queues)
+			}
+		})
+	}
+}
+
+func TestECSStatusDescriber_imageScans(t *testing.T) {
+	const (
+		mockRepoURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/mockApp/mockSvc"
+		mockDigest  = "18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+		mockDigest2 = "741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	)
+	mockTasks := []awsecs.TaskStatus{
+		{
+			Images: []awsecs.Image{
+				{ID: mockRepoURI + ":v2", Digest: mockDigest},
+				{ID: "public.ecr.aws/aws-observability/aws-otel-collector:latest", Digest: "0c2e4a8d7c6b"},
+				{ID: "123456789012.dkr.ecr.us-west-2.amazonaws.com/mockApp/mockSvc-sidecar:v1", Digest: "5d2a1f9e3b7c"},
+			},
+		},
+		{
+			Images: []awsecs.Image{
+				{ID: mockRepoURI + ":v2", Digest: mockDigest},
+			},
+		},
+		{
+			Images: []awsecs.Image{
+				{ID: mockRepoURI + "@sha256:" + mockDigest2, Digest: mockDigest2},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		tasks      []awsecs.TaskStatus
+		setupMocks func(m *mocks.MockimageScanGetter)
+
+		wanted         []ecr.ImageScanFindings
+		wantedWarnings []string
+	}{
+		"returns nothing if there is no running task": {
+			setupMocks: func(m *mocks.MockimageScanGetter) {},
+		},
+		"returns a warning if failed to get the findings of an image": {
+			tasks: mockTasks,
+			setupMocks: func(m *mocks.MockimageScanGetter) {
+				m.EXPECT().ImageScanFindings("mockApp/mockSvc", "sha256:"+mockDigest).Return(nil, errors.New("some error"))
+				m.EXPECT().ImageScanFindings("mockApp/mockSvc", "sha256:"+mockDigest2).Return(&ecr.ImageScanFindings{
+					Digest: "sha256:" + mockDigest2,
+					Status: "COMPLETE",
+				}, nil)
+			},
+			wanted: []ecr.ImageScanFindings{
+				{
+					Digest: "sha256:" + mockDigest2,
+					Status: "COMPLETE",
+				},
+			},
+			wantedWarnings: []string{
+				fmt.Sprintf("get scan findings of image sha256:%s: some error", mockDigest),
+			},
+		},
+		"returns the findings of each scanned image of the service": {
+			tasks: mockTasks,
+			setupMocks: func(m *mocks.MockimageScanGetter) {
+				m.EXPECT().ImageScanFindings("mockApp/mockSvc", "sha256:"+mockDigest).Return(&ecr.ImageScanFindings{
+					Digest: "sha256:" + mockDigest,
+					Status: "COMPLETE",
+				}, nil).Times(1)
+				m.EXPECT().ImageScanFindings("mockApp/mockSvc", "sha256:"+mockDigest2).Return(nil, &ecr.ErrScanNotFound{})
+			},
+			wanted: []ecr.ImageScanFindings{
+				{
+					Digest: "sha256:" + mockDigest,
+					Status: "COMPLETE",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockGetter := mocks.NewMockimageScanGetter(ctrl)
+			tc.setupMocks(mockGetter)
+			d := &ecsStatusDescriber{
+				app:             "mockApp",
+				env:             "mockEnv",
+				svc:             "mockSvc",
+				imageScanGetter: mockGetter,
+			}
+
+			// WHEN
+			scans, warnings := d.imageScans(tc.tasks)
+
+			// THEN
+			require.Equal(t, tc.wanted, scans)
+			require.Equal(t, tc.wantedWarnings, warnings)
+		})
+	}
+}
+
+func TestECSStatusDescriber_imageScansNotConfigured(t *testing.T) {
+	d := &ecsStatusDescriber{
+		app: "mockApp",
+		env: "mockEnv",
+		svc: "mockSvc",
+	}
+
+	scans, warnings := d.imageScans([]awsecs.TaskStatus{
+		{
+			Images: []awsecs.Image{
+				{ID: "123456789012.dkr.ecr.us-west-2.amazonaws.com/mockApp/mockSvc:v2", Digest: "18f7eb6cff6e"},
+			},
+		},
+	})
+
+	require.Nil(t, scans)
+	require.Nil(t, warnings)
+}
+
 func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 	appName := "testapp"
 	envName := "test"
@@ -10,8 +10,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 
 	"github.com/dustin/go-humanize"
@@ -32,6 +34,7 @@ func TestServiceStatusDesc_String(t *testing.T) {
 
 	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
 	stoppedTime, _ := time.Parse(time.RFC3339, "2020-03-13T20:00:30+00:00")
+	scanTime, _ := time.Parse(time.RFC3339, "2019-12-31T00:00:00+00:00")
 
 	testCases := map[string]struct {
 		desc                 *ecsServiceStatus
@@ -535,6 +538,94 @@ Tasks
   Running   ░░░░░░░░░░  0/0 desired tasks are running
 `,
 			json: `{"Service":{"desiredCount":0,"runningCount":0,"status":"ACTIVE","deployments":[{"id":"id-4","desiredCount":0,"runningCount":0,"updatedAt":"0001-01-01T00:00:00Z","launchType":"","taskDefinition":"arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6","status":"PRIMARY"}],"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":[],"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null}
+`,
+		},
+		"show queues section for worker services": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 1,
+					RunningCount: 1,
+					Status:       "ACTIVE",
+				},
+				Queues: []sqs.QueueDepth{
+					{
+						Name:     "phonetool-test-worker-EventsQueue",
+						Visible:  42,
+						InFlight: 3,
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ██████████  1/1 desired tasks are running
+
+Queues
+
+  Name                               Visible     In Flight   Delayed
+  ----                               -------     ---------   -------
+  phonetool-test-worker-EventsQueue  42          3           0
+`,
+			json: `{"Service":{"desiredCount":1,"runningCount":1,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null,"queues":[{"name":"phonetool-test-worker-EventsQueue","visible":42,"inFlight":3,"delayed":0}]}
+`,
+		},
+		"show image scans section": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 1,
+					RunningCount: 1,
+					Status:       "ACTIVE",
+				},
+				ImageScans: []ecr.ImageScanFindings{
+					{
+						Digest:      "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+						Status:      "COMPLETE",
+						CompletedAt: scanTime,
+						SeverityCounts: map[string]int{
+							"CRITICAL": 1,
+							"LOW":      3,
+						},
+					},
+					{
+						Digest:            "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+						Status:            "FAILED",
+						StatusDescription: "UnsupportedImageError",
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ██████████  1/1 desired tasks are running
+
+Image Scans
+
+  Digest               Status      Scanned     Findings
+  ------               ------      -------     --------
+  sha256:18f7eb6cff6e  COMPLETE    1 day ago   1 critical, 3 low
+  sha256:741d3e95eefa  FAILED      -           UnsupportedImageError
+`,
+			json: `{"Service":{"desiredCount":1,"runningCount":1,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null,"imageScans":[{"digest":"sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7","status":"COMPLETE","completedAt":"2019-12-31T00:00:00Z","severityCounts":{"CRITICAL":1,"LOW":3}},{"digest":"sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49","status":"FAILED","statusDescription":"UnsupportedImageError","completedAt":"0001-01-01T00:00:00Z","severityCounts":null}]}
+`,
+		},
+		"show warnings of image scans that can't be retrieved": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 1,
+					RunningCount: 1,
+					Status:       "ACTIVE",
+				},
+				ImageScanWarnings: []string{
+					"get scan findings of image sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7: some error",
+				},
+			},
+			human: `Task Summary
+
+  Running   ██████████  1/1 desired tasks are running
+
+Image Scans
+
+  Warning: get scan findings of image sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7: some error
+`,
+			json: `{"Service":{"desiredCount":1,"runningCount":1,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null,"imageScanWarnings":["get scan findings of image sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7: some error"]}
 `,
 		},
 	}
@@ -618,6 +709,27 @@ System Logs
 	}
 }
 
+func TestECSStoppedTasks_HumanString(t *testing.T) {
+	tasks := ECSStoppedTasks{
+		{
+			ID:            "S11111111111111",
+			LastStatus:    "STOPPED",
+			StoppedReason: "Essential container in task exited",
+		},
+		{
+			ID:            "S22222222222222",
+			LastStatus:    "STOPPED",
+			StoppedReason: "Essential container in task exited",
+		},
+	}
+
+	require.Equal(t, `  Reason                          Task Count  Sample Task IDs
+  ------                          ----------  ---------------
+  Essential container in task ex  2           S1111111,S2222222
+  ited                                        
+`, tasks.HumanString())
+}
+
 func TestECSTaskStatus_humanString(t *testing.T) {
 	// from the function changes (ex: from "1 month ago" to "2 months ago"). To make our tests stable,
 	oldHumanize := humanizeTime
@@ -0,0 +1,257 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// DefaultServiceHistoryLimit is the number of most recent deployments displayed by default.
+	DefaultServiceHistoryLimit = 10
+
+	shortDigestLength = 19 // Length of "sha256:" followed by the first 12 characters of the hash.
+)
+
+type taskDefRevisionsGetter interface {
+	TaskDefinitionRevisions(app, env, svc string, limit int) ([]*awsecs.TaskDefinition, error)
+	TaskDefinitionRevision(app, env, svc string, revision int) (*awsecs.TaskDefinition, error)
+	ServiceTaskDefinitionRevision(app, env, svc string) (int, error)
+}
+
+// ServiceHistoryDescriber retrieves the previous deployments of a service.
+type ServiceHistoryDescriber struct {
+	app   string
+	env   string
+	svc   string
+	limit int
+
+	revisionsGetter taskDefRevisionsGetter
+}
+
+// NewServiceHistoryConfig contains fields that initiates the service history describer.
+type NewServiceHistoryConfig struct {
+	App         string
+	Env         string
+	Svc         string
+	Limit       int // Limit is the number of most recent deployments to describe.
+	ConfigStore ConfigStoreSvc
+}
+
+// NewServiceHistoryDescriber instantiates a new ServiceHistoryDescriber struct.
+func NewServiceHistoryDescriber(opt *NewServiceHistoryConfig) (*ServiceHistoryDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	provider := sessions.NewProvider()
+	envSess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	limit := opt.Limit
+	if limit <= 0 {
+		limit = DefaultServiceHistoryLimit
+	}
+	return &ServiceHistoryDescriber{
+		app:             opt.App,
+		env:             opt.Env,
+		svc:             opt.Svc,
+		limit:           limit,
+		revisionsGetter: ecs.New(envSess),
+	}, nil
+}
+
+// Describe returns the most recent deployments of the service.
+func (d *ServiceHistoryDescriber) Describe() (HumanJSONStringer, error) {
+	revisions, err := d.Revisions()
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceHistory{
+		Revisions: revisions,
+	}, nil
+}
+
+// Revisions returns the most recent deployments of the service, from the latest to the oldest.
+func (d *ServiceHistoryDescriber) Revisions() ([]ServiceRevision, error) {
+	taskDefs, err := d.revisionsGetter.TaskDefinitionRevisions(d.app, d.env, d.svc, d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("get deployments of service %s: %w", d.svc, err)
+	}
+	current, err := d.currentRevision()
+	if err != nil {
+		return nil, err
+	}
+	revisions := []ServiceRevision{}
+	for _, taskDef := range taskDefs {
+		revision, err := d.newServiceRevision(taskDef, current)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, *revision)
+	}
+	return revisions, nil
+}
+
+// Revision returns the deployment of the service at the given task definition revision.
+func (d *ServiceHistoryDescriber) Revision(revision int) (*ServiceRevision, error) {
+	taskDef, err := d.revisionsGetter.TaskDefinitionRevision(d.app, d.env, d.svc, revision)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment %d of service %s: %w", revision, d.svc, err)
+	}
+	current, err := d.currentRevision()
+	if err != nil {
+		return nil, err
+	}
+	return d.newServiceRevision(taskDef, current)
+}
+
+// currentRevision returns the task definition revision that the service is deployed with.
+func (d *ServiceHistoryDescriber) currentRevision() (int, error) {
+	revision, err := d.revisionsGetter.ServiceTaskDefinitionRevision(d.app, d.env, d.svc)
+	if err != nil {
+		return 0, fmt.Errorf("get current deployment of service %s: %w", d.svc, err)
+	}
+	return revision, nil
+}
+
+func (d *ServiceHistoryDescriber) newServiceRevision(taskDef *awsecs.TaskDefinition, current int) (*ServiceRevision, error) {
+	revision := int(aws.Int64Value(taskDef.Revision))
+	// The main container of a service is named after the service.
+	image, err := taskDef.Image(d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get image of revision %d of service %s: %w", revision, d.svc, err)
+	}
+	labels, err := taskDef.DockerLabels(d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get docker labels of revision %d of service %s: %w", revision, d.svc, err)
+	}
+	return &ServiceRevision{
+		Revision:   revision,
+		Image:      image,
+		Digest:     imageDigest(image, labels),
+		DeployedBy: labels[deploy.DeployedByLabelKey],
+		DeployedAt: aws.TimeValue(taskDef.RegisteredAt),
+		Current:    revision == current,
+	}, nil
+}
+
+// imageDigest returns the digest of the image that a revision was deployed with.
+// The digest is recorded in a docker label when the image is pushed during the deployment,
+// images pinned by digest carry it in their reference, and the digest of any other image is unknown.
+func imageDigest(image string, labels map[string]string) string {
+	if digest := labels[deploy.ImageDigestLabelKey]; digest != "" {
+		return digest
+	}
+	if i := strings.LastIndex(image, "@"); i != -1 {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// ServiceHistory contains the previous deployments of a service.
+type ServiceHistory struct {
+	Revisions []ServiceRevision `json:"revisions"`
+}
+
+// ServiceRevision contains the image and principal of a single deployment of a service.
+type ServiceRevision struct {
+	Revision   int       `json:"revision"`
+	Image      string    `json:"image"`
+	Digest     string    `json:"digest,omitempty"`     // Digest is empty if it was not recorded at deployment time.
+	DeployedBy string    `json:"deployedBy,omitempty"` // DeployedBy is empty if the revision was deployed before it was recorded.
+	DeployedAt time.Time `json:"deployedAt"`
+	Current    bool      `json:"current"`
+}
+
+// PinnedImage returns the image reference of the revision pinned by digest if the digest is known.
+func (r ServiceRevision) PinnedImage() string {
+	if r.Digest == "" || strings.Contains(r.Image, "@") {
+		return r.Image
+	}
+	repo := r.Image
+	// Only strip the tag after the last path segment, the registry host may contain a port.
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo = repo[:i]
+	}
+	return fmt.Sprintf("%s@%s", repo, r.Digest)
+}
+
+// JSONString returns the stringified ServiceHistory struct with json format.
+func (h *ServiceHistory) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal service history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceHistory struct with human readable format.
+func (h *ServiceHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Deployments\n\n"))
+	writer.Flush()
+	h.writeRevisions(writer)
+	writer.Flush()
+	return b.String()
+}
+
+func (h *ServiceHistory) writeRevisions(writer io.Writer) {
+	if len(h.Revisions) == 0 {
+		fmt.Fprint(writer, "  The service has not been deployed yet.\n")
+		return
+	}
+	headers := []string{"Revision", "Deployed At", "Deployed By", "Image", "Digest"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, r := range h.Revisions {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", r.humanRevision(), humanizeTime(r.DeployedAt), r.humanDeployedBy(), r.Image, r.humanDigest())
+	}
+}
+
+func (r ServiceRevision) humanRevision() string {
+	if r.Current {
+		return fmt.Sprintf("%s (current)", strconv.Itoa(r.Revision))
+	}
+	return strconv.Itoa(r.Revision)
+}
+
+// humanDeployedBy trims the ARN of the principal down to its resource, such as "assumed-role/Admin/alice".
+func (r ServiceRevision) humanDeployedBy() string {
+	if r.DeployedBy == "" {
+		return "-"
+	}
+	parsed, err := arn.Parse(r.DeployedBy)
+	if err != nil {
+		return r.DeployedBy
+	}
+	return parsed.Resource
+}
+
+func (r ServiceRevision) humanDigest() string {
+	if r.Digest == "" {
+		return "-"
+	}
+	if len(r.Digest) > shortDigestLength {
+		return r.Digest[:shortDigestLength]
+	}
+	return r.Digest
+}
@@ -0,0 +1,318 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcHistoryDescriberMocks struct {
+	revisionsGetter *mocks.MocktaskDefRevisionsGetter
+}
+
+func TestServiceHistoryDescriber_Describe(t *testing.T) {
+	const (
+		mockRepoURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+		mockDigest1 = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+		mockDigest2 = "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"
+		mockAlice   = "arn:aws:sts::123456789012:assumed-role/Admin/alice"
+	)
+	registeredAt := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	mockError := errors.New("some error")
+	taskDef := func(revision int64, status, image string, labels map[string]string) *awsecs.TaskDefinition {
+		return &awsecs.TaskDefinition{
+			Revision:     aws.Int64(revision),
+			Status:       aws.String(status),
+			RegisteredAt: aws.Time(registeredAt),
+			ContainerDefinitions: []*sdkecs.ContainerDefinition{
+				{
+					Name:  aws.String("firelens_log_router"),
+					Image: aws.String("amazon/aws-for-fluent-bit"),
+				},
+				{
+					Name:         aws.String("api"),
+					Image:        aws.String(image),
+					DockerLabels: aws.StringMap(labels),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcHistoryDescriberMocks)
+
+		wantedError   error
+		wantedContent *ServiceHistory
+	}{
+		"errors if failed to list revisions": {
+			setupMocks: func(m svcHistoryDescriberMocks) {
+				m.revisionsGetter.EXPECT().TaskDefinitionRevisions("phonetool", "test", "api", 5).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get deployments of service api: some error"),
+		},
+		"errors if failed to get the current revision": {
+			setupMocks: func(m svcHistoryDescriberMocks) {
+				m.revisionsGetter.EXPECT().TaskDefinitionRevisions("phonetool", "test", "api", 5).Return([]*awsecs.TaskDefinition{
+					{Revision: aws.Int64(3)},
+				}, nil)
+				m.revisionsGetter.EXPECT().ServiceTaskDefinitionRevision("phonetool", "test", "api").Return(0, mockError)
+			},
+			wantedError: fmt.Errorf("get current deployment of service api: some error"),
+		},
+		"errors if the main container is missing": {
+			setupMocks: func(m svcHistoryDescriberMocks) {
+				m.revisionsGetter.EXPECT().TaskDefinitionRevisions("phonetool", "test", "api", 5).Return([]*awsecs.TaskDefinition{
+					{Revision: aws.Int64(3)},
+				}, nil)
+				m.revisionsGetter.EXPECT().ServiceTaskDefinitionRevision("phonetool", "test", "api").Return(3, nil)
+			},
+			wantedError: fmt.Errorf("get image of revision 3 of service api: container api not found"),
+		},
+		"marks the revision that the service runs as current": {
+			setupMocks: func(m svcHistoryDescriberMocks) {
+				m.revisionsGetter.EXPECT().TaskDefinitionRevisions("phonetool", "test", "api", 5).Return([]*awsecs.TaskDefinition{
+					taskDef(3, "ACTIVE", mockRepoURI+":v2", map[string]string{"copilot-deployed-by": mockAlice, "copilot-image-digest": mockDigest2}),
+					taskDef(2, "ACTIVE", mockRepoURI+"@"+mockDigest1, map[string]string{"copilot-deployed-by": mockAlice, "copilot-image-digest": ""}),
+					taskDef(1, "ACTIVE", mockRepoURI+":v1", nil),
+				}, nil)
+				m.revisionsGetter.EXPECT().ServiceTaskDefinitionRevision("phonetool", "test", "api").Return(3, nil)
+			},
+			wantedContent: &ServiceHistory{
+				Revisions: []ServiceRevision{
+					{
+						Revision:   3,
+						Image:      mockRepoURI + ":v2",
+						Digest:     mockDigest2,
+						DeployedBy: mockAlice,
+						DeployedAt: registeredAt,
+						Current:    true,
+					},
+					{
+						Revision:   2,
+						Image:      mockRepoURI + "@" + mockDigest1,
+						Digest:     mockDigest1,
+						DeployedBy: mockAlice,
+						DeployedAt: registeredAt,
+					},
+					{
+						Revision:   1,
+						Image:      mockRepoURI + ":v1",
+						DeployedAt: registeredAt,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcHistoryDescriberMocks{
+				revisionsGetter: mocks.NewMocktaskDefRevisionsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &ServiceHistoryDescriber{
+				app:             "phonetool",
+				env:             "test",
+				svc:             "api",
+				limit:           5,
+				revisionsGetter: m.revisionsGetter,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, got)
+			}
+		})
+	}
+}
+
+func TestServiceHistoryDescriber_Revision(t *testing.T) {
+	const mockRepoURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+	testCases := map[string]struct {
+		setupMocks func(m svcHistoryDescriberMocks)
+
+		wantedError    error
+		wantedRevision *ServiceRevision
+	}{
+		"errors if failed to get the revision": {
+			setupMocks: func(m svcHistoryDescriberMocks) {
+				m.revisionsGetter.EXPECT().TaskDefinitionRevision("phonetool", "test", "api", 2).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get deployment 2 of service api: some error"),
+		},
+		"success": {
+			setupMocks: func(m svcHistoryDescriberMocks) {
+				m.revisionsGetter.EXPECT().TaskDefinitionRevision("phonetool", "test", "api", 2).Return(&awsecs.TaskDefinition{
+					Revision: aws.Int64(2),
+					Status:   aws.String("INACTIVE"),
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name:  aws.String("api"),
+							Image: aws.String(mockRepoURI + ":v1"),
+							DockerLabels: aws.StringMap(map[string]string{
+								"copilot-image-digest": "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+							}),
+						},
+					},
+				}, nil)
+				m.revisionsGetter.EXPECT().ServiceTaskDefinitionRevision("phonetool", "test", "api").Return(3, nil)
+			},
+			wantedRevision: &ServiceRevision{
+				Revision: 2,
+				Image:    mockRepoURI + ":v1",
+				Digest:   "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcHistoryDescriberMocks{
+				revisionsGetter: mocks.NewMocktaskDefRevisionsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &ServiceHistoryDescriber{
+				app:             "phonetool",
+				env:             "test",
+				svc:             "api",
+				revisionsGetter: m.revisionsGetter,
+			}
+
+			// WHEN
+			got, err := d.Revision(2)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRevision, got)
+			}
+		})
+	}
+}
+
+func TestServiceRevision_PinnedImage(t *testing.T) {
+	testCases := map[string]struct {
+		revision ServiceRevision
+
+		wantedImage string
+	}{
+		"keeps the image if the digest is unknown": {
+			revision:    ServiceRevision{Image: "nginx:latest"},
+			wantedImage: "nginx:latest",
+		},
+		"keeps the image if it is already pinned": {
+			revision: ServiceRevision{
+				Image:  "nginx@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+				Digest: "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+			},
+			wantedImage: "nginx@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+		},
+		"replaces the tag with the digest": {
+			revision: ServiceRevision{
+				Image:  "localhost:5000/phonetool/api:v1",
+				Digest: "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+			},
+			wantedImage: "localhost:5000/phonetool/api@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedImage, tc.revision.PinnedImage())
+		})
+	}
+}
+
+func TestServiceHistory_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-09-01T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	deployedAt := time.Date(2021, 9, 1, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		history *ServiceHistory
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no deployments": {
+			history: &ServiceHistory{
+				Revisions: []ServiceRevision{},
+			},
+			wantedHumanString: `Deployments
+
+  The service has not been deployed yet.
+`,
+			wantedJSONString: "{\"revisions\":[]}\n",
+		},
+		"with deployments": {
+			history: &ServiceHistory{
+				Revisions: []ServiceRevision{
+					{
+						Revision:   3,
+						Image:      "phonetool/api:v2",
+						Digest:     "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+						DeployedBy: "arn:aws:sts::123456789012:assumed-role/Admin/alice",
+						DeployedAt: deployedAt,
+						Current:    true,
+					},
+					{
+						Revision:   1,
+						Image:      "nginx:latest",
+						DeployedAt: deployedAt,
+					},
+				},
+			},
+			wantedHumanString: `Deployments
+
+  Revision     Deployed At  Deployed By               Image             Digest
+  --------     -----------  -----------               -----             ------
+  3 (current)  3 hours ago  assumed-role/Admin/alice  phonetool/api:v2  sha256:f1d4ae3f7261
+  1            3 hours ago  -                         nginx:latest      -
+`,
+			wantedJSONString: "{\"revisions\":[{\"revision\":3,\"image\":\"phonetool/api:v2\",\"digest\":\"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\",\"deployedBy\":\"arn:aws:sts::123456789012:assumed-role/Admin/alice\",\"deployedAt\":\"2021-09-01T09:00:00Z\",\"current\":true},{\"revision\":1,\"image\":\"nginx:latest\",\"deployedAt\":\"2021-09-01T09:00:00Z\",\"current\":false}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.history.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSONString, json)
+
+			human := tc.history.HumanString()
+			require.Equal(t, tc.wantedHumanString, human)
+		})
+	}
+}
@@ -63,15 +63,23 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("get stack parameters for service %s: %w", d.svc, err)
 	}
+	svcOutputs, err := d.svcStackDescriber[envName].Outputs()
+	if err != nil {
+		return "", fmt.Errorf("get stack outputs for service %s: %w", d.svc, err)
+	}
 
 	uri := &LBWebServiceURI{
-		DNSNames: []string{envOutputs[envOutputPublicLoadBalancerDNSName]},
-		Path:     svcParams[stack.LBWebServiceRulePathParamKey],
+		DNSNames:   []string{envOutputs[envOutputPublicLoadBalancerDNSName]},
+		Path:       svcParams[stack.LBWebServiceRulePathParamKey],
+		NLBDNSName: svcOutputs[svcOutputPublicNLBDNSName],
+		NLBPort:    svcOutputs[svcOutputPublicNLBPort],
+	}
+	if subdomain, ok := envOutputs[envOutputSubdomain]; ok {
+		uri.DNSNames = []string{fmt.Sprintf("%s.%s", d.svc, subdomain)}
+		uri.HTTPS = true
 	}
-	_, isHTTPS := envOutputs[envOutputSubdomain]
-	if isHTTPS {
-		dnsName := fmt.Sprintf("%s.%s", d.svc, envOutputs[envOutputSubdomain])
-		uri.DNSNames = []string{dnsName}
+	// Services in environments with imported certificates serve HTTPS traffic without a subdomain.
+	if svcParams[stack.LBWebServiceHTTPSParamKey] == "true" {
 		uri.HTTPS = true
 	}
 	aliases := envParams[stack.EnvParamAliasesKey]
@@ -88,7 +96,8 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 	return uri.String(), nil
 }
 
-// URI returns the service discovery namespace and is used to make
+// URI returns the URL of the service on the internal load balancer if the service is placed behind it.
+// Otherwise, it returns the service discovery namespace and is used to make
 // BackendServiceDescriber have the same signature as WebServiceDescriber.
 func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if err := d.initDescribers(envName); err != nil {
@@ -98,6 +107,9 @@ func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("get stack parameters for environment %s: %w", envName, err)
 	}
+	if path, ok := svcStackParams[stack.LBWebServiceRulePathParamKey]; ok {
+		return d.internalURI(envName, path)
+	}
 	port := svcStackParams[stack.LBWebServiceContainerPortParamKey]
 	if port == stack.NoExposedContainerPort {
 		return BlankServiceDiscoveryURI, nil
@@ -114,6 +126,19 @@ func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	return s.String(), nil
 }
 
+// internalURI returns the URL of the service on the internal load balancer of the environment.
+func (d *BackendServiceDescriber) internalURI(envName, path string) (string, error) {
+	envOutputs, err := d.envDescriber[envName].Outputs()
+	if err != nil {
+		return "", fmt.Errorf("get stack outputs for environment %s: %w", envName, err)
+	}
+	uri := &LBWebServiceURI{
+		DNSNames: []string{envOutputs[envOutputInternalLoadBalancerDNSName]},
+		Path:     path,
+	}
+	return uri.String(), nil
+}
+
 // URI returns the WebServiceURI to identify this service uniquely given an environment name.
 func (d *RDWebServiceDescriber) URI(envName string) (string, error) {
 	err := d.initServiceDescriber(envName)
@@ -134,6 +159,9 @@ type LBWebServiceURI struct {
 	HTTPS    bool
 	DNSNames []string // The environment's subdomain if the service is served on HTTPS. Otherwise, the public load balancer's DNS.
 	Path     string   // Empty if the service is served on HTTPS. Otherwise, the pattern used to match the service.
+
+	NLBDNSName string // The DNS name of the network load balancer in front of the service, if any.
+	NLBPort    string // The port of the network load balancer listener.
 }
 
 func (u *LBWebServiceURI) String() string {
@@ -149,6 +177,9 @@ func (u *LBWebServiceURI) String() string {
 		}
 		uris = append(uris, fmt.Sprintf("%s%s%s", protocol, dnsName, path))
 	}
+	if u.NLBDNSName != "" {
+		uris = append(uris, fmt.Sprintf("%s:%s", u.NLBDNSName, u.NLBPort))
+	}
 	return english.OxfordWordSeries(uris, "or")
 }
 
@@ -71,6 +71,7 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceRulePathParamKey: testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 				)
 			},
 
@@ -86,6 +87,7 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceRulePathParamKey: "*",
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 				)
 			},
 
@@ -104,11 +106,80 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceRulePathParamKey: testSvcPath,
 					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 				)
 			},
 
 			wantedURI: "https://example.com or https://v1.example.com",
 		},
+		"with alias served by imported certificates": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{
+						stack.EnvParamAliasesKey: `{"jobs": ["example.com"]}`,
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+						stack.LBWebServiceHTTPSParamKey:    "true",
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+				)
+			},
+
+			wantedURI: "https://example.com",
+		},
+		"fail to get outputs of service stack": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get stack outputs for service jobs: some error"),
+		},
+		"with network load balancer": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: "/",
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputPublicNLBDNSName: "jobs-nlb-1234.elb.us-west-1.amazonaws.com",
+						svcOutputPublicNLBPort:    "443",
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://abc.us-west-1.elb.amazonaws.com or jobs-nlb-1234.elb.us-west-1.amazonaws.com:443",
+		},
+		"imported load balancer": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: "my-lb-1234.us-west-2.elb.amazonaws.com",
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: "api",
+						stack.LBWebServiceHTTPSParamKey:    "false",
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+				)
+			},
+
+			wantedURI: "http://my-lb-1234.us-west-2.elb.amazonaws.com/api",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -212,6 +283,40 @@ func TestBackendServiceDescriber_URI(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, "hello.test.app.local:8080", actual)
 	})
+	t.Run("should return the internal load balancer URL if the service is placed behind it", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvcStack := mocks.NewMockecsStackDescriber(ctrl)
+		mockSvcStack.EXPECT().Params().Return(map[string]string{
+			stack.LBWebServiceContainerPortParamKey: "8080",
+			stack.LBWebServiceRulePathParamKey:      "orders",
+		}, nil)
+		mockEnvStack := mocks.NewMockenvDescriber(ctrl)
+		mockEnvStack.EXPECT().Outputs().Return(map[string]string{
+			envOutputInternalLoadBalancerDNSName: "internal-demo-test-1234.us-west-2.elb.amazonaws.com",
+		}, nil)
+
+		d := &BackendServiceDescriber{
+			ecsServiceDescriber: &ecsServiceDescriber{
+				svc: "hello",
+				svcStackDescriber: map[string]ecsStackDescriber{
+					"test": mockSvcStack,
+				},
+				initDescribers: func(string) error { return nil },
+			},
+			envDescriber: map[string]envDescriber{
+				"test": mockEnvStack,
+			},
+		}
+
+		// WHEN
+		actual, err := d.URI("test")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "http://internal-demo-test-1234.us-west-2.elb.amazonaws.com/orders", actual)
+	})
 }
 
 func TestRDWebServiceDescriber_URI(t *testing.T) {
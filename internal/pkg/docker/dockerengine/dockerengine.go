@@ -8,16 +8,19 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	osexec "os/exec"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
 )
 
 // Cmd is the interface implemented by external commands.
@@ -29,15 +32,18 @@ type Cmd interface {
 const (
 	LinuxOS   = "linux"
 	Amd64Arch = "amd64"
+	Arm64Arch = "arm64"
 )
 
 const (
-	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
+	credStoreECRLogin   = "ecr-login"             // set on `credStore` attribute in docker configuration file
+	inlineCacheBuildArg = "BUILDKIT_INLINE_CACHE" // embeds the build cache metadata in the image so that it can be used with --cache-from
 )
 
 // CmdClient represents the docker client to interact with the server via external commands.
 type CmdClient struct {
 	runner Cmd
+	out    io.Writer // Where to write informational messages, defaults to the terminal if nil.
 	// Override in unit tests.
 	buf      *bytes.Buffer
 	homePath string
@@ -51,6 +57,28 @@ func New(cmd Cmd) CmdClient {
 	}
 }
 
+// NewWithOutput returns a CmdClient whose commands write their output to w instead of the terminal.
+// It's used to run several builds concurrently without interleaving their output.
+func NewWithOutput(cmd Cmd, w io.Writer) CmdClient {
+	c := New(&outputCmd{
+		cmd: cmd,
+		w:   w,
+	})
+	c.out = w
+	return c
+}
+
+// outputCmd runs commands with their stdout and stderr written to w unless overridden by the options.
+type outputCmd struct {
+	cmd Cmd
+	w   io.Writer
+}
+
+// Run runs the command with its output written to w.
+func (c *outputCmd) Run(name string, args []string, options ...exec.CmdOption) error {
+	return c.cmd.Run(name, args, append([]exec.CmdOption{exec.Stdout(c.w), exec.Stderr(c.w)}, options...)...)
+}
+
 // BuildArguments holds the arguments that can be passed while building a container.
 type BuildArguments struct {
 	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
@@ -59,8 +87,25 @@ type BuildArguments struct {
 	Context    string            // Optional. Build context directory to pass to `docker build`.
 	Target     string            // Optional. The target build stage to pass to `docker build`.
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
+	CacheTo    []string          // Optional. Cache export destinations to pass to `docker buildx build`, which builds the image instead of `docker build`.
 	Platform   string            // Optional. OS/Arch to pass to `docker build`.
+	Platforms  []string          // Optional. OS/Arch pairs to build a multi-platform image for with `docker buildx build`.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
+
+	// Optional. Use the image previously pushed to URI as a cache source, and embed cache metadata in the new image
+	// so that the next build can use it too.
+	CacheFromPrevious bool
+}
+
+// RunOptions holds the options that can be passed while running a container.
+type RunOptions struct {
+	ImageURI         string            // Required. The image to run the container from.
+	ContainerName    string            // Required. The name to assign to the container.
+	Ports            map[string]string // Optional. Host ports mapped to container ports to publish via `--publish` flags.
+	EnvVars          map[string]string // Optional. Environment variables to pass via `--env` flags.
+	Secrets          map[string]string // Optional. Resolved secrets to pass as environment variables, their values are not part of the command line.
+	ContainerNetwork string            // Optional. The name of another container whose network stack to join.
+	Command          []string          // Optional. The command that overrides the image's default command.
 }
 
 type dockerConfig struct {
@@ -69,13 +114,45 @@ type dockerConfig struct {
 }
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
+// Since only `docker buildx build` can export the build cache, the image is built with `docker buildx build --load`
+// if there are cache export destinations.
 func (c CmdClient) Build(in *BuildArguments) error {
+	args := append([]string{"build"}, buildFlags(in, in.Platform, false)...)
+	if len(in.CacheTo) != 0 {
+		args = append([]string{"buildx", "build", "--load"}, buildFlags(in, in.Platform, true)...)
+	}
+	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
+	if in.Platform != "" {
+		c.infof("Building your container image: docker %s\n", strings.Join(args, " "))
+	}
+	if err := c.runner.Run("docker", args); err != nil {
+		return fmt.Errorf("building image: %w", err)
+	}
+
+	return nil
+}
+
+// BuildAndPushMultiPlatform will run a `docker buildx build --push` command that builds the image for each of the
+// platforms in the build arguments, and pushes them to the ecr repo URI as a single manifest list.
+// Unlike Build, the image isn't loaded into the local image store since docker can't store multi-platform images.
+func (c CmdClient) BuildAndPushMultiPlatform(in *BuildArguments) error {
+	args := append([]string{"buildx", "build", "--push"}, buildFlags(in, strings.Join(in.Platforms, ","), true)...)
+	c.infof("Building your multi-platform container image: docker %s\n", strings.Join(args, " "))
+	if err := c.runner.Run("docker", args); err != nil {
+		return fmt.Errorf("building multi-platform image for %s: %w", english.WordSeries(in.Platforms, "and"), err)
+	}
+	return nil
+}
+
+// buildFlags returns the flags and positional arguments shared by `docker build` and `docker buildx build`.
+// The cache export destinations are only passed to `docker buildx build`.
+func buildFlags(in *BuildArguments, platform string, buildx bool) []string {
 	dfDir := in.Context
 	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
 		dfDir = filepath.Dir(in.Dockerfile)
 	}
 
-	args := []string{"build"}
+	var args []string
 
 	// Add additional image tags to the docker build call.
 	args = append(args, "-t", in.URI)
@@ -87,6 +164,16 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	for _, imageFrom := range in.CacheFrom {
 		args = append(args, "--cache-from", imageFrom)
 	}
+	if in.CacheFromPrevious {
+		args = append(args, "--cache-from", in.URI)
+	}
+
+	// Add cache to options.
+	if buildx {
+		for _, cacheTo := range in.CacheTo {
+			args = append(args, "--cache-to", cacheTo)
+		}
+	}
 
 	// Add target option.
 	if in.Target != "" {
@@ -94,8 +181,8 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	}
 
 	// Add platform option.
-	if in.Platform != "" {
-		args = append(args, "--platform", in.Platform)
+	if platform != "" {
+		args = append(args, "--platform", platform)
 	}
 
 	// Add the "args:" override section from manifest to the docker build call.
@@ -109,17 +196,11 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	for _, k := range keys {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
-
-	args = append(args, dfDir, "-f", in.Dockerfile)
-	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
-	if in.Platform != "" {
-		log.Infof("Building your container image: docker %s\n", strings.Join(args, " "))
-	}
-	if err := c.runner.Run("docker", args); err != nil {
-		return fmt.Errorf("building image: %w", err)
+	if _, ok := in.Args[inlineCacheBuildArg]; in.CacheFromPrevious && !ok {
+		args = append(args, "--build-arg", fmt.Sprintf("%s=1", inlineCacheBuildArg))
 	}
 
-	return nil
+	return append(args, dfDir, "-f", in.Dockerfile)
 }
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
@@ -159,6 +240,75 @@ func (c CmdClient) Push(uri string, tags ...string) (digest string, err error) {
 	return parts[1], nil
 }
 
+// Run will run a `docker run` command in detached mode for the given options.
+// The container is kept once it stops so that its exit code can be read with Wait, and must be removed with Remove.
+// The secrets are passed with `--env KEY` flags and read by docker from its own environment,
+// so that their values can't be seen in the list of processes.
+func (c CmdClient) Run(options *RunOptions) error {
+	args := []string{"run", "--detach", "--name", options.ContainerName}
+
+	if options.ContainerNetwork != "" {
+		args = append(args, "--network", fmt.Sprintf("container:%s", options.ContainerNetwork))
+	}
+
+	// Collect the keys in a slice to sort for test stability.
+	for _, hostPort := range sortedKeys(options.Ports) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, options.Ports[hostPort]))
+	}
+	for _, k := range sortedKeys(options.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, options.EnvVars[k]))
+	}
+	var secrets []string
+	for _, k := range sortedKeys(options.Secrets) {
+		args = append(args, "--env", k)
+		secrets = append(secrets, fmt.Sprintf("%s=%s", k, options.Secrets[k]))
+	}
+
+	args = append(args, options.ImageURI)
+	args = append(args, options.Command...)
+	var opts []exec.CmdOption
+	if len(secrets) != 0 {
+		opts = append(opts, exec.Env(secrets...))
+	}
+	if err := c.runner.Run("docker", args, opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", options.ContainerName, err)
+	}
+	return nil
+}
+
+// Logs will run a `docker logs --follow` command and stream the output of the container to w until the container stops.
+func (c CmdClient) Logs(containerName string, w io.Writer) error {
+	if err := c.runner.Run("docker", []string{"logs", "--follow", containerName}, exec.Stdout(w), exec.Stderr(w)); err != nil {
+		return fmt.Errorf("stream logs of container %s: %w", containerName, err)
+	}
+	return nil
+}
+
+// Wait will run a `docker wait` command to block until the container stops, and returns the exit code of the container.
+func (c CmdClient) Wait(containerName string) (int, error) {
+	buf := new(strings.Builder)
+	if err := c.runner.Run("docker", []string{"wait", containerName}, exec.Stdout(buf)); err != nil {
+		return 0, fmt.Errorf("wait for container %s: %w", containerName, err)
+	}
+	code, err := strconv.Atoi(strings.TrimSpace(buf.String()))
+	if err != nil {
+		return 0, fmt.Errorf("parse exit code %q of container %s: %w", strings.TrimSpace(buf.String()), containerName, err)
+	}
+	return code, nil
+}
+
+// Remove will run a `docker rm --force` command to stop and remove the containers.
+func (c CmdClient) Remove(containerNames ...string) error {
+	if len(containerNames) == 0 {
+		return nil
+	}
+	args := append([]string{"rm", "--force"}, containerNames...)
+	if err := c.runner.Run("docker", args); err != nil {
+		return fmt.Errorf("remove containers %s: %w", strings.Join(containerNames, ", "), err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c CmdClient) CheckDockerEngineRunning() error {
 	if _, err := osexec.LookPath("docker"); err != nil {
@@ -187,6 +337,14 @@ func (c CmdClient) CheckDockerEngineRunning() error {
 	}
 }
 
+func (c CmdClient) infof(format string, args ...interface{}) {
+	if c.out != nil {
+		fmt.Fprintf(c.out, format, args...)
+		return
+	}
+	log.Infof(format, args...)
+}
+
 // getPlatform will run the `docker version` command to get the OS/Arch.
 func (c CmdClient) getPlatform() (os, arch string, err error) {
 	if _, err := osexec.LookPath("docker"); err != nil {
@@ -215,6 +373,15 @@ func DockerBuildPlatform(os, arch string) string {
 	return fmt.Sprintf("%s/%s", os, arch)
 }
 
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
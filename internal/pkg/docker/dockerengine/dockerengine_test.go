@@ -168,6 +168,117 @@ func TestDockerCommand_Build(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_BuildCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCmd := NewMockCmd(ctrl)
+	mockCmd.EXPECT().Run("docker", []string{"buildx", "build", "--load",
+		"-t", "mockURI",
+		"--cache-from", "foo/bar:latest",
+		"--cache-from", "mockURI",
+		"--cache-to", "type=inline",
+		"--build-arg", "BUILDKIT_INLINE_CACHE=1",
+		"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+	s := CmdClient{
+		runner: mockCmd,
+	}
+
+	// WHEN
+	err := s.Build(&BuildArguments{
+		URI:               "mockURI",
+		Dockerfile:        "mockPath/to/mockDockerfile",
+		CacheFrom:         []string{"foo/bar:latest"},
+		CacheTo:           []string{"type=inline"},
+		CacheFromPrevious: true,
+	})
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestNewWithOutput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCmd := NewMockCmd(ctrl)
+	buf := new(bytes.Buffer)
+	mockCmd.EXPECT().Run("docker", []string{"push", "mockURI"}, gomock.Any(), gomock.Any()).Return(nil)
+	mockCmd.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "mockURI"}, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ string, _ []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			require.Equal(t, buf, cmd.Stderr, "stderr should be written to the output")
+			_, err := cmd.Stdout.Write([]byte("\"mockURI@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\"\n"))
+			return err
+		})
+	s := NewWithOutput(mockCmd, buf)
+
+	// WHEN
+	digest, err := s.Push("mockURI")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+	require.Empty(t, buf.String(), "the digest should be captured by the inspect command instead of the output")
+}
+
+func TestDockerCommand_BuildAndPushMultiPlatform(t *testing.T) {
+	mockError := errors.New("mockError")
+	mockURI := "mockURI"
+
+	testCases := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wantedError error
+	}{
+		"should error if the docker buildx command fails": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("building multi-platform image for linux/amd64 and linux/arm64: %w", mockError),
+		},
+		"should build and push the image for every platform": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "build", "--push",
+					"-t", mockURI,
+					"-t", mockURI + ":tag1",
+					"--platform", "linux/amd64,linux/arm64",
+					"--build-arg", "GO_VERSION=1.17",
+					"mockPath", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			s := CmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.BuildAndPushMultiPlatform(&BuildArguments{
+				URI:        mockURI,
+				Tags:       []string{"tag1"},
+				Dockerfile: "mockPath/to/mockDockerfile",
+				Context:    "mockPath",
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+				Args: map[string]string{
+					"GO_VERSION": "1.17",
+				},
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDockerCommand_Login(t *testing.T) {
 	mockError := errors.New("mockError")
 
@@ -298,6 +409,227 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_Run(t *testing.T) {
+	t.Run("runs a detached container with ports, environment variables and secrets", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"run", "--detach", "--name", "my-app-frontend",
+			"--publish", "8080:8080",
+			"--env", "COPILOT_SERVICE_NAME=frontend", "--env", "LOG_LEVEL=info",
+			"--env", "DB_PASSWORD",
+			"my-app/frontend", "npm", "start"}, gomock.Any()).
+			DoAndReturn(func(_ string, _ []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				require.Contains(t, cmd.Env, "DB_PASSWORD=hunter2", "secrets should be passed in the environment of docker")
+				return nil
+			})
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Run(&RunOptions{
+			ImageURI:      "my-app/frontend",
+			ContainerName: "my-app-frontend",
+			Ports:         map[string]string{"8080": "8080"},
+			EnvVars: map[string]string{
+				"LOG_LEVEL":            "info",
+				"COPILOT_SERVICE_NAME": "frontend",
+			},
+			Secrets: map[string]string{"DB_PASSWORD": "hunter2"},
+			Command: []string{"npm", "start"},
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("joins the network of another container", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"run", "--detach", "--name", "my-app-frontend-nginx",
+			"--network", "container:my-app-frontend", "nginx"}).Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Run(&RunOptions{
+			ImageURI:         "nginx",
+			ContainerName:    "my-app-frontend-nginx",
+			ContainerNetwork: "my-app-frontend",
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("returns a wrapped error on failed docker run", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Run(&RunOptions{
+			ImageURI:      "nginx",
+			ContainerName: "nginx",
+		})
+
+		// THEN
+		require.EqualError(t, err, "run container nginx: some error")
+	})
+}
+
+func TestDockerCommand_Logs(t *testing.T) {
+	t.Run("streams the container output to the writer", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"logs", "--follow", "my-app-frontend"}, gomock.Any(), gomock.Any()).
+			Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				_, _ = cmd.Stdout.Write([]byte("listening on port 8080\n"))
+			}).Return(nil)
+		buf := new(bytes.Buffer)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Logs("my-app-frontend", buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "listening on port 8080\n", buf.String())
+	})
+	t.Run("returns a wrapped error on failed docker logs", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Logs("my-app-frontend", new(bytes.Buffer))
+
+		// THEN
+		require.EqualError(t, err, "stream logs of container my-app-frontend: some error")
+	})
+}
+
+func TestDockerCommand_Wait(t *testing.T) {
+	t.Run("returns the exit code of the container", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"wait", "my-app-frontend"}, gomock.Any()).
+			Do(func(_ string, _ []string, opt exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("137\n"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		code, err := cmd.Wait("my-app-frontend")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 137, code)
+	})
+	t.Run("returns a wrapped error on failed docker wait", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		_, err := cmd.Wait("my-app-frontend")
+
+		// THEN
+		require.EqualError(t, err, "wait for container my-app-frontend: some error")
+	})
+	t.Run("returns an error if the exit code can't be parsed", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"wait", "my-app-frontend"}, gomock.Any()).
+			Do(func(_ string, _ []string, opt exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("oops\n"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		_, err := cmd.Wait("my-app-frontend")
+
+		// THEN
+		require.EqualError(t, err, `parse exit code "oops" of container my-app-frontend: strconv.Atoi: parsing "oops": invalid syntax`)
+	})
+}
+
+func TestDockerCommand_Remove(t *testing.T) {
+	t.Run("does nothing if there are no containers", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Remove()
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("force removes all containers", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"rm", "--force", "my-app-frontend", "my-app-frontend-nginx"}).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Remove("my-app-frontend", "my-app-frontend-nginx")
+
+		// THEN
+		require.EqualError(t, err, "remove containers my-app-frontend, my-app-frontend-nginx: some error")
+	})
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockCmd *MockCmd
@@ -7,6 +7,7 @@ package ecs
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -37,10 +38,12 @@ type ecsClient interface {
 	NetworkConfiguration(cluster, serviceName string) (*ecs.NetworkConfiguration, error)
 	RunningTasks(cluster string) ([]*ecs.Task, error)
 	RunningTasksInFamily(cluster, family string) ([]*ecs.Task, error)
+	Service(clusterName, serviceName string) (*ecs.Service, error)
 	ServiceRunningTasks(clusterName, serviceName string) ([]*ecs.Task, error)
 	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
 	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+	TaskDefinitionRevisions(family string) ([]string, error)
 	UpdateService(clusterName, serviceName string, opts ...ecs.UpdateServiceOpts) error
 }
 
@@ -77,6 +80,11 @@ func (c Client) ClusterARN(app, env string) (string, error) {
 	return c.clusterARN(app, env)
 }
 
+// StateMachineARN returns the ARN of the state machine that runs a job.
+func (c Client) StateMachineARN(app, env, job string) (string, error) {
+	return c.stateMachineARN(app, env, job)
+}
+
 // ForceUpdateService forces a new update for an ECS service given Copilot service info.
 func (c Client) ForceUpdateService(app, env, svc string) error {
 	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
@@ -215,6 +223,56 @@ func (c Client) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error
 	return taskDefinition, nil
 }
 
+// TaskDefinitionRevisions returns up to limit task definitions of the service, from the latest revision to the oldest.
+// If limit is not positive, all revisions are returned.
+func (c Client) TaskDefinitionRevisions(app, env, svc string, limit int) ([]*ecs.TaskDefinition, error) {
+	family := fmt.Sprintf(fmtWorkloadTaskDefinitionFamily, app, env, svc)
+	arns, err := c.ecsClient.TaskDefinitionRevisions(family)
+	if err != nil {
+		return nil, fmt.Errorf("list task definition revisions of service %s: %w", svc, err)
+	}
+	if limit > 0 && len(arns) > limit {
+		arns = arns[:limit]
+	}
+	taskDefs := make([]*ecs.TaskDefinition, len(arns))
+	for i, arn := range arns {
+		taskDef, err := c.ecsClient.TaskDefinition(arn)
+		if err != nil {
+			return nil, fmt.Errorf("get task definition %s of service %s: %w", arn, svc, err)
+		}
+		taskDefs[i] = taskDef
+	}
+	return taskDefs, nil
+}
+
+// TaskDefinitionRevision returns the task definition of the service at the given revision.
+func (c Client) TaskDefinitionRevision(app, env, svc string, revision int) (*ecs.TaskDefinition, error) {
+	taskDefName := fmt.Sprintf(fmtWorkloadTaskDefinitionFamily+":%d", app, env, svc, revision)
+	taskDefinition, err := c.ecsClient.TaskDefinition(taskDefName)
+	if err != nil {
+		return nil, fmt.Errorf("get task definition %s of service %s: %w", taskDefName, svc, err)
+	}
+	return taskDefinition, nil
+}
+
+// ServiceTaskDefinitionRevision returns the revision of the task definition that the service is deployed with.
+func (c Client) ServiceTaskDefinitionRevision(app, env, svc string) (int, error) {
+	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
+	if err != nil {
+		return 0, err
+	}
+	service, err := c.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return 0, fmt.Errorf("get ECS service %s: %w", serviceName, err)
+	}
+	taskDefARN := aws.StringValue(service.TaskDefinition)
+	revision, err := strconv.Atoi(taskDefARN[strings.LastIndex(taskDefARN, ":")+1:])
+	if err != nil {
+		return 0, fmt.Errorf("parse revision of task definition %s: %w", taskDefARN, err)
+	}
+	return revision, nil
+}
+
 // NetworkConfiguration returns the network configuration of the service.
 func (c Client) NetworkConfiguration(app, env, svc string) (*ecs.NetworkConfiguration, error) {
 	clusterARN, err := c.clusterARN(app, env)
@@ -114,6 +114,78 @@ func TestClient_ClusterARN(t *testing.T) {
 	}
 }
 
+func TestClient_StateMachineARN(t *testing.T) {
+	const (
+		mockApp = "mockApp"
+		mockEnv = "mockEnv"
+		mockJob = "mockJob"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockJob,
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+		wantedARN   string
+	}{
+		"errors if fail to get resources by tags": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, getRgInput).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get state machine resource by tags for job mockJob: some error"),
+		},
+		"errors if no state machine matches the job": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, getRgInput).
+					Return([]*resourcegroups.Resource{
+						{ARN: "arn:aws:states:us-west-2:123456789:stateMachine:mockApp-mockEnv-otherJob"},
+					}, nil)
+			},
+			wantedError: fmt.Errorf("state machine for job mockJob not found"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, getRgInput).
+					Return([]*resourcegroups.Resource{
+						{ARN: "arn:aws:states:us-west-2:123456789:stateMachine:mockApp-mockEnv-mockJob"},
+					}, nil)
+			},
+			wantedARN: "arn:aws:states:us-west-2:123456789:stateMachine:mockApp-mockEnv-mockJob",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			test.setupMocks(clientMocks{
+				resourceGetter: mockRgGetter,
+			})
+			client := Client{
+				rgGetter: mockRgGetter,
+			}
+
+			// WHEN
+			get, err := client.StateMachineARN(mockApp, mockEnv, mockJob)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantedARN, get)
+			}
+		})
+	}
+}
 func TestClient_serviceARN(t *testing.T) {
 	const (
 		mockApp = "mockApp"
@@ -1008,6 +1080,209 @@ func TestServiceDescriber_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestClient_TaskDefinitionRevisions(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testSvc = "svc"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		inLimit    int
+		setupMocks func(m *mocks.MockecsClient)
+
+		wantedTaskDefinitions []*ecs.TaskDefinition
+		wantedError           error
+	}{
+		"unable to list revisions": {
+			setupMocks: func(m *mocks.MockecsClient) {
+				m.EXPECT().TaskDefinitionRevisions("phonetool-test-svc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list task definition revisions of service svc: some error"),
+		},
+		"unable to retrieve a task definition": {
+			setupMocks: func(m *mocks.MockecsClient) {
+				m.EXPECT().TaskDefinitionRevisions("phonetool-test-svc").Return([]string{"phonetool-test-svc:2"}, nil)
+				m.EXPECT().TaskDefinition("phonetool-test-svc:2").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get task definition phonetool-test-svc:2 of service svc: some error"),
+		},
+		"returns up to limit task definitions": {
+			inLimit: 2,
+			setupMocks: func(m *mocks.MockecsClient) {
+				m.EXPECT().TaskDefinitionRevisions("phonetool-test-svc").Return([]string{"phonetool-test-svc:3", "phonetool-test-svc:2", "phonetool-test-svc:1"}, nil)
+				m.EXPECT().TaskDefinition("phonetool-test-svc:3").Return(&ecs.TaskDefinition{Revision: aws.Int64(3)}, nil)
+				m.EXPECT().TaskDefinition("phonetool-test-svc:2").Return(&ecs.TaskDefinition{Revision: aws.Int64(2)}, nil)
+			},
+			wantedTaskDefinitions: []*ecs.TaskDefinition{
+				{Revision: aws.Int64(3)},
+				{Revision: aws.Int64(2)},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECS := mocks.NewMockecsClient(ctrl)
+			tc.setupMocks(mockECS)
+
+			c := Client{
+				ecsClient: mockECS,
+			}
+
+			// WHEN
+			got, err := c.TaskDefinitionRevisions(testApp, testEnv, testSvc, tc.inLimit)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTaskDefinitions, got)
+			}
+		})
+	}
+}
+
+func TestClient_TaskDefinitionRevision(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockecsClient)
+
+		wantedTaskDefinition *ecs.TaskDefinition
+		wantedError          error
+	}{
+		"unable to retrieve task definition": {
+			setupMocks: func(m *mocks.MockecsClient) {
+				m.EXPECT().TaskDefinition("phonetool-test-svc:4").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get task definition phonetool-test-svc:4 of service svc: some error"),
+		},
+		"successfully return the task definition at the revision": {
+			setupMocks: func(m *mocks.MockecsClient) {
+				m.EXPECT().TaskDefinition("phonetool-test-svc:4").Return(&ecs.TaskDefinition{Revision: aws.Int64(4)}, nil)
+			},
+			wantedTaskDefinition: &ecs.TaskDefinition{Revision: aws.Int64(4)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECS := mocks.NewMockecsClient(ctrl)
+			tc.setupMocks(mockECS)
+
+			c := Client{
+				ecsClient: mockECS,
+			}
+
+			// WHEN
+			got, err := c.TaskDefinitionRevision("phonetool", "test", "svc", 4)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTaskDefinition, got)
+			}
+		})
+	}
+}
+
+func TestClient_ServiceTaskDefinitionRevision(t *testing.T) {
+	const (
+		mockSvcARN  = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster = "mockCluster"
+		mockService = "mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     "phonetool",
+		deploy.EnvTagKey:     "test",
+		deploy.ServiceTagKey: "svc",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedRevision int
+		wantedError    error
+	}{
+		"errors if fail to describe the service": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedError: errors.New("get ECS service mockService: some error"),
+		},
+		"errors if the task definition has no revision": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String("phonetool-test-svc"),
+					}, nil),
+				)
+			},
+			wantedError: errors.New(`parse revision of task definition phonetool-test-svc: strconv.Atoi: parsing "phonetool-test-svc": invalid syntax`),
+		},
+		"returns the revision of the task definition of the service": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-svc:12"),
+					}, nil),
+				)
+			},
+			wantedRevision: 12,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				ecsClient:      mocks.NewMockecsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			c := Client{
+				rgGetter:  m.resourceGetter,
+				ecsClient: m.ecsClient,
+			}
+
+			// WHEN
+			got, err := c.ServiceTaskDefinitionRevision("phonetool", "test", "svc")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRevision, got)
+		})
+	}
+}
+
 func Test_NetworkConfiguration(t *testing.T) {
 	const (
 		testApp = "phonetool"
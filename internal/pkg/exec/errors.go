@@ -41,3 +41,10 @@ type ErrOutdatedSSMPlugin struct {
 func (e ErrOutdatedSSMPlugin) Error() string {
 	return "Session Manager plugin is not up-to-date"
 }
+
+// ErrNotationNotExist means the Notation CLI is not installed.
+type ErrNotationNotExist struct{}
+
+func (e *ErrNotationNotExist) Error() string {
+	return fmt.Sprintf("Notation CLI with the AWS Signer plugin does not exist, install it following %s", notationInstallLink)
+}
@@ -70,6 +70,14 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env sets the internal *exec.Cmd's Env field to the environment of the current process with the additional variables,
+// in the "key=value" form.
+func Env(vars ...string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Env = append(os.Environ(), vars...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(name, args, opts...)
@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	notationBinaryName  = "notation"
+	signerPluginName    = "com.amazonaws.signer.notation.plugin"
+	notationInstallLink = "https://docs.aws.amazon.com/signer/latest/developerguide/image-signing-prerequisites.html"
+)
+
+// NotationCommand represents commands that can be run to sign container images with Notation and AWS Signer.
+type NotationCommand struct {
+	runner
+}
+
+// NewNotationCommand returns a NotationCommand.
+func NewNotationCommand() NotationCommand {
+	return NotationCommand{
+		runner: NewCmd(),
+	}
+}
+
+// Sign signs the image with the AWS Signer signing profile, and pushes the signature to the registry of the image.
+// The image must be referenced by digest, such as "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc@sha256:abc".
+func (c NotationCommand) Sign(image, signingProfileARN string) error {
+	err := c.runner.Run(notationBinaryName, []string{"sign", "--plugin", signerPluginName, "--id", signingProfileARN, image})
+	if err == nil {
+		return nil
+	}
+	if strings.Contains(err.Error(), executableNotExistErrMessage) {
+		return &ErrNotationNotExist{}
+	}
+	return fmt.Errorf("sign image %s: %w", image, err)
+}
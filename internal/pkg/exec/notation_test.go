@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotationCommand_Sign(t *testing.T) {
+	const (
+		mockImage   = "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc@sha256:mockDigest"
+		mockProfile = "arn:aws:signer:us-west-2:123456789012:/signing-profiles/mockProfile"
+	)
+	wantedArgs := []string{"sign", "--plugin", "com.amazonaws.signer.notation.plugin", "--id", mockProfile, mockImage}
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return ErrNotationNotExist if notation is not installed": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(notationBinaryName, wantedArgs).Return(errors.New(`exec: "notation": executable file not found in $PATH`))
+			},
+			wantedError: &ErrNotationNotExist{},
+		},
+		"return error if fail to sign the image": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(notationBinaryName, wantedArgs).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("sign image %s: some error", mockImage),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(notationBinaryName, wantedArgs).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			cmd := NotationCommand{
+				runner: mockRunner,
+			}
+
+			err := cmd.Sign(mockImage, mockProfile)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
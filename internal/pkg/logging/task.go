@@ -20,7 +20,7 @@ const (
 	numCWLogsCallsPerRound = 10
 	fmtTaskLogGroupName    = "/copilot/%s"
 	// e.g., copilot-task/python/4f8243e83f8a4bdaa7587fa1eaff2ea3
-	fmtTaskLogStreamName = "copilot-task/%s/%s"
+	fmtTaskLogStreamPrefix = "copilot-task/%s"
 )
 
 // TasksDescriber describes ECS tasks.
@@ -31,8 +31,9 @@ type TasksDescriber interface {
 // TaskClient retrieves the logs of Amazon ECS tasks.
 type TaskClient struct {
 	// Inputs to the task client.
-	groupName string
-	tasks     []*task.Task
+	logGroupName        string
+	logStreamNamePrefix string
+	tasks               []*task.Task
 
 	eventsWriter  io.Writer
 	eventsLogger  logGetter
@@ -44,9 +45,19 @@ type TaskClient struct {
 
 // NewTaskClient returns a TaskClient that can retrieve logs from the given tasks under the groupName.
 func NewTaskClient(sess *session.Session, groupName string, tasks []*task.Task) *TaskClient {
+	return newTaskClient(sess, fmt.Sprintf(fmtTaskLogGroupName, groupName), fmt.Sprintf(fmtTaskLogStreamPrefix, groupName), tasks)
+}
+
+// NewJobTaskClient returns a TaskClient that can retrieve logs from the tasks started by a job.
+func NewJobTaskClient(sess *session.Session, app, env, job string, tasks []*task.Task) *TaskClient {
+	return newTaskClient(sess, fmt.Sprintf(fmtSvclogGroupName, app, env, job), fmt.Sprintf(fmtSvcLogStreamPrefix, job), tasks)
+}
+
+func newTaskClient(sess *session.Session, logGroupName, logStreamNamePrefix string, tasks []*task.Task) *TaskClient {
 	return &TaskClient{
-		groupName: groupName,
-		tasks:     tasks,
+		logGroupName:        logGroupName,
+		logStreamNamePrefix: logStreamNamePrefix,
+		tasks:               tasks,
 
 		taskDescriber: ecs.New(sess),
 		eventsLogger:  cloudwatchlogs.New(sess),
@@ -61,7 +72,7 @@ func NewTaskClient(sess *session.Session, groupName string, tasks []*task.Task)
 // WriteEventsUntilStopped writes tasks' events to a writer until all tasks have stopped.
 func (t *TaskClient) WriteEventsUntilStopped() error {
 	in := cloudwatchlogs.LogEventsOpts{
-		LogGroup: fmt.Sprintf(fmtTaskLogGroupName, t.groupName),
+		LogGroup: t.logGroupName,
 	}
 	for {
 		logStreams, err := t.logStreamNamesFromTasks(t.tasks)
@@ -127,7 +138,7 @@ func (t *TaskClient) logStreamNamesFromTasks(tasks []*task.Task) ([]string, erro
 		if err != nil {
 			return nil, fmt.Errorf("parse task ID from ARN %s", task.TaskARN)
 		}
-		logStreamNames = append(logStreamNames, fmt.Sprintf(fmtTaskLogStreamName, t.groupName, id))
+		logStreamNames = append(logStreamNames, fmt.Sprintf("%s/%s", t.logStreamNamePrefix, id))
 	}
 	return logStreamNames, nil
 }
@@ -133,8 +133,9 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 			tc.setUpMocks(mocks)
 
 			ew := &TaskClient{
-				groupName: groupName,
-				tasks:     tc.tasks,
+				logGroupName:        "/copilot/" + groupName,
+				logStreamNamePrefix: "copilot-task/" + groupName,
+				tasks:               tc.tasks,
 
 				eventsWriter:  mockWriter{},
 				eventsLogger:  mocks.logGetter,
@@ -1171,6 +1171,42 @@ func TestApplyEnv_Platform(t *testing.T) {
 				}
 			},
 		},
+		"platform string is overridden if platform list is not nil": {
+			inSvc: func(svc *LoadBalancedWebService) {
+				svc.Platform = &PlatformArgsOrString{
+					PlatformString: aws.String("mockPlatform"),
+				}
+				svc.Environments["test"].Platform = &PlatformArgsOrString{
+					PlatformList: []string{"mockPlatformA", "mockPlatformB"},
+				}
+			},
+			wanted: func(svc *LoadBalancedWebService) {
+				svc.Platform = &PlatformArgsOrString{
+					PlatformList: []string{"mockPlatformA", "mockPlatformB"},
+				}
+			},
+		},
+		"platform list is overridden if platform args is not nil": {
+			inSvc: func(svc *LoadBalancedWebService) {
+				svc.Platform = &PlatformArgsOrString{
+					PlatformList: []string{"mockPlatformA", "mockPlatformB"},
+				}
+				svc.Environments["test"].Platform = &PlatformArgsOrString{
+					PlatformArgs: PlatformArgs{
+						OSFamily: aws.String("mock"),
+						Arch:     aws.String("platformTest"),
+					},
+				}
+			},
+			wanted: func(svc *LoadBalancedWebService) {
+				svc.Platform = &PlatformArgsOrString{
+					PlatformArgs: PlatformArgs{
+						OSFamily: aws.String("mock"),
+						Arch:     aws.String("platformTest"),
+					},
+				}
+			},
+		},
 		"platform string overridden": {
 			inSvc: func(svc *LoadBalancedWebService) {
 				svc.Platform = &PlatformArgsOrString{
@@ -27,12 +27,14 @@ type BackendService struct {
 type BackendServiceConfig struct {
 	ImageConfig      ImageWithPortAndHealthcheck `yaml:"image,flow"`
 	ImageOverride    `yaml:",inline"`
+	RoutingRule      `yaml:"http,flow"`
 	TaskConfig       `yaml:",inline"`
 	*Logging         `yaml:"logging,flow"`
 	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"`
 	Network          *NetworkConfig            `yaml:"network"`
 	Publish          *PublishConfig            `yaml:"publish"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Deployment       DeploymentConfig          `yaml:"deployment"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -79,6 +81,11 @@ func (s *BackendService) Port() (port uint16, ok bool) {
 	return aws.Uint16Value(value), true
 }
 
+// HTTPEnabled returns true if the service is placed behind the internal load balancer of the environment.
+func (s *BackendService) HTTPEnabled() bool {
+	return s.BackendServiceConfig.RoutingRule.Path != nil
+}
+
 // Publish returns the list of topics where notifications can be published.
 func (s *BackendService) Publish() []Topic {
 	if s.BackendServiceConfig.Publish == nil {
@@ -97,6 +104,16 @@ func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
 }
 
+// ImageScan returns the configuration of the vulnerability scan of the service's image.
+func (s *BackendService) ImageScan() *ImageScan {
+	return s.ImageConfig.Scan
+}
+
+// ImageSigning returns the configuration of the signature of the service's image.
+func (s *BackendService) ImageSigning() *ImageSigning {
+	return s.ImageConfig.Sign
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -214,6 +214,46 @@ func TestBackendService_Port(t *testing.T) {
 	}
 }
 
+func TestBackendService_HTTPEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted bool
+	}{
+		"disabled without an http section": {
+			in: `
+name: api
+type: Backend Service
+image:
+  port: 8080
+`,
+		},
+		"enabled with a path": {
+			in: `
+name: api
+type: Backend Service
+image:
+  port: 8080
+http:
+  path: 'orders'
+  healthcheck: '/_health'
+`,
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := UnmarshalWorkload([]byte(tc.in))
+			require.NoError(t, err)
+
+			// THEN
+			require.Equal(t, tc.wanted, mft.(*BackendService).HTTPEnabled())
+		})
+	}
+}
+
 func TestBackendService_Publish(t *testing.T) {
 	testCases := map[string]struct {
 		mft *BackendService
@@ -254,6 +294,32 @@ func TestBackendService_Publish(t *testing.T) {
 	}
 }
 
+func TestBackendService_Deployment(t *testing.T) {
+	// GIVEN
+	in := []byte(`name: api
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 80
+deployment:
+  circuit_breaker: false
+environments:
+  prod:
+    deployment:
+      circuit_breaker: true
+`)
+
+	// WHEN
+	mft, err := UnmarshalWorkload(in)
+	require.NoError(t, err)
+	require.Equal(t, aws.Bool(false), mft.(*BackendService).Deployment.CircuitBreaker)
+	prod, err := mft.ApplyEnv("prod")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, aws.Bool(true), prod.(*BackendService).Deployment.CircuitBreaker)
+}
+
 func TestBackendSvc_ApplyEnv(t *testing.T) {
 	mockBackendServiceWithNoEnvironments := BackendService{
 		Workload: Workload{
@@ -0,0 +1,240 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvironmentManifestType identifies that the type of a manifest is an environment manifest.
+const EnvironmentManifestType = "Environment"
+
+var (
+	errIDAndCIDR              = errors.New(`must specify one, not both, of "id" and "cidr"`)
+	errNATGatewaysImportedVPC = errors.New(`cannot specify "nat_gateways" when importing a VPC`)
+)
+
+// Environment is the manifest configuration for an environment.
+type Environment struct {
+	Name              *string `yaml:"name"`
+	Type              *string `yaml:"type"`
+	EnvironmentConfig `yaml:",inline"`
+}
+
+// EnvironmentConfig holds the configuration of the resources shared by the workloads of an environment.
+type EnvironmentConfig struct {
+	Network       EnvironmentNetworkConfig `yaml:"network,omitempty"`
+	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http,omitempty"`
+	Observability EnvironmentObservability `yaml:"observability,omitempty"`
+}
+
+// EnvironmentNetworkConfig holds the networking configuration of an environment.
+type EnvironmentNetworkConfig struct {
+	VPC EnvironmentVPCConfig `yaml:"vpc,omitempty"`
+}
+
+// EnvironmentVPCConfig holds the configuration of the VPC of an environment.
+// The VPC is imported if ID is set, otherwise Copilot creates the VPC.
+type EnvironmentVPCConfig struct {
+	ID          *string              `yaml:"id"`
+	CIDR        *string              `yaml:"cidr"`
+	Subnets     SubnetsConfiguration `yaml:"subnets,omitempty"`
+	NATGateways *bool                `yaml:"nat_gateways"` // Create NAT gateways even if no workload is placed in the private subnets.
+}
+
+// SubnetsConfiguration holds the public and private subnets of an environment.
+type SubnetsConfiguration struct {
+	Public  []SubnetConfiguration `yaml:"public,omitempty"`
+	Private []SubnetConfiguration `yaml:"private,omitempty"`
+}
+
+// SubnetConfiguration holds either the ID of an existing subnet or the CIDR range of a subnet to create.
+type SubnetConfiguration struct {
+	ID   *string `yaml:"id"`
+	CIDR *string `yaml:"cidr"`
+}
+
+// EnvironmentHTTPConfig holds the configuration of the load balancers of an environment.
+type EnvironmentHTTPConfig struct {
+	Public PublicHTTPConfig `yaml:"public,omitempty"`
+}
+
+// PublicHTTPConfig holds the configuration of the public Application Load Balancer.
+type PublicHTTPConfig struct {
+	Certificates []string `yaml:"certificates,omitempty"` // ARNs of ACM certificates attached to the HTTPS listener.
+	LoadBalancer *string  `yaml:"load_balancer"`          // ARN of an existing load balancer to use instead of creating one.
+}
+
+// EnvironmentObservability holds the observability settings of an environment.
+type EnvironmentObservability struct {
+	ContainerInsights *bool `yaml:"container_insights"`
+}
+
+// UnmarshalEnvironment deserializes the YAML input stream into an environment manifest object.
+func UnmarshalEnvironment(in []byte) (*Environment, error) {
+	var m Environment
+	if err := yaml.Unmarshal(in, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal environment manifest: %w", err)
+	}
+	if typ := aws.StringValue(m.Type); typ != EnvironmentManifestType {
+		return nil, fmt.Errorf(`manifest type %q must be %q`, typ, EnvironmentManifestType)
+	}
+	return &m, nil
+}
+
+// IsImported returns true if the environment uses an existing VPC.
+func (v EnvironmentVPCConfig) IsImported() bool {
+	return v.ID != nil
+}
+
+// IsAdjusted returns true if the environment creates a VPC with custom CIDR ranges or settings.
+func (v EnvironmentVPCConfig) IsAdjusted() bool {
+	return !v.IsImported() && (v.CIDR != nil || len(v.Subnets.Public) != 0 || len(v.Subnets.Private) != 0 || aws.BoolValue(v.NATGateways))
+}
+
+// PublicSubnetIDs returns the IDs of the imported public subnets.
+func (s SubnetsConfiguration) PublicSubnetIDs() []string {
+	return subnetValues(s.Public, func(sub SubnetConfiguration) *string { return sub.ID })
+}
+
+// PrivateSubnetIDs returns the IDs of the imported private subnets.
+func (s SubnetsConfiguration) PrivateSubnetIDs() []string {
+	return subnetValues(s.Private, func(sub SubnetConfiguration) *string { return sub.ID })
+}
+
+// PublicSubnetCIDRs returns the CIDR ranges of the public subnets to create.
+func (s SubnetsConfiguration) PublicSubnetCIDRs() []string {
+	return subnetValues(s.Public, func(sub SubnetConfiguration) *string { return sub.CIDR })
+}
+
+// PrivateSubnetCIDRs returns the CIDR ranges of the private subnets to create.
+func (s SubnetsConfiguration) PrivateSubnetCIDRs() []string {
+	return subnetValues(s.Private, func(sub SubnetConfiguration) *string { return sub.CIDR })
+}
+
+func subnetValues(subnets []SubnetConfiguration, value func(SubnetConfiguration) *string) []string {
+	var values []string
+	for _, sub := range subnets {
+		if v := value(sub); v != nil {
+			values = append(values, *v)
+		}
+	}
+	return values
+}
+
+// Validate returns an error if the environment manifest has missing or conflicting fields.
+func (e *Environment) Validate() error {
+	if aws.StringValue(e.Name) == "" {
+		return errors.New(`"name" must be specified`)
+	}
+	if err := e.Network.VPC.validate(); err != nil {
+		return fmt.Errorf(`validate "network.vpc": %w`, err)
+	}
+	for i, cert := range e.HTTPConfig.Public.Certificates {
+		parsed, err := arn.Parse(cert)
+		if err != nil || parsed.Service != "acm" {
+			return fmt.Errorf(`validate "http.public.certificates[%d]": %q is not the ARN of an ACM certificate`, i, cert)
+		}
+	}
+	if lb := e.HTTPConfig.Public.LoadBalancer; lb != nil {
+		parsed, err := arn.Parse(aws.StringValue(lb))
+		if err != nil || parsed.Service != "elasticloadbalancing" {
+			return fmt.Errorf(`validate "http.public.load_balancer": %q is not the ARN of a load balancer`, aws.StringValue(lb))
+		}
+		if !e.Network.VPC.IsImported() {
+			return errors.New(`validate "http.public.load_balancer": must specify "network.vpc.id" to import the VPC of the load balancer`)
+		}
+	}
+	return nil
+}
+
+func (v EnvironmentVPCConfig) validate() error {
+	if v.IsImported() {
+		return v.validateImported()
+	}
+	return v.validateAdjusted()
+}
+
+func (v EnvironmentVPCConfig) validateImported() error {
+	if v.CIDR != nil {
+		return errIDAndCIDR
+	}
+	if v.NATGateways != nil {
+		return errNATGatewaysImportedVPC
+	}
+	if err := validateSubnets(v.Subnets, func(sub SubnetConfiguration) error {
+		if sub.ID == nil {
+			return errors.New(`must specify "id" when importing a VPC`)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// We allow 0 or 2+ public subnets.
+	if len(v.Subnets.Public) == 1 {
+		return errors.New("at least two public subnets must be imported to enable Load Balancing")
+	}
+	// We require 2+ private subnets.
+	if len(v.Subnets.Private) < 2 {
+		return errors.New("at least two private subnets must be imported")
+	}
+	return nil
+}
+
+func (v EnvironmentVPCConfig) validateAdjusted() error {
+	if v.CIDR != nil {
+		if _, _, err := net.ParseCIDR(aws.StringValue(v.CIDR)); err != nil {
+			return fmt.Errorf(`"cidr" %q is not a valid CIDR range`, aws.StringValue(v.CIDR))
+		}
+	}
+	if err := validateSubnets(v.Subnets, func(sub SubnetConfiguration) error {
+		if sub.CIDR == nil {
+			return errors.New(`must specify "cidr" unless "vpc.id" is specified`)
+		}
+		if _, _, err := net.ParseCIDR(aws.StringValue(sub.CIDR)); err != nil {
+			return fmt.Errorf(`"cidr" %q is not a valid CIDR range`, aws.StringValue(sub.CIDR))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if v.CIDR == nil && len(v.Subnets.Public)+len(v.Subnets.Private) == 0 {
+		return nil // Use the default VPC configuration.
+	}
+	if v.CIDR == nil {
+		return errors.New(`must specify "cidr" when the CIDR ranges of the subnets are specified`)
+	}
+	if len(v.Subnets.Public) == 0 || len(v.Subnets.Private) == 0 {
+		return errors.New(`must specify both "subnets.public" and "subnets.private" when "cidr" is specified`)
+	}
+	// Each private subnet routes its traffic through a NAT gateway placed in the public subnet of the same availability zone.
+	if len(v.Subnets.Private) > len(v.Subnets.Public) {
+		return errors.New(`cannot specify more "subnets.private" than "subnets.public"`)
+	}
+	return nil
+}
+
+func validateSubnets(subnets SubnetsConfiguration, validate func(SubnetConfiguration) error) error {
+	for _, placement := range subnetPlacements {
+		subs := subnets.Public
+		if placement == PrivateSubnetPlacement {
+			subs = subnets.Private
+		}
+		for i, sub := range subs {
+			if sub.ID != nil && sub.CIDR != nil {
+				return fmt.Errorf(`validate "subnets.%s[%d]": %w`, placement, i, errIDAndCIDR)
+			}
+			if err := validate(sub); err != nil {
+				return fmt.Errorf(`validate "subnets.%s[%d]": %w`, placement, i, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    *Environment
+		wantedErr string
+	}{
+		"unmarshals an environment manifest": {
+			in: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+        - cidr: 10.1.1.0/24
+      private:
+        - cidr: 10.1.2.0/24
+    nat_gateways: true
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/abc
+observability:
+  container_insights: true`,
+			wanted: &Environment{
+				Name: aws.String("test"),
+				Type: aws.String(EnvironmentManifestType),
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR: aws.String("10.1.0.0/16"),
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{
+									{CIDR: aws.String("10.1.0.0/24")},
+									{CIDR: aws.String("10.1.1.0/24")},
+								},
+								Private: []SubnetConfiguration{
+									{CIDR: aws.String("10.1.2.0/24")},
+								},
+							},
+							NATGateways: aws.Bool(true),
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+						},
+					},
+					Observability: EnvironmentObservability{
+						ContainerInsights: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"errors if the manifest is not an environment manifest": {
+			in: `name: api
+type: Backend Service`,
+			wantedErr: `manifest type "Backend Service" must be "Environment"`,
+		},
+		"errors if the manifest is not YAML": {
+			in:        `name: [`,
+			wantedErr: "unmarshal environment manifest: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnmarshalEnvironment([]byte(tc.in))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEnvironment_Validate(t *testing.T) {
+	twoSubnets := func(field string) []SubnetConfiguration {
+		if field == "id" {
+			return []SubnetConfiguration{{ID: aws.String("subnet-1")}, {ID: aws.String("subnet-2")}}
+		}
+		return []SubnetConfiguration{{CIDR: aws.String("10.0.0.0/24")}, {CIDR: aws.String("10.0.1.0/24")}}
+	}
+	testCases := map[string]struct {
+		in EnvironmentConfig
+
+		wantedErr string
+	}{
+		"default configuration": {},
+		"imported VPC": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						ID: aws.String("vpc-1"),
+						Subnets: SubnetsConfiguration{
+							Public:  twoSubnets("id"),
+							Private: twoSubnets("id"),
+						},
+					},
+				},
+			},
+		},
+		"imported VPC with a CIDR range": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						ID:   aws.String("vpc-1"),
+						CIDR: aws.String("10.0.0.0/16"),
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": must specify one, not both, of "id" and "cidr"`,
+		},
+		"imported VPC with NAT gateways": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						ID:          aws.String("vpc-1"),
+						NATGateways: aws.Bool(true),
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": cannot specify "nat_gateways" when importing a VPC`,
+		},
+		"imported VPC with a subnet to create": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						ID: aws.String("vpc-1"),
+						Subnets: SubnetsConfiguration{
+							Public:  twoSubnets("id"),
+							Private: twoSubnets("cidr"),
+						},
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": validate "subnets.private[0]": must specify "id" when importing a VPC`,
+		},
+		"imported VPC with a single private subnet": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						ID: aws.String("vpc-1"),
+						Subnets: SubnetsConfiguration{
+							Private: twoSubnets("id")[:1],
+						},
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": at least two private subnets must be imported`,
+		},
+		"subnet with both an ID and a CIDR range": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						CIDR: aws.String("10.0.0.0/16"),
+						Subnets: SubnetsConfiguration{
+							Public: []SubnetConfiguration{{ID: aws.String("subnet-1"), CIDR: aws.String("10.0.0.0/24")}},
+						},
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": validate "subnets.public[0]": must specify one, not both, of "id" and "cidr"`,
+		},
+		"adjusted VPC": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						CIDR: aws.String("10.0.0.0/16"),
+						Subnets: SubnetsConfiguration{
+							Public:  twoSubnets("cidr"),
+							Private: twoSubnets("cidr"),
+						},
+						NATGateways: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"adjusted VPC with an invalid CIDR range": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						CIDR: aws.String("10.0.0.0"),
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": "cidr" "10.0.0.0" is not a valid CIDR range`,
+		},
+		"adjusted VPC without subnets": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						CIDR: aws.String("10.0.0.0/16"),
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": must specify both "subnets.public" and "subnets.private" when "cidr" is specified`,
+		},
+		"subnets without the CIDR range of the VPC": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						Subnets: SubnetsConfiguration{
+							Public:  twoSubnets("cidr"),
+							Private: twoSubnets("cidr"),
+						},
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": must specify "cidr" when the CIDR ranges of the subnets are specified`,
+		},
+		"more private subnets than public subnets": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						CIDR: aws.String("10.0.0.0/16"),
+						Subnets: SubnetsConfiguration{
+							Public:  twoSubnets("cidr")[:1],
+							Private: twoSubnets("cidr"),
+						},
+					},
+				},
+			},
+			wantedErr: `validate "network.vpc": cannot specify more "subnets.private" than "subnets.public"`,
+		},
+		"invalid certificate ARN": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc", "arn:aws:iam::123456789012:server-certificate/abc"},
+					},
+				},
+			},
+			wantedErr: `validate "http.public.certificates[1]": "arn:aws:iam::123456789012:server-certificate/abc" is not the ARN of an ACM certificate`,
+		},
+		"imported load balancer": {
+			in: EnvironmentConfig{
+				Network: EnvironmentNetworkConfig{
+					VPC: EnvironmentVPCConfig{
+						ID: aws.String("vpc-1"),
+						Subnets: SubnetsConfiguration{
+							Public:  twoSubnets("id"),
+							Private: twoSubnets("id"),
+						},
+					},
+				},
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						LoadBalancer: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"),
+					},
+				},
+			},
+		},
+		"invalid load balancer ARN": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						LoadBalancer: aws.String("my-lb"),
+					},
+				},
+			},
+			wantedErr: `validate "http.public.load_balancer": "my-lb" is not the ARN of a load balancer`,
+		},
+		"imported load balancer without its VPC": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						LoadBalancer: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"),
+					},
+				},
+			},
+			wantedErr: `validate "http.public.load_balancer": must specify "network.vpc.id" to import the VPC of the load balancer`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			env := &Environment{
+				Name:              aws.String("test"),
+				Type:              aws.String(EnvironmentManifestType),
+				EnvironmentConfig: tc.in,
+			}
+
+			err := env.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
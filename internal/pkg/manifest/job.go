@@ -48,9 +48,23 @@ type ScheduledJobConfig struct {
 	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
 }
 
-// JobTriggerConfig represents the configuration for the event that triggers the job.
+// JobTriggerConfig represents the configuration for the events that trigger the job.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule      *string                  `yaml:"schedule"`
+	EventPatterns []map[string]interface{} `yaml:"event_patterns"` // EventBridge event patterns.
+	S3            []S3Trigger              `yaml:"s3"`
+	Topics        []TopicSubscription      `yaml:"topics"`
+}
+
+// IsEmpty returns true if the job has no schedule and no events to trigger it.
+func (t JobTriggerConfig) IsEmpty() bool {
+	return aws.StringValue(t.Schedule) == "" && len(t.EventPatterns) == 0 && len(t.S3) == 0 && len(t.Topics) == 0
+}
+
+// S3Trigger represents the configuration to trigger a job when objects are created in the bucket of a storage addon.
+type S3Trigger struct {
+	Bucket string  `yaml:"bucket"` // Name of the S3 storage addon.
+	Prefix *string `yaml:"prefix"`
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -123,6 +137,11 @@ func (j *ScheduledJob) Publish() []Topic {
 	return j.ScheduledJobConfig.Publish.Topics
 }
 
+// Subscriptions returns the list of topics the job is triggered by.
+func (j *ScheduledJob) Subscriptions() []TopicSubscription {
+	return j.On.Topics
+}
+
 // BuildArgs returns a docker.BuildArguments object for the job given a workspace root.
 func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return j.ImageConfig.BuildConfig(wsRoot)
@@ -133,6 +152,16 @@ func (j *ScheduledJob) BuildRequired() (bool, error) {
 	return requiresBuild(j.ImageConfig.Image)
 }
 
+// ImageScan returns the configuration of the vulnerability scan of the job's image.
+func (j *ScheduledJob) ImageScan() *ImageScan {
+	return j.ImageConfig.Scan
+}
+
+// ImageSigning returns the configuration of the signature of the job's image.
+func (j *ScheduledJob) ImageSigning() *ImageSigning {
+	return j.ImageConfig.Sign
+}
+
 // JobDockerfileBuildRequired returns if the job container image should be built from local Dockerfile.
 func JobDockerfileBuildRequired(job interface{}) (bool, error) {
 	return dockerfileBuildRequired("job", job)
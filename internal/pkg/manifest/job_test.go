@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestScheduledJob_MarshalBinary(t *testing.T) {
@@ -407,3 +408,72 @@ func TestScheduledJob_Publish(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduledJob_UnmarshalTriggers(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedTriggers JobTriggerConfig
+		wantedEmpty    bool
+	}{
+		"schedule only": {
+			inContent: `on:
+  schedule: "@daily"`,
+			wantedTriggers: JobTriggerConfig{
+				Schedule: stringP("@daily"),
+			},
+		},
+		"event driven triggers": {
+			inContent: `on:
+  event_patterns:
+    - source: ["aws.ecr"]
+      detail-type: ["ECR Image Action"]
+  s3:
+    - bucket: uploads
+      prefix: incoming/
+  topics:
+    - name: orders
+      service: api`,
+			wantedTriggers: JobTriggerConfig{
+				EventPatterns: []map[string]interface{}{
+					{
+						"source":      []interface{}{"aws.ecr"},
+						"detail-type": []interface{}{"ECR Image Action"},
+					},
+				},
+				S3: []S3Trigger{
+					{
+						Bucket: "uploads",
+						Prefix: stringP("incoming/"),
+					},
+				},
+				Topics: []TopicSubscription{
+					{
+						Name:    "orders",
+						Service: "api",
+					},
+				},
+			},
+		},
+		"no triggers": {
+			inContent:   `name: report`,
+			wantedEmpty: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var job ScheduledJob
+
+			// WHEN
+			err := yaml.Unmarshal([]byte(tc.inContent), &job)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTriggers, job.On)
+			require.Equal(t, tc.wantedEmpty, job.On.IsEmpty())
+			require.Equal(t, tc.wantedTriggers.Topics, job.Subscriptions())
+		})
+	}
+}
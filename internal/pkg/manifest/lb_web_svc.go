@@ -50,10 +50,53 @@ type LoadBalancedWebServiceConfig struct {
 	RoutingRule      `yaml:"http,flow"`
 	TaskConfig       `yaml:",inline"`
 	*Logging         `yaml:"logging,flow"`
-	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"`
-	Network          *NetworkConfig            `yaml:"network"` // TODO: the type needs to be updated after we upgrade mergo
-	Publish          *PublishConfig            `yaml:"publish"`
-	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Sidecars         map[string]*SidecarConfig        `yaml:"sidecars"`
+	Network          *NetworkConfig                   `yaml:"network"` // TODO: the type needs to be updated after we upgrade mergo
+	Publish          *PublishConfig                   `yaml:"publish"`
+	TaskDefOverrides []OverrideRule                   `yaml:"taskdef_overrides"`
+	Deployment       DeploymentConfig                 `yaml:"deployment"`
+	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
+}
+
+// NetworkLoadBalancerConfiguration holds options for a network load balancer in front of the service.
+type NetworkLoadBalancerConfiguration struct {
+	Port            *uint16            `yaml:"port"`
+	Protocol        *string            `yaml:"protocol"`
+	Certificate     *string            `yaml:"certificate"`
+	TargetContainer *string            `yaml:"target_container"`
+	HealthCheck     NLBHealthCheckArgs `yaml:"healthcheck"`
+}
+
+// IsEmpty returns true if the network load balancer is not configured.
+func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
+	return c.Port == nil && c.Protocol == nil && c.Certificate == nil && c.TargetContainer == nil && c.HealthCheck.isEmpty()
+}
+
+// Protocols supported by the listener of a network load balancer.
+const (
+	NLBProtocolTCP = "TCP"
+	NLBProtocolUDP = "UDP"
+	NLBProtocolTLS = "TLS"
+)
+
+// NLBProtocols are the supported protocols for the listener of a network load balancer.
+var NLBProtocols = []string{
+	NLBProtocolTCP,
+	NLBProtocolUDP,
+	NLBProtocolTLS,
+}
+
+// NLBHealthCheckArgs holds the configuration to determine if the network load balanced targets are healthy.
+type NLBHealthCheckArgs struct {
+	Port               *uint16        `yaml:"port"`
+	HealthyThreshold   *int64         `yaml:"healthy_threshold"`
+	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
+	Timeout            *time.Duration `yaml:"timeout"`
+	Interval           *time.Duration `yaml:"interval"`
+}
+
+func (h *NLBHealthCheckArgs) isEmpty() bool {
+	return h.Port == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Timeout == nil && h.Interval == nil
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -145,6 +188,16 @@ func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
 }
 
+// ImageScan returns the configuration of the vulnerability scan of the service's image.
+func (s *LoadBalancedWebService) ImageScan() *ImageScan {
+	return s.ImageConfig.Scan
+}
+
+// ImageSigning returns the configuration of the signature of the service's image.
+func (s *LoadBalancedWebService) ImageSigning() *ImageSigning {
+	return s.ImageConfig.Sign
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s LoadBalancedWebService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -185,6 +238,48 @@ type RoutingRule struct {
 	AllowedSourceIps         *[]string `yaml:"allowed_source_ips"` // TODO: the type needs to be updated after we upgrade mergo
 }
 
+// Deployment strategies for a load balanced web service.
+const (
+	RollingDeploymentStrategy   = "rolling"
+	BlueGreenDeploymentStrategy = "blue_green"
+	CanaryDeploymentStrategy    = "canary"
+)
+
+// DeploymentStrategies are the supported deployment strategies for a load balanced web service.
+var DeploymentStrategies = []string{
+	RollingDeploymentStrategy,
+	BlueGreenDeploymentStrategy,
+	CanaryDeploymentStrategy,
+}
+
+// DeploymentConfig holds how new revisions of the service are rolled out.
+type DeploymentConfig struct {
+	Strategy *string `yaml:"strategy"`
+	// BakeTime is how long to wait after all traffic is shifted before the old revision is retired.
+	BakeTime       *time.Duration `yaml:"bake_time"`
+	Canary         CanaryConfig   `yaml:"canary"`
+	RollbackAlarms []string       `yaml:"rollback_alarms"` // Names of the CloudWatch alarms that roll back the deployment when they fire.
+	// CircuitBreaker rolls back deployments whose tasks repeatedly fail to reach a steady state. Defaults to true.
+	CircuitBreaker *bool `yaml:"circuit_breaker"`
+}
+
+// ShiftsTraffic returns true if new revisions of the service are rolled out by shifting traffic between target groups.
+func (d DeploymentConfig) ShiftsTraffic() bool {
+	strategy := aws.StringValue(d.Strategy)
+	return strategy == BlueGreenDeploymentStrategy || strategy == CanaryDeploymentStrategy
+}
+
+// CanaryConfig holds the share of traffic shifted to the new revision before shifting the rest.
+type CanaryConfig struct {
+	Percent  *int           `yaml:"percent"`
+	BakeTime *time.Duration `yaml:"bake_time"`
+}
+
+// IsEmpty returns true if the canary configuration is not set.
+func (c CanaryConfig) IsEmpty() bool {
+	return c.Percent == nil && c.BakeTime == nil
+}
+
 // Alias is a custom type which supports unmarshaling "http.alias" yaml which
 // can either be of type string or type slice of string.
 type Alias stringSliceOrString
@@ -152,6 +152,91 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
 	}
 }
 
+func TestLoadBalancedWebService_Deployment(t *testing.T) {
+	// GIVEN
+	in := []byte(`name: frontend
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+deployment:
+  strategy: canary
+  bake_time: 10m
+  canary:
+    percent: 10
+    bake_time: 5m
+  rollback_alarms: ["frontend-5xx"]
+  circuit_breaker: false
+environments:
+  test:
+    deployment:
+      strategy: blue_green
+`)
+
+	// WHEN
+	mft, err := UnmarshalWorkload(in)
+	require.NoError(t, err)
+	test, err := mft.ApplyEnv("test")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, DeploymentConfig{
+		Strategy: aws.String(CanaryDeploymentStrategy),
+		BakeTime: durationp(10 * time.Minute),
+		Canary: CanaryConfig{
+			Percent:  aws.Int(10),
+			BakeTime: durationp(5 * time.Minute),
+		},
+		RollbackAlarms: []string{"frontend-5xx"},
+		CircuitBreaker: aws.Bool(false),
+	}, mft.(*LoadBalancedWebService).Deployment)
+	require.Equal(t, aws.String(BlueGreenDeploymentStrategy), test.(*LoadBalancedWebService).Deployment.Strategy)
+	require.Equal(t, []string{"frontend-5xx"}, test.(*LoadBalancedWebService).Deployment.RollbackAlarms)
+	require.Equal(t, aws.Bool(false), test.(*LoadBalancedWebService).Deployment.CircuitBreaker)
+}
+
+func TestLoadBalancedWebService_NLB(t *testing.T) {
+	// GIVEN
+	in := []byte(`name: frontend
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+nlb:
+  port: 443
+  protocol: TLS
+  certificate: arn:aws:acm:us-west-2:123456789012:certificate/abc
+  healthcheck:
+    port: 80
+    interval: 10s
+environments:
+  test:
+    nlb:
+      protocol: TCP
+      certificate: ""
+`)
+
+	// WHEN
+	mft, err := UnmarshalWorkload(in)
+	require.NoError(t, err)
+	test, err := mft.ApplyEnv("test")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, NetworkLoadBalancerConfiguration{
+		Port:        aws.Uint16(443),
+		Protocol:    aws.String(NLBProtocolTLS),
+		Certificate: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"),
+		HealthCheck: NLBHealthCheckArgs{
+			Port:     aws.Uint16(80),
+			Interval: durationp(10 * time.Second),
+		},
+	}, mft.(*LoadBalancedWebService).NLBConfig)
+	require.Equal(t, aws.Uint16(443), test.(*LoadBalancedWebService).NLBConfig.Port)
+	require.Equal(t, aws.String(NLBProtocolTCP), test.(*LoadBalancedWebService).NLBConfig.Protocol)
+	require.True(t, (&NetworkLoadBalancerConfiguration{}).IsEmpty())
+}
+
 func TestLoadBalancedWebService_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		inProps LoadBalancedWebServiceProps
@@ -1333,6 +1418,53 @@ func TestLoadBalancedWebService_BuildRequired(t *testing.T) {
 			},
 			want: false,
 		},
+		"error if the image is scanned but not built": {
+			image: Image{
+				Location: aws.String("mockLocation"),
+				Scan:     &ImageScan{},
+			},
+			wantErr: fmt.Errorf(`"image.scan" can only be specified with "image.build"`),
+		},
+		"error if the image is signed but not built": {
+			image: Image{
+				Location: aws.String("mockLocation"),
+				Sign: &ImageSigning{
+					Profile: aws.String("arn:aws:signer:us-west-2:123456789012:/signing-profiles/mockProfile"),
+				},
+			},
+			wantErr: fmt.Errorf(`"image.sign" can only be specified with "image.build"`),
+		},
+		"error if the signing profile is not specified": {
+			image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuildString"),
+				},
+				Sign: &ImageSigning{},
+			},
+			wantErr: fmt.Errorf(`"image.sign.profile" must be specified`),
+		},
+		"error if the signing profile is not an AWS Signer signing profile ARN": {
+			image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuildString"),
+				},
+				Sign: &ImageSigning{
+					Profile: aws.String("arn:aws:kms:us-west-2:123456789012:key/mockKey"),
+				},
+			},
+			wantErr: fmt.Errorf(`"image.sign.profile" "arn:aws:kms:us-west-2:123456789012:key/mockKey" must be the ARN of an AWS Signer signing profile`),
+		},
+		"return true if the built image is signed": {
+			image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuildString"),
+				},
+				Sign: &ImageSigning{
+					Profile: aws.String("arn:aws:signer:us-west-2:123456789012:/signing-profiles/mockProfile"),
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
@@ -17,6 +18,8 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	ECRProviderName        = "ECR"
+	S3ProviderName         = "S3"
 
 	pipelineManifestPath = "cicd/pipeline.yml"
 )
@@ -26,6 +29,8 @@ var PipelineProviders = []string{
 	GithubProviderName,
 	CodeCommitProviderName,
 	BitbucketProviderName,
+	ECRProviderName,
+	S3ProviderName,
 }
 
 // Provider defines a source of the artifacts
@@ -92,6 +97,34 @@ func (p *bitbucketProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type ecrProvider struct {
+	properties *ECRProperties
+}
+
+func (p *ecrProvider) Name() string {
+	return ECRProviderName
+}
+func (p *ecrProvider) String() string {
+	return ECRProviderName
+}
+func (p *ecrProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type s3Provider struct {
+	properties *S3Properties
+}
+
+func (p *s3Provider) Name() string {
+	return S3ProviderName
+}
+func (p *s3Provider) String() string {
+	return S3ProviderName
+}
+func (p *s3Provider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubV1Properties contain information for configuring a Githubv1
 // source provider.
 type GitHubV1Properties struct {
@@ -123,6 +156,20 @@ type CodeCommitProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+// ECRProperties contains information for configuring an Amazon ECR
+// source provider. Pushing the tag to the repository triggers the pipeline.
+type ECRProperties struct {
+	Repository string `structs:"repository" yaml:"repository"`
+	Tag        string `structs:"tag" yaml:"tag"`
+}
+
+// S3Properties contains information for configuring an Amazon S3
+// source provider. Uploading the object to the bucket triggers the pipeline.
+type S3Properties struct {
+	Bucket    string `structs:"bucket" yaml:"bucket"`
+	ObjectKey string `structs:"object_key" yaml:"object_key"`
+}
+
 // NewProvider creates a source provider based on the type of
 // the provided provider-specific configurations
 func NewProvider(configs interface{}) (Provider, error) {
@@ -143,6 +190,14 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *ECRProperties:
+		return &ecrProvider{
+			properties: props,
+		}, nil
+	case *S3Properties:
+		return &s3Provider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -183,9 +238,55 @@ type Build struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string   `yaml:"name"`
-	RequiresApproval bool     `yaml:"requires_approval,omitempty"`
-	TestCommands     []string `yaml:"test_commands,omitempty"`
+	Name             string            `yaml:"name"`
+	Environments     []string          `yaml:"environments,omitempty"`
+	RequiresApproval bool              `yaml:"requires_approval,omitempty"`
+	Approval         *PipelineApproval `yaml:"approval,omitempty"`
+	PreDeployments   []PipelineAction  `yaml:"pre_deployments,omitempty"`
+	PostDeployments  []PipelineAction  `yaml:"post_deployments,omitempty"`
+	TestCommands     []string          `yaml:"test_commands,omitempty"`
+	Test             *PipelineTest     `yaml:"test,omitempty"`
+}
+
+// PipelineApproval represents the manual approval before the deployments of a stage.
+type PipelineApproval struct {
+	Topic   string         `yaml:"topic,omitempty"`   // ARN of the SNS topic notified when the approval is pending.
+	Timeout *time.Duration `yaml:"timeout,omitempty"` // Duration after which the approval is rejected.
+}
+
+// PipelineTest represents the CodeBuild project that tests a stage after its deployments.
+type PipelineTest struct {
+	Image     string               `yaml:"image,omitempty"`
+	Buildspec string               `yaml:"buildspec,omitempty"`
+	Variables map[string]string    `yaml:"variables,omitempty"`
+	Secrets   map[string]string    `yaml:"secrets,omitempty"`
+	Network   *PipelineTestNetwork `yaml:"network,omitempty"`
+}
+
+// PipelineTestNetwork represents where the tests of a stage run.
+type PipelineTestNetwork struct {
+	// Environment is the name of the environment of the stage whose private subnets the tests run in.
+	Environment string `yaml:"environment"`
+}
+
+// PipelineAction represents commands run in a stage of the pipeline before or after its deployments.
+type PipelineAction struct {
+	Name     string   `yaml:"name"`
+	Commands []string `yaml:"commands"`
+}
+
+// EnvironmentNames returns the names of the environments deployed in parallel by the stage.
+// A stage without environments deploys to the environment with the same name as the stage.
+func (s PipelineStage) EnvironmentNames() []string {
+	if len(s.Environments) == 0 {
+		return []string{s.Name}
+	}
+	return s.Environments
+}
+
+// ApprovalRequired returns true if the deployments of the stage wait for a manual approval.
+func (s PipelineStage) ApprovalRequired() bool {
+	return s.RequiresApproval || s.Approval != nil
 }
 
 // NewPipelineManifest returns a pipeline manifest object.
@@ -234,6 +335,12 @@ func UnmarshalPipeline(in []byte) (*PipelineManifest, error) {
 		return nil, err
 	}
 
+	if err := validateStages(pm.Stages); err != nil {
+		return nil, err
+	}
+	if err := validateECRSourceStages(pm.Source, pm.Stages); err != nil {
+		return nil, err
+	}
 	// TODO: #221 Do more validations
 	switch version {
 	case Ver1:
@@ -266,3 +373,103 @@ func validateVersion(pm *PipelineManifest) (PipelineSchemaMajorVersion, error) {
 			}
 	}
 }
+
+// validateStages returns an error if a stage or an environment appears more than once in the pipeline,
+// or if the pre and post deployment actions of a stage are not named uniquely.
+func validateStages(stages []PipelineStage) error {
+	stageNames := make(map[string]bool)
+	envStages := make(map[string]string)
+	for _, stage := range stages {
+		if stage.Name == "" {
+			return errors.New(`"name" must be specified for every stage`)
+		}
+		if stageNames[stage.Name] {
+			return fmt.Errorf("stage %s is defined more than once", stage.Name)
+		}
+		stageNames[stage.Name] = true
+		for _, env := range stage.EnvironmentNames() {
+			if prev, ok := envStages[env]; ok {
+				return fmt.Errorf("environment %s is deployed by both stages %s and %s", env, prev, stage.Name)
+			}
+			envStages[env] = stage.Name
+		}
+		if err := validateStageApproval(stage); err != nil {
+			return err
+		}
+		if err := validateStageTest(stage); err != nil {
+			return err
+		}
+		actionNames := make(map[string]bool)
+		for _, action := range append(append([]PipelineAction{}, stage.PreDeployments...), stage.PostDeployments...) {
+			if action.Name == "" {
+				return fmt.Errorf(`"name" must be specified for the pre and post deployments of stage %s`, stage.Name)
+			}
+			if actionNames[action.Name] {
+				return fmt.Errorf("action %s is defined more than once in stage %s", action.Name, stage.Name)
+			}
+			if len(action.Commands) == 0 {
+				return fmt.Errorf(`"commands" must be specified for action %s in stage %s`, action.Name, stage.Name)
+			}
+			actionNames[action.Name] = true
+		}
+	}
+	return nil
+}
+
+// validateECRSourceStages returns an error if a stage of a pipeline triggered by an Amazon ECR repository runs commands.
+// The only input of the actions of such a pipeline is the imageDetail.json file of the pushed image, not the workspace.
+func validateECRSourceStages(source *Source, stages []PipelineStage) error {
+	if source == nil || source.ProviderName != ECRProviderName {
+		return nil
+	}
+	for _, stage := range stages {
+		if len(stage.TestCommands) > 0 || stage.Test != nil {
+			return fmt.Errorf(`"test_commands" and "test" cannot be specified for stage %s: the source of a pipeline with provider %s does not contain your workspace`, stage.Name, ECRProviderName)
+		}
+		if len(stage.PreDeployments) > 0 || len(stage.PostDeployments) > 0 {
+			return fmt.Errorf(`"pre_deployments" and "post_deployments" cannot be specified for stage %s: the source of a pipeline with provider %s does not contain your workspace`, stage.Name, ECRProviderName)
+		}
+	}
+	return nil
+}
+
+// Bounds of the timeout of a manual approval action in CodePipeline.
+const (
+	minPipelineApprovalTimeout = 5 * time.Minute
+	maxPipelineApprovalTimeout = 86400 * time.Minute
+)
+
+func validateStageApproval(stage PipelineStage) error {
+	if stage.Approval == nil || stage.Approval.Timeout == nil {
+		return nil
+	}
+	timeout := *stage.Approval.Timeout
+	if timeout < minPipelineApprovalTimeout || timeout > maxPipelineApprovalTimeout {
+		return fmt.Errorf("approval timeout %s of stage %s must be between %s and %s", timeout, stage.Name, minPipelineApprovalTimeout, maxPipelineApprovalTimeout)
+	}
+	if timeout%time.Minute != 0 {
+		return fmt.Errorf("approval timeout %s of stage %s must be a whole number of minutes", timeout, stage.Name)
+	}
+	return nil
+}
+
+func validateStageTest(stage PipelineStage) error {
+	if stage.Test == nil {
+		return nil
+	}
+	if stage.Test.Buildspec != "" && len(stage.TestCommands) > 0 {
+		return fmt.Errorf(`"test.buildspec" and "test_commands" cannot both be specified for stage %s`, stage.Name)
+	}
+	if stage.Test.Buildspec == "" && len(stage.TestCommands) == 0 {
+		return fmt.Errorf(`"test.buildspec" or "test_commands" must be specified with "test" for stage %s`, stage.Name)
+	}
+	if stage.Test.Network == nil {
+		return nil
+	}
+	for _, env := range stage.EnvironmentNames() {
+		if env == stage.Test.Network.Environment {
+			return nil
+		}
+	}
+	return fmt.Errorf("tests of stage %s cannot run in environment %s: it is not deployed by the stage", stage.Name, stage.Test.Network.Environment)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
@@ -39,6 +40,18 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create ECR provider": {
+			providerConfig: &ECRProperties{
+				Repository: "phonetool/api",
+				Tag:        "latest",
+			},
+		},
+		"successfully create S3 provider": {
+			providerConfig: &S3Properties{
+				Bucket:    "phonetool-sources",
+				ObjectKey: "workspace.zip",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -303,6 +316,319 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with parallel environments and pre and post deployments": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+    - name: prod
+      environments: [prod-us, prod-eu]
+      pre_deployments:
+        - name: migrate
+          commands: [make migrate]
+      post_deployments:
+        - name: smoke
+          commands: [make smoke]
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+					{
+						Name:         "prod",
+						Environments: []string{"prod-us", "prod-eu"},
+						PreDeployments: []PipelineAction{
+							{Name: "migrate", Commands: []string{"make migrate"}},
+						},
+						PostDeployments: []PipelineAction{
+							{Name: "smoke", Commands: []string{"make smoke"}},
+						},
+					},
+				},
+			},
+		},
+		"stage defined more than once": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+    - name: test
+`,
+			expectedErr: errors.New("stage test is defined more than once"),
+		},
+		"environment deployed by two stages": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: prod-us
+    - name: prod
+      environments: [prod-us, prod-eu]
+`,
+			expectedErr: errors.New("environment prod-us is deployed by both stages prod-us and prod"),
+		},
+		"action defined more than once in a stage": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: prod
+      pre_deployments:
+        - name: migrate
+          commands: [make migrate]
+      post_deployments:
+        - name: migrate
+          commands: [make migrate]
+`,
+			expectedErr: errors.New("action migrate is defined more than once in stage prod"),
+		},
+		"action without commands": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: prod
+      post_deployments:
+        - name: smoke
+`,
+			expectedErr: errors.New(`"commands" must be specified for action smoke in stage prod`),
+		},
+		"valid pipeline.yml with stage tests and approval notifications": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+      test:
+        image: aws/codebuild/standard:5.0
+        buildspec: copilot/tests/buildspec.yml
+        variables:
+          LOG_LEVEL: debug
+        secrets:
+          TOKEN: /copilot/phonetool/test/secrets/token
+        network:
+          environment: test
+    - name: prod
+      approval:
+        topic: arn:aws:sns:us-west-2:1111:releases
+        timeout: 2h
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Test: &PipelineTest{
+							Image:     "aws/codebuild/standard:5.0",
+							Buildspec: "copilot/tests/buildspec.yml",
+							Variables: map[string]string{"LOG_LEVEL": "debug"},
+							Secrets:   map[string]string{"TOKEN": "/copilot/phonetool/test/secrets/token"},
+							Network:   &PipelineTestNetwork{Environment: "test"},
+						},
+					},
+					{
+						Name: "prod",
+						Approval: &PipelineApproval{
+							Topic:   "arn:aws:sns:us-west-2:1111:releases",
+							Timeout: durationp(2 * time.Hour),
+						},
+					},
+				},
+			},
+		},
+		"approval timeout out of bounds": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: prod
+      approval:
+        timeout: 1m
+`,
+			expectedErr: errors.New("approval timeout 1m0s of stage prod must be between 5m0s and 1440h0m0s"),
+		},
+		"approval timeout not in minutes": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: prod
+      approval:
+        timeout: 10m30s
+`,
+			expectedErr: errors.New("approval timeout 10m30s of stage prod must be a whole number of minutes"),
+		},
+		"test commands with an ECR source": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: ECR
+  properties:
+    repository: phonetool/api
+    tag: release
+
+stages:
+    - name: test
+      test_commands:
+        - make integ-test
+`,
+			expectedErr: errors.New(`"test_commands" and "test" cannot be specified for stage test: the source of a pipeline with provider ECR does not contain your workspace`),
+		},
+		"post deployments with an ECR source": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: ECR
+  properties:
+    repository: phonetool/api
+
+stages:
+    - name: test
+      post_deployments:
+        - name: smoke
+          commands:
+            - make smoke-test
+`,
+			expectedErr: errors.New(`"pre_deployments" and "post_deployments" cannot be specified for stage test: the source of a pipeline with provider ECR does not contain your workspace`),
+		},
+		"test without buildspec or test commands": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+      test:
+        image: aws/codebuild/standard:5.0
+`,
+			expectedErr: errors.New(`"test.buildspec" or "test_commands" must be specified with "test" for stage test`),
+		},
+		"test with both buildspec and test commands": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+      test_commands: [make test]
+      test:
+        buildspec: copilot/tests/buildspec.yml
+`,
+			expectedErr: errors.New(`"test.buildspec" and "test_commands" cannot both be specified for stage test`),
+		},
+		"test network in an environment of another stage": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    - name: test
+      test_commands: [make test]
+      test:
+        network:
+          environment: prod
+    - name: prod
+`,
+			expectedErr: errors.New("tests of stage test cannot run in environment prod: it is not deployed by the stage"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -317,3 +643,34 @@ stages:
 		})
 	}
 }
+
+func TestPipelineStage_EnvironmentNames(t *testing.T) {
+	testCases := map[string]struct {
+		in     PipelineStage
+		wanted []string
+	}{
+		"defaults to the name of the stage": {
+			in:     PipelineStage{Name: "test"},
+			wanted: []string{"test"},
+		},
+		"returns the environments of the stage": {
+			in: PipelineStage{
+				Name:         "prod",
+				Environments: []string{"prod-us", "prod-eu"},
+			},
+			wanted: []string{"prod-us", "prod-eu"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.EnvironmentNames())
+		})
+	}
+}
+
+func TestPipelineStage_ApprovalRequired(t *testing.T) {
+	require.False(t, PipelineStage{Name: "test"}.ApprovalRequired())
+	require.True(t, PipelineStage{Name: "prod", RequiresApproval: true}.ApprovalRequired())
+	require.True(t, PipelineStage{Name: "prod", Approval: &PipelineApproval{Topic: "arn:aws:sns:us-west-2:1111:releases"}}.ApprovalRequired())
+}
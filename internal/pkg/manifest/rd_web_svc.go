@@ -95,7 +95,7 @@ func (s *RequestDrivenWebService) TaskPlatform() (*string, error) {
 	if s.InstanceConfig.Platform == nil {
 		return nil, nil
 	}
-	return s.InstanceConfig.Platform.PlatformString, nil
+	return s.InstanceConfig.Platform.taskPlatform(), nil
 }
 
 // BuildArgs returns a docker.BuildArguments object given a ws root directory.
@@ -103,6 +103,16 @@ func (s *RequestDrivenWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
 }
 
+// ImageScan returns the configuration of the vulnerability scan of the service's image.
+func (s *RequestDrivenWebService) ImageScan() *ImageScan {
+	return s.ImageConfig.Scan
+}
+
+// ImageSigning returns the configuration of the signature of the service's image.
+func (s *RequestDrivenWebService) ImageSigning() *ImageSigning {
+	return s.ImageConfig.Sign
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s RequestDrivenWebService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+	// JSON Schema primitive types.
+	schemaTypeObject  = "object"
+	schemaTypeArray   = "array"
+	schemaTypeString  = "string"
+	schemaTypeInteger = "integer"
+	schemaTypeNumber  = "number"
+	schemaTypeBoolean = "boolean"
+)
+
+// durationPattern matches the strings accepted by time.ParseDuration such as "1h30m" or "500ms".
+const durationPattern = `^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+)$`
+
+// JSONSchema is the subset of the JSON Schema (draft-07) vocabulary needed to describe a manifest.
+type JSONSchema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"` // Either false or a *JSONSchema.
+	Required             []string               `json:"required,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+}
+
+// customSchemas holds the types whose schema can't be derived from their Go representation.
+var customSchemas = map[reflect.Type]func() *JSONSchema{
+	reflect.TypeOf(time.Duration(0)): func() *JSONSchema {
+		return &JSONSchema{
+			Type:        schemaTypeString,
+			Pattern:     durationPattern,
+			Description: `A duration such as "30s", "5m" or "1h30m".`,
+		}
+	},
+	reflect.TypeOf(IntRangeBand("")): func() *JSONSchema {
+		return &JSONSchema{
+			Type:        schemaTypeString,
+			Pattern:     `^[0-9]+-[0-9]+$`,
+			Description: `A range of tasks in the format "${min}-${max}".`,
+		}
+	},
+	reflect.TypeOf(ImageScanSeverity("")): func() *JSONSchema {
+		return &JSONSchema{
+			Type:        schemaTypeString,
+			Enum:        ImageScanSeverities,
+			Description: "The severity of a vulnerability found by an image scan.",
+		}
+	},
+	reflect.TypeOf(yaml.Node{}): func() *JSONSchema {
+		return &JSONSchema{} // Any value.
+	},
+}
+
+// manifestTypes maps a workload type to an empty manifest of that type.
+var manifestTypes = map[string]interface{}{
+	LoadBalancedWebServiceType:  LoadBalancedWebService{},
+	RequestDrivenWebServiceType: RequestDrivenWebService{},
+	BackendServiceType:          BackendService{},
+	WorkerServiceType:           WorkerService{},
+	ScheduledJobType:            ScheduledJob{},
+}
+
+// WorkloadJSONSchema returns the JSON Schema of the manifest for the given workload type.
+func WorkloadJSONSchema(workloadType string) (*JSONSchema, error) {
+	mft, ok := manifestTypes[workloadType]
+	if !ok {
+		return nil, &ErrInvalidWorkloadType{Type: workloadType}
+	}
+	s := schemaOf(reflect.TypeOf(mft))
+	s.Schema = jsonSchemaDraft
+	s.Title = fmt.Sprintf("Copilot %s manifest", workloadType)
+	s.Required = []string{"name", "type"}
+	s.Properties["type"].Enum = []string{workloadType}
+	return s, nil
+}
+
+// schemaOf derives the schema of a Go type from the way it's unmarshaled by the yaml package.
+func schemaOf(t reflect.Type) *JSONSchema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if custom, ok := customSchemas[t]; ok {
+		return custom()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &JSONSchema{Type: schemaTypeString}
+	case reflect.Bool:
+		return &JSONSchema{Type: schemaTypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: schemaTypeInteger}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: schemaTypeNumber}
+	case reflect.Slice, reflect.Array:
+		return &JSONSchema{
+			Type:  schemaTypeArray,
+			Items: schemaOf(t.Elem()),
+		}
+	case reflect.Map:
+		return &JSONSchema{
+			Type:                 schemaTypeObject,
+			AdditionalProperties: schemaOf(t.Elem()),
+		}
+	case reflect.Struct:
+		if isUnion(t) {
+			return unionSchemaOf(t)
+		}
+		s := &JSONSchema{
+			Type:                 schemaTypeObject,
+			Properties:           make(map[string]*JSONSchema),
+			AdditionalProperties: false,
+		}
+		addProperties(s, t)
+		return s
+	default:
+		// Interfaces accept any value.
+		return &JSONSchema{}
+	}
+}
+
+// addProperties adds the yaml fields of the struct t to the properties of the object schema s.
+func addProperties(s *JSONSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue // Unexported fields are ignored by the yaml package.
+		}
+		name, inline := yamlFieldName(field)
+		if name == "-" {
+			continue
+		}
+		if inline {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			addProperties(s, ft)
+			continue
+		}
+		s.Properties[name] = schemaOf(field.Type)
+	}
+}
+
+// yamlFieldName returns the key of the struct field in a YAML document, and whether the field is inlined.
+func yamlFieldName(field reflect.StructField) (name string, inline bool) {
+	tag := field.Tag.Get("yaml")
+	parts := strings.Split(tag, ",")
+	for _, flag := range parts[1:] {
+		if flag == "inline" {
+			return "", true
+		}
+	}
+	if parts[0] != "" {
+		return parts[0], false
+	}
+	return strings.ToLower(field.Name), false
+}
+
+// isUnion returns true if the struct can be unmarshaled from several YAML types.
+// Such structs implement yaml.Unmarshaler and hold one untagged field per alternative, for example
+// Count holds either an integer Value or an AdvancedCount.
+func isUnion(t reflect.Type) bool {
+	if _, ok := reflect.PtrTo(t).MethodByName("UnmarshalYAML"); !ok {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("yaml"); ok {
+			return false
+		}
+	}
+	return true
+}
+
+func unionSchemaOf(t reflect.Type) *JSONSchema {
+	s := &JSONSchema{}
+	for i := 0; i < t.NumField(); i++ {
+		s.OneOf = append(s.OneOf, schemaOf(t.Field(i).Type))
+	}
+	return s
+}
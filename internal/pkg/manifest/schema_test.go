@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadJSONSchema(t *testing.T) {
+	t.Run("returns an error for an unknown workload type", func(t *testing.T) {
+		_, err := WorkloadJSONSchema("Static Site")
+
+		require.EqualError(t, err, (&ErrInvalidWorkloadType{Type: "Static Site"}).Error())
+	})
+
+	t.Run("every workload type has a schema that can be marshaled to JSON", func(t *testing.T) {
+		for _, typ := range WorkloadTypes {
+			s, err := WorkloadJSONSchema(typ)
+			require.NoError(t, err)
+
+			_, err = json.Marshal(s)
+			require.NoError(t, err, "marshal schema of %s", typ)
+			require.Equal(t, jsonSchemaDraft, s.Schema)
+			require.Equal(t, []string{"name", "type"}, s.Required)
+			require.Equal(t, []string{typ}, s.Properties["type"].Enum)
+			require.Equal(t, false, s.AdditionalProperties)
+		}
+	})
+
+	t.Run("union types are described with oneOf", func(t *testing.T) {
+		s, err := WorkloadJSONSchema(LoadBalancedWebServiceType)
+		require.NoError(t, err)
+
+		count := s.Properties["count"]
+		require.Len(t, count.OneOf, 2)
+		require.Equal(t, schemaTypeInteger, count.OneOf[0].Type)
+		require.Equal(t, schemaTypeObject, count.OneOf[1].Type)
+
+		rng := count.OneOf[1].Properties["range"]
+		require.Len(t, rng.OneOf, 2)
+		require.Equal(t, schemaTypeString, rng.OneOf[0].Type)
+		require.Equal(t, `^[0-9]+-[0-9]+$`, rng.OneOf[0].Pattern)
+		require.Equal(t, schemaTypeObject, rng.OneOf[1].Type)
+
+		build := s.Properties["image"].Properties["build"]
+		require.Len(t, build.OneOf, 2)
+		require.Equal(t, schemaTypeString, build.OneOf[0].Type)
+		require.Contains(t, build.OneOf[1].Properties, "dockerfile")
+
+		healthcheck := s.Properties["http"].Properties["healthcheck"]
+		require.Len(t, healthcheck.OneOf, 2)
+		require.Equal(t, schemaTypeString, healthcheck.OneOf[0].Type)
+		require.Equal(t, durationPattern, healthcheck.OneOf[1].Properties["interval"].Pattern)
+
+		efs := s.Properties["storage"].Properties["volumes"].AdditionalProperties.(*JSONSchema).Properties["efs"]
+		require.Len(t, efs.OneOf, 2)
+		require.Equal(t, schemaTypeObject, efs.OneOf[0].Type)
+		require.Equal(t, schemaTypeBoolean, efs.OneOf[1].Type)
+	})
+
+	t.Run("environment overrides share the schema of the manifest fields", func(t *testing.T) {
+		s, err := WorkloadJSONSchema(ScheduledJobType)
+		require.NoError(t, err)
+
+		env := s.Properties["environments"].AdditionalProperties.(*JSONSchema)
+		require.Contains(t, env.Properties, "on")
+		require.Contains(t, env.Properties, "retries")
+		require.NotContains(t, env.Properties, "name")
+	})
+}
@@ -179,12 +179,48 @@ type AdvancedCount struct {
 	Memory       *int           `yaml:"memory_percentage"`
 	Requests     *int           `yaml:"requests"`
 	ResponseTime *time.Duration `yaml:"response_time"`
+	QueueScaling *QueueScaling  `yaml:"queue_delay"` // Only valid for Worker Services.
+}
+
+// QueueScaling represents the configuration to scale a service based on the backlog of its SQS queues.
+type QueueScaling struct {
+	AcceptableLatency *time.Duration `yaml:"acceptable_latency"`
+	AvgProcessingTime *time.Duration `yaml:"msg_processing_time"`
+}
+
+// IsEmpty returns whether QueueScaling is empty.
+func (qs *QueueScaling) IsEmpty() bool {
+	return qs.AcceptableLatency == nil && qs.AvgProcessingTime == nil
+}
+
+// AcceptableBacklogPerTask returns the number of messages that a single task can have in its backlog
+// while still processing them within the acceptable latency.
+func (qs *QueueScaling) AcceptableBacklogPerTask() (int, error) {
+	if err := qs.IsValid(); err != nil {
+		return 0, err
+	}
+	backlog := int(*qs.AcceptableLatency / *qs.AvgProcessingTime)
+	if backlog < 1 {
+		return 1, nil
+	}
+	return backlog, nil
+}
+
+// IsValid checks that both the acceptable latency and the message processing time are specified.
+func (qs *QueueScaling) IsValid() error {
+	if qs.AcceptableLatency == nil || qs.AvgProcessingTime == nil {
+		return errInvalidQueueScaling
+	}
+	if *qs.AvgProcessingTime <= 0 {
+		return errInvalidQueueScalingProcessingTime
+	}
+	return nil
 }
 
 // IsEmpty returns whether AdvancedCount is empty.
 func (a *AdvancedCount) IsEmpty() bool {
 	return a.Range == nil && a.CPU == nil && a.Memory == nil &&
-		a.Requests == nil && a.ResponseTime == nil && a.Spot == nil
+		a.Requests == nil && a.ResponseTime == nil && a.Spot == nil && a.QueueScaling == nil
 }
 
 // IgnoreRange returns whether desiredCount is specified on spot capacity
@@ -192,9 +228,14 @@ func (a *AdvancedCount) IgnoreRange() bool {
 	return a.Spot != nil
 }
 
+// hasSpot returns whether some of the tasks are placed on Fargate Spot capacity.
+func (a *AdvancedCount) hasSpot() bool {
+	return a.Spot != nil || (a.Range != nil && a.Range.Value == nil)
+}
+
 func (a *AdvancedCount) hasAutoscaling() bool {
 	return a.Range != nil || a.CPU != nil || a.Memory != nil ||
-		a.Requests != nil || a.ResponseTime != nil
+		a.Requests != nil || a.ResponseTime != nil || a.QueueScaling != nil
 }
 
 // IsValid checks to make sure Spot fields are compatible with other values in AdvancedCount
@@ -205,10 +246,13 @@ func (a *AdvancedCount) IsValid() error {
 	}
 
 	// Range must be specified if using autoscaling
-	if a.Range == nil && (a.CPU != nil || a.Memory != nil || a.Requests != nil || a.ResponseTime != nil) {
+	if a.Range == nil && (a.CPU != nil || a.Memory != nil || a.Requests != nil || a.ResponseTime != nil || a.QueueScaling != nil) {
 		return errInvalidAutoscaling
 	}
 
+	if a.QueueScaling != nil {
+		return a.QueueScaling.IsValid()
+	}
 	return nil
 }
 
@@ -218,6 +262,7 @@ func (a *AdvancedCount) unsetAutoscaling() {
 	a.Memory = nil
 	a.Requests = nil
 	a.ResponseTime = nil
+	a.QueueScaling = nil
 }
 
 // ServiceDockerfileBuildRequired returns if the service container image should be built from local Dockerfile.
@@ -405,6 +405,31 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"With queue delay autoscaling": {
+			inContent: []byte(`count:
+  range: 1-10
+  queue_delay:
+    acceptable_latency: 10m
+    msg_processing_time: 250ms
+`),
+			wantedStruct: Count{
+				AdvancedCount: AdvancedCount{
+					Range: &Range{Value: &mockRange},
+					QueueScaling: &QueueScaling{
+						AcceptableLatency: durationp(10 * time.Minute),
+						AvgProcessingTime: durationp(250 * time.Millisecond),
+					},
+				},
+			},
+		},
+		"Error if queue delay is missing the processing time": {
+			inContent: []byte(`count:
+  range: 1-10
+  queue_delay:
+    acceptable_latency: 10m
+`),
+			wantedError: errInvalidQueueScaling,
+		},
 		"With spot specified as count": {
 			inContent: []byte(`count:
   spot: 42
@@ -843,6 +868,42 @@ func TestAdvancedCount_IsValid(t *testing.T) {
 
 			expectedErr: errInvalidAdvancedCount,
 		},
+		"with range and queue delay": {
+			input: &AdvancedCount{
+				Range: &Range{
+					Value: &mockRange,
+				},
+				QueueScaling: &QueueScaling{
+					AcceptableLatency: durationp(10 * time.Minute),
+					AvgProcessingTime: durationp(250 * time.Millisecond),
+				},
+			},
+
+			expectedErr: nil,
+		},
+		"invalid with queue delay and no range": {
+			input: &AdvancedCount{
+				QueueScaling: &QueueScaling{
+					AcceptableLatency: durationp(10 * time.Minute),
+					AvgProcessingTime: durationp(250 * time.Millisecond),
+				},
+			},
+
+			expectedErr: errInvalidAutoscaling,
+		},
+		"invalid with zero message processing time": {
+			input: &AdvancedCount{
+				Range: &Range{
+					Value: &mockRange,
+				},
+				QueueScaling: &QueueScaling{
+					AcceptableLatency: durationp(10 * time.Minute),
+					AvgProcessingTime: durationp(0),
+				},
+			},
+
+			expectedErr: errInvalidQueueScalingProcessingTime,
+		},
 		"invalid with autoscaling fields and no range": {
 			input: &AdvancedCount{
 				CPU:      aws.Int(512),
@@ -898,3 +959,45 @@ func TestHealthCheckArgsOrString_IsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueScaling_AcceptableBacklogPerTask(t *testing.T) {
+	testCases := map[string]struct {
+		in *QueueScaling
+
+		wantedBacklog int
+		wantedErr     error
+	}{
+		"error if the processing time is missing": {
+			in: &QueueScaling{
+				AcceptableLatency: durationp(10 * time.Minute),
+			},
+			wantedErr: errInvalidQueueScaling,
+		},
+		"divides the latency by the processing time": {
+			in: &QueueScaling{
+				AcceptableLatency: durationp(10 * time.Minute),
+				AvgProcessingTime: durationp(250 * time.Millisecond),
+			},
+			wantedBacklog: 2400,
+		},
+		"each task accepts at least one message": {
+			in: &QueueScaling{
+				AcceptableLatency: durationp(time.Second),
+				AvgProcessingTime: durationp(2 * time.Second),
+			},
+			wantedBacklog: 1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			backlog, err := tc.in.AcceptableBacklogPerTask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedBacklog, backlog)
+			}
+		})
+	}
+}
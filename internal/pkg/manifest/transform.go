@@ -133,11 +133,18 @@ func (t platformArgsOrStringTransformer) Transformer(typ reflect.Type) func(dst,
 		dstStruct, srcStruct := dst.Interface().(PlatformArgsOrString), src.Interface().(PlatformArgsOrString)
 
 		if srcStruct.PlatformString != nil {
+			dstStruct.PlatformList = nil
+			dstStruct.PlatformArgs = PlatformArgs{}
+		}
+
+		if srcStruct.PlatformList != nil {
+			dstStruct.PlatformString = nil
 			dstStruct.PlatformArgs = PlatformArgs{}
 		}
 
 		if !srcStruct.PlatformArgs.isEmpty() {
 			dstStruct.PlatformString = nil
+			dstStruct.PlatformList = nil
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
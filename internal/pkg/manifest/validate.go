@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+// YAML tags of scalar nodes.
+const (
+	yamlNullTag  = "!!null"
+	yamlBoolTag  = "!!bool"
+	yamlIntTag   = "!!int"
+	yamlFloatTag = "!!float"
+	yamlMergeKey = "<<"
+)
+
+var yaml11Booleans = []string{"y", "Y", "yes", "Yes", "YES", "n", "N", "no", "No", "NO", "on", "On", "ON", "off", "Off", "OFF"}
+
+// ValidationError is a problem found while linting a manifest.
+type ValidationError struct {
+	Line   int    // Line is 0 if the problem can't be tied to a location in the manifest.
+	Column int    // Column is 0 if the problem can't be tied to a location in the manifest.
+	Path   string // Path is the dot-separated path to the invalid field, for example "http.healthcheck.interval".
+	Msg    string
+}
+
+func newValidationError(node *yaml.Node, path, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{
+		Line:   node.Line,
+		Column: node.Column,
+		Path:   path,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *ValidationError) Error() string {
+	var loc string
+	if e.Line != 0 {
+		loc = fmt.Sprintf("line %d, column %d: ", e.Line, e.Column)
+	}
+	if e.Path == "" {
+		return loc + e.Msg
+	}
+	return fmt.Sprintf("%s%q %s", loc, e.Path, e.Msg)
+}
+
+// ValidateWorkload lints the workload manifest without making any network call.
+// The manifest is first checked against the JSON Schema of its type, then the overrides of each environment are
+// applied and the resulting manifests are checked for conflicting fields.
+// An error is returned only if the input is not a YAML document.
+func ValidateWorkload(in []byte) ([]*ValidationError, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return []*ValidationError{
+			newValidationError(&doc, "", "manifest must be a map of fields"),
+		}, nil
+	}
+	root := doc.Content[0]
+	_, typeNode := mappingValue(root, "type")
+	if typeNode == nil {
+		return []*ValidationError{
+			newValidationError(root, "type", "must be specified"),
+		}, nil
+	}
+	schema, err := WorkloadJSONSchema(typeNode.Value)
+	if err != nil {
+		return []*ValidationError{
+			newValidationError(typeNode, "type", "must be one of %s", english.WordSeries(quoteAll(WorkloadTypes), "or")),
+		}, nil
+	}
+	if errs := schema.validate(root, ""); len(errs) != 0 {
+		return errs, nil
+	}
+
+	mft, err := UnmarshalWorkload(in)
+	if err != nil {
+		return []*ValidationError{
+			{Msg: err.Error()},
+		}, nil
+	}
+	var errs []*ValidationError
+	if err := validateWorkload(mft); err != nil {
+		errs = append(errs, &ValidationError{Msg: err.Error()})
+	}
+	_, envs := mappingValue(root, "environments")
+	if envs == nil || envs.Kind != yaml.MappingNode {
+		return errs, nil
+	}
+	for i := 0; i < len(envs.Content); i += 2 {
+		envNode := envs.Content[i]
+		path := fmt.Sprintf("environments.%s", envNode.Value)
+		// Unmarshal the manifest again since merging the overrides can modify the fields shared with the original manifest.
+		base, err := UnmarshalWorkload(in)
+		if err != nil {
+			return nil, err
+		}
+		envMft, err := base.ApplyEnv(envNode.Value)
+		if err != nil {
+			errs = append(errs, newValidationError(envNode, path, "cannot be applied: %v", err))
+			continue
+		}
+		if err := validateWorkload(envMft); err != nil {
+			errs = append(errs, newValidationError(envNode, path, "results in an invalid manifest: %v", err))
+		}
+	}
+	return errs, nil
+}
+
+// validateWorkload returns an error if the fields of the manifest conflict with each other.
+// These conflicts can't be expressed in the JSON Schema, and can be introduced when environment overrides are merged.
+func validateWorkload(mft WorkloadManifest) error {
+	type buildRequirer interface {
+		BuildRequired() (bool, error)
+	}
+	if m, ok := mft.(buildRequirer); ok {
+		if _, err := m.BuildRequired(); err != nil {
+			return err
+		}
+	}
+	if m, ok := mft.(*RequestDrivenWebService); ok {
+		if p := m.InstanceConfig.Platform; p != nil && (len(p.PlatformList) > 1 || p.Arch() != dockerengine.Amd64Arch) {
+			return fmt.Errorf(`"platform" of a %s can only be %s`, RequestDrivenWebServiceType, dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch))
+		}
+	}
+	var count *Count
+	var platform *PlatformArgsOrString
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		count, platform = &m.Count, m.Platform
+	case *BackendService:
+		count, platform = &m.Count, m.Platform
+	case *WorkerService:
+		count, platform = &m.Count, m.Platform
+	}
+	if count == nil {
+		return nil
+	}
+	if err := count.AdvancedCount.IsValid(); err != nil {
+		return fmt.Errorf(`validate "count": %w`, err)
+	}
+	// Fargate Spot capacity providers only run tasks on x86_64.
+	if platform != nil && platform.Arch() == dockerengine.Arm64Arch && count.AdvancedCount.hasSpot() {
+		return fmt.Errorf(`"platform" %s cannot be specified with Fargate Spot capacity in "count"`, dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Arm64Arch))
+	}
+	if _, err := count.Desired(); err != nil {
+		return fmt.Errorf(`validate "count": %w`, err)
+	}
+	return nil
+}
+
+// validate returns the problems found by checking the YAML node against the schema.
+func (s *JSONSchema) validate(node *yaml.Node, path string) []*ValidationError {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return s.validate(node.Content[0], path)
+	case yaml.AliasNode:
+		return s.validate(node.Alias, path)
+	}
+	if node.Tag == yamlNullTag {
+		return nil // Every field is optional unless it's required by its parent.
+	}
+	if len(s.OneOf) != 0 {
+		var wanted []string
+		for _, alt := range s.OneOf {
+			if alt.acceptsKind(node) {
+				return alt.validate(node, path)
+			}
+			wanted = append(wanted, alt.kindDescription())
+		}
+		return []*ValidationError{
+			newValidationError(node, path, "must be %s", english.WordSeries(wanted, "or")),
+		}
+	}
+	if !s.acceptsKind(node) {
+		return []*ValidationError{
+			newValidationError(node, path, "must be %s", s.kindDescription()),
+		}
+	}
+
+	var errs []*ValidationError
+	switch s.Type {
+	case schemaTypeObject:
+		errs = append(errs, s.validateMapping(node, path)...)
+	case schemaTypeArray:
+		for i, item := range node.Content {
+			errs = append(errs, s.Items.validate(item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case schemaTypeString:
+		if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(node.Value) {
+			errs = append(errs, newValidationError(node, path, "has an invalid value %q", node.Value))
+		}
+		if len(s.Enum) != 0 && !contains(node.Value, s.Enum) {
+			errs = append(errs, newValidationError(node, path, "must be one of %s", english.WordSeries(quoteAll(s.Enum), "or")))
+		}
+	}
+	return errs
+}
+
+func (s *JSONSchema) validateMapping(node *yaml.Node, path string) []*ValidationError {
+	var errs []*ValidationError
+	for i := 0; i < len(node.Content); i += 2 {
+		key, val := node.Content[i], node.Content[i+1]
+		if key.Value == yamlMergeKey {
+			continue
+		}
+		fieldPath := key.Value
+		if path != "" {
+			fieldPath = fmt.Sprintf("%s.%s", path, key.Value)
+		}
+		prop, ok := s.Properties[key.Value]
+		if !ok {
+			additional, ok := s.AdditionalProperties.(*JSONSchema)
+			if !ok {
+				errs = append(errs, newValidationError(key, fieldPath, "is not a valid field"))
+				continue
+			}
+			prop = additional
+		}
+		errs = append(errs, prop.validate(val, fieldPath)...)
+	}
+	for _, required := range s.Required {
+		if _, val := mappingValue(node, required); val == nil || val.Tag == yamlNullTag {
+			fieldPath := required
+			if path != "" {
+				fieldPath = fmt.Sprintf("%s.%s", path, required)
+			}
+			errs = append(errs, newValidationError(node, fieldPath, "must be specified"))
+		}
+	}
+	return errs
+}
+
+// acceptsKind returns true if the node can be unmarshaled into a value of the schema's type.
+func (s *JSONSchema) acceptsKind(node *yaml.Node) bool {
+	switch s.Type {
+	case schemaTypeObject:
+		return node.Kind == yaml.MappingNode
+	case schemaTypeArray:
+		return node.Kind == yaml.SequenceNode
+	case schemaTypeString:
+		return node.Kind == yaml.ScalarNode // Any scalar can be unmarshaled into a string.
+	case schemaTypeInteger:
+		return node.Kind == yaml.ScalarNode && node.Tag == yamlIntTag
+	case schemaTypeNumber:
+		return node.Kind == yaml.ScalarNode && (node.Tag == yamlIntTag || node.Tag == yamlFloatTag)
+	case schemaTypeBoolean:
+		// YAML 1.1 booleans such as "yes" are still unmarshaled into booleans for backwards compatibility.
+		return node.Kind == yaml.ScalarNode && (node.Tag == yamlBoolTag || contains(node.Value, yaml11Booleans))
+	default:
+		return true
+	}
+}
+
+func (s *JSONSchema) kindDescription() string {
+	switch s.Type {
+	case schemaTypeObject:
+		return "a map"
+	case schemaTypeArray:
+		return "a list"
+	case schemaTypeInteger:
+		return "an integer"
+	case schemaTypeBoolean:
+		return "a boolean"
+	default:
+		return fmt.Sprintf("a %s", s.Type)
+	}
+}
+
+// mappingValue returns the key and value nodes of the field in a mapping node, or nil if the field doesn't exist.
+func mappingValue(node *yaml.Node, field string) (key *yaml.Node, val *yaml.Node) {
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		if node.Content[i].Value == field {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+func quoteAll(elems []string) []string {
+	quoted := make([]string, len(elems))
+	for i, el := range elems {
+		quoted[i] = fmt.Sprintf("%q", el)
+	}
+	return quoted
+}
+
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
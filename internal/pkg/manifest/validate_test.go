@@ -0,0 +1,216 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedErrs []string
+		wantedErr  string
+	}{
+		"invalid YAML": {
+			in:        "name: [",
+			wantedErr: "unmarshal manifest: yaml: line 1: did not find expected node content",
+		},
+		"manifest is not a map": {
+			in:         "- name: api",
+			wantedErrs: []string{"line 1, column 1: manifest must be a map of fields"},
+		},
+		"missing type": {
+			in:         "name: api",
+			wantedErrs: []string{`line 1, column 1: "type" must be specified`},
+		},
+		"unknown type": {
+			in: `name: api
+type: Static Site`,
+			wantedErrs: []string{`line 2, column 7: "type" must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service" or "Scheduled Job"`},
+		},
+		"schema violations": {
+			in: `name: api
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: eighty
+http:
+  path: /
+  healthcheck:
+    interval: ten seconds
+cpu: 256
+memroy: 512
+count:
+  - 1
+variables:
+  LOG_LEVEL: info
+  DEBUG: true
+storage:
+  volumes:
+    data:
+      efs: enabled
+      path: /data`,
+			wantedErrs: []string{
+				`line 5, column 9: "image.port" must be an integer`,
+				`line 9, column 15: "http.healthcheck.interval" has an invalid value "ten seconds"`,
+				`line 11, column 1: "memroy" is not a valid field`,
+				`line 13, column 3: "count" must be an integer or a map`,
+				`line 20, column 12: "storage.volumes.data.efs" must be a map or a boolean`,
+			},
+		},
+		"conflicting fields in the manifest": {
+			in: `name: api
+type: Backend Service
+image:
+  location: nginx
+count:
+  cpu_percentage: 70`,
+			wantedErrs: []string{`unmarshal to backend service: must specify "range" if using autoscaling`},
+		},
+		"environment overrides that can't be applied": {
+			in: `name: api
+type: Backend Service
+image:
+  location: nginx
+environments:
+  prod:
+    image:
+      location: nginx
+      build: ./Dockerfile`,
+			wantedErrs: []string{
+				`line 6, column 3: "environments.prod" cannot be applied: invalid manifest: image.build is mutually exclusive with image.location and shouldn't be specified at the same time`,
+			},
+		},
+		"environment overrides that result in an invalid manifest": {
+			in: `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+environments:
+  test:
+    count: 2
+  prod:
+    image:
+      build: ""`,
+			wantedErrs: []string{
+				`line 8, column 3: "environments.prod" results in an invalid manifest: either "image.build" or "image.location" needs to be specified in the manifest`,
+			},
+		},
+		"invalid image scan severity": {
+			in: `name: api
+type: Worker Service
+image:
+  build: ./Dockerfile
+  scan:
+    fail_on: severe`,
+			wantedErrs: []string{
+				`line 6, column 14: "image.scan.fail_on" must be one of "critical", "high", "medium", "low" or "informational"`,
+			},
+		},
+		"image scan of an image that is not built": {
+			in: `name: api
+type: Backend Service
+image:
+  location: nginx
+  scan:
+    fail_on: high`,
+			wantedErrs: []string{`"image.scan" can only be specified with "image.build"`},
+		},
+		"multi-platform Request-Driven Web Service": {
+			in: `name: api
+type: Request-Driven Web Service
+image:
+  build: ./Dockerfile
+  port: 80
+platform: [linux/amd64, linux/arm64]`,
+			wantedErrs: []string{`"platform" of a Request-Driven Web Service can only be linux/amd64`},
+		},
+		"arm64 platform with Fargate Spot": {
+			in: `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+platform: linux/arm64
+count:
+  spot: 2`,
+			wantedErrs: []string{`"platform" linux/arm64 cannot be specified with Fargate Spot capacity in "count"`},
+		},
+		"arm64 platform with Fargate Spot in an environment override": {
+			in: `name: api
+type: Worker Service
+image:
+  build: ./Dockerfile
+platform: [linux/arm64, linux/amd64]
+count: 1
+environments:
+  prod:
+    count:
+      range:
+        min: 1
+        max: 10
+        spot_from: 3
+      cpu_percentage: 70`,
+			wantedErrs: []string{
+				`line 8, column 3: "environments.prod" results in an invalid manifest: "platform" linux/arm64 cannot be specified with Fargate Spot capacity in "count"`,
+			},
+		},
+		"valid arm64 manifest with autoscaling": {
+			in: `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+platform: linux/arm64
+count:
+  range: 1-10
+  cpu_percentage: 70`,
+		},
+		"valid manifest with environment overrides": {
+			in: `name: api
+type: Load Balanced Web Service
+image:
+  build:
+    dockerfile: ./Dockerfile
+    args:
+      GO_VERSION: 1.17
+  port: 80
+http:
+  path: /
+  healthcheck: /healthz
+cpu: 256
+memory: 512
+count:
+  range: 1-10
+  cpu_percentage: 70
+exec: yes
+environments:
+  test:
+    count: 1
+    http:
+      healthcheck:
+        path: /
+        interval: 10s`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs, err := ValidateWorkload([]byte(tc.in))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			var actual []string
+			for _, e := range errs {
+				actual = append(actual, e.Error())
+			}
+			require.ElementsMatch(t, tc.wantedErrs, actual)
+		})
+	}
+}
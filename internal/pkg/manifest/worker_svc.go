@@ -35,6 +35,7 @@ type WorkerServiceConfig struct {
 	Subscribe        *SubscribeConfig          `yaml:"subscribe"`
 	Network          *NetworkConfig            `yaml:"network"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Deployment       DeploymentConfig          `yaml:"deployment"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
@@ -107,6 +108,16 @@ func (s *WorkerService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.BuildConfig(wsRoot)
 }
 
+// ImageScan returns the configuration of the vulnerability scan of the service's image.
+func (s *WorkerService) ImageScan() *ImageScan {
+	return s.ImageConfig.Scan
+}
+
+// ImageSigning returns the configuration of the signature of the service's image.
+func (s *WorkerService) ImageSigning() *ImageSigning {
+	return s.ImageConfig.Sign
+}
+
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
 // receives messages from.
 func (s *WorkerService) Subscriptions() []TopicSubscription {
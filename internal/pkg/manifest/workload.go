@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -18,6 +19,7 @@ import (
 	"github.com/google/shlex"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"gopkg.in/yaml.v3"
 )
@@ -31,6 +33,11 @@ const (
 	PrivateSubnetPlacement = "private"
 )
 
+const (
+	signerServiceName            = "signer"
+	signingProfileResourcePrefix = "/signing-profiles/"
+)
+
 var (
 	// WorkloadTypes holds all workload manifest types.
 	WorkloadTypes = append(ServiceTypes, JobTypes...)
@@ -38,13 +45,16 @@ var (
 	// All placement options.
 	subnetPlacements = []string{PublicSubnetPlacement, PrivateSubnetPlacement}
 
-	validPlatforms        = []string{dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch)}
+	validPlatforms = []string{
+		dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch),
+		dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Arm64Arch),
+	}
 	validOperatingSystems = []string{dockerengine.LinuxOS}
-	validArchitectures    = []string{dockerengine.Amd64Arch}
+	validArchitectures    = []string{dockerengine.Amd64Arch, dockerengine.Arm64Arch}
 
 	// Error definitions.
 	errUnmarshalBuildOpts    = errors.New("unable to unmarshal build field into string or compose-style map")
-	errUnmarshalPlatformOpts = errors.New("unable to unmarshal platform field into string or compose-style map")
+	errUnmarshalPlatformOpts = errors.New("unable to unmarshal platform field into string, list of strings or compose-style map")
 	errUnmarshalCountOpts    = errors.New(`unable to unmarshal "count" field to an integer or autoscaling configuration`)
 	errUnmarshalRangeOpts    = errors.New(`unable to unmarshal "range" field`)
 	errUnmarshalExec         = errors.New("unable to unmarshal exec field into boolean or exec configuration")
@@ -54,6 +64,9 @@ var (
 	errInvalidRangeOpts     = errors.New(`must specify one, not both, of "range" and "min"/"max"`)
 	errInvalidAdvancedCount = errors.New(`must specify one, not both, of "spot" and autoscaling fields`)
 	errInvalidAutoscaling   = errors.New(`must specify "range" if using autoscaling`)
+
+	errInvalidQueueScaling               = errors.New(`must specify both "acceptable_latency" and "msg_processing_time" for "queue_delay"`)
+	errInvalidQueueScalingProcessingTime = errors.New(`"msg_processing_time" must be greater than 0`)
 )
 
 // WorkloadManifest represents a workload manifest.
@@ -87,6 +100,86 @@ type Image struct {
 	Credentials  *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn    map[string]string `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	Scan         *ImageScan        `yaml:"scan"`            // Scan the image for vulnerabilities after it's pushed.
+	Sign         *ImageSigning     `yaml:"sign"`            // Sign the image after it's pushed.
+}
+
+// Severities of the vulnerabilities found by an image scan, from the most to the least severe.
+const (
+	ImageScanSeverityCritical      = "critical"
+	ImageScanSeverityHigh          = "high"
+	ImageScanSeverityMedium        = "medium"
+	ImageScanSeverityLow           = "low"
+	ImageScanSeverityInformational = "informational"
+)
+
+// ImageScanSeverities are the severities accepted by "image.scan.fail_on".
+var ImageScanSeverities = []string{ImageScanSeverityCritical, ImageScanSeverityHigh, ImageScanSeverityMedium,
+	ImageScanSeverityLow, ImageScanSeverityInformational}
+
+// ImageScanSeverity is the severity of a vulnerability found by an image scan.
+type ImageScanSeverity string
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the ImageScanSeverity
+// type, so that a severity that isn't one of ImageScanSeverities is rejected.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (s *ImageScanSeverity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var severity string
+	if err := unmarshal(&severity); err != nil {
+		return err
+	}
+	if !contains(severity, ImageScanSeverities) {
+		return fmt.Errorf(`invalid "image.scan.fail_on" value %q: must be one of %s`, severity, english.WordSeries(quoteAll(ImageScanSeverities), "or"))
+	}
+	*s = ImageScanSeverity(severity)
+	return nil
+}
+
+// ImageScan holds the configuration of the vulnerability scan of an image built from a Dockerfile.
+// The image is scanned by ECR once it's pushed, and the deployment fails if the scan finds vulnerabilities
+// at or above the FailOn severity that are not in the Allow list.
+type ImageScan struct {
+	FailOn *ImageScanSeverity `yaml:"fail_on"`
+	Allow  []string           `yaml:"allow"` // IDs of the vulnerabilities to ignore, such as "CVE-2021-3711".
+}
+
+// FailOnSeverity returns the severity at or above which vulnerabilities fail the deployment.
+// It returns the empty string if the scan results are only reported.
+func (s *ImageScan) FailOnSeverity() string {
+	if s == nil || s.FailOn == nil {
+		return ""
+	}
+	return string(*s.FailOn)
+}
+
+// ImageSigning holds the configuration of the signature of an image built from a Dockerfile.
+// The image is signed by digest with Notation and the AWS Signer signing profile once it's pushed,
+// and the signature is stored next to the image in the ECR repository.
+type ImageSigning struct {
+	Profile *string `yaml:"profile"` // ARN of the AWS Signer signing profile.
+}
+
+// ProfileARN returns the ARN of the signing profile, or the empty string if the image isn't signed.
+func (s *ImageSigning) ProfileARN() string {
+	if s == nil {
+		return ""
+	}
+	return aws.StringValue(s.Profile)
+}
+
+// validate returns an error if the signing profile is not the ARN of an AWS Signer signing profile.
+func (s *ImageSigning) validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Profile == nil {
+		return errors.New(`"image.sign.profile" must be specified`)
+	}
+	parsed, err := arn.Parse(aws.StringValue(s.Profile))
+	if err != nil || parsed.Service != signerServiceName || !strings.HasPrefix(parsed.Resource, signingProfileResourcePrefix) {
+		return fmt.Errorf(`"image.sign.profile" %q must be the ARN of an AWS Signer signing profile`, aws.StringValue(s.Profile))
+	}
+	return nil
 }
 
 // ImageWithHealthcheck represents a container image with health check.
@@ -137,11 +230,13 @@ func (i *Image) BuildConfig(rootDirectory string) *DockerBuildArgs {
 		context = aws.String(filepath.Join(rootDirectory, ctx))
 	}
 	return &DockerBuildArgs{
-		Dockerfile: dockerfile,
-		Context:    context,
-		Args:       i.args(),
-		Target:     i.target(),
-		CacheFrom:  i.cacheFrom(),
+		Dockerfile:        dockerfile,
+		Context:           context,
+		Args:              i.args(),
+		Target:            i.target(),
+		CacheFrom:         i.cacheFrom(),
+		CacheTo:           i.Build.BuildArgs.CacheTo,
+		CacheFromPrevious: i.Build.BuildArgs.CacheFromPrevious,
 	}
 }
 
@@ -325,10 +420,14 @@ type DockerBuildArgs struct {
 	Args       map[string]string `yaml:"args,omitempty"`
 	Target     *string           `yaml:"target,omitempty"`
 	CacheFrom  []string          `yaml:"cache_from,omitempty"`
+	CacheTo    []string          `yaml:"cache_to,omitempty"`
+	// CacheFromPrevious uses the image previously pushed to the workload's ECR repository as a cache source.
+	CacheFromPrevious *bool `yaml:"cache_from_previous,omitempty"`
 }
 
 func (b *DockerBuildArgs) isEmpty() bool {
-	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil {
+	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil &&
+		b.CacheTo == nil && b.CacheFromPrevious == nil {
 		return true
 	}
 	return false
@@ -428,11 +527,21 @@ type TaskConfig struct {
 }
 
 // TaskPlatform returns the platform for the service.
+// If the manifest lists several platforms, the tasks run on the first one.
 func (t *TaskConfig) TaskPlatform() (*string, error) {
 	if t.Platform == nil {
 		return nil, nil
 	}
-	return t.Platform.PlatformString, nil
+	return t.Platform.taskPlatform(), nil
+}
+
+// ImagePlatforms returns the platforms to build a multi-platform image for.
+// It returns nil unless the manifest lists more than one platform.
+func (t *TaskConfig) ImagePlatforms() []string {
+	if t.Platform == nil || len(t.Platform.PlatformList) < 2 {
+		return nil
+	}
+	return t.Platform.PlatformList
 }
 
 // PublishConfig represents the configurable options for setting up publishers.
@@ -607,9 +716,10 @@ func (i ImageWithHealthcheck) HealthCheckOpts() *ecs.HealthCheck {
 }
 
 // PlatformArgsOrString is a custom type which supports unmarshaling yaml which
-// can either be of type string or type PlatformArgs.
+// can either be of type string, a list of strings, or type PlatformArgs.
 type PlatformArgsOrString struct {
 	PlatformString *string
+	PlatformList   []string
 	PlatformArgs   PlatformArgs
 }
 
@@ -636,19 +746,49 @@ func (p *PlatformArgsOrString) UnmarshalYAML(unmarshal func(interface{}) error)
 		if err := validateArch(p.PlatformArgs.Arch); err != nil {
 			return fmt.Errorf("validate arch: %w", err)
 		}
-		// Unmarshaled successfully to p.PlatformArgs, unset p.PlatformString, and return.
+		// Unmarshaled successfully to p.PlatformArgs, unset p.PlatformString and p.PlatformList, and return.
 		p.PlatformString = nil
+		p.PlatformList = nil
+		return nil
+	}
+	if err := unmarshal(&p.PlatformString); err == nil {
+		// Unmarshaled successfully to p.PlatformString, unset p.PlatformList, and return.
+		p.PlatformList = nil
+		if err := validatePlatform(p.PlatformString); err != nil {
+			return fmt.Errorf("validate platform: %w", err)
+		}
 		return nil
 	}
-	if err := unmarshal(&p.PlatformString); err != nil {
+	p.PlatformString = nil
+	if err := unmarshal(&p.PlatformList); err != nil {
 		return errUnmarshalPlatformOpts
 	}
-	if err := validatePlatform(p.PlatformString); err != nil {
+	if err := validatePlatformList(p.PlatformList); err != nil {
 		return fmt.Errorf("validate platform: %w", err)
 	}
 	return nil
 }
 
+// Arch returns the architecture that the tasks run on, or an empty string if it's not specified.
+func (p *PlatformArgsOrString) Arch() string {
+	platform := aws.StringValue(p.taskPlatform())
+	if i := strings.Index(platform, "/"); i != -1 {
+		return platform[i+1:]
+	}
+	return ""
+}
+
+// taskPlatform returns the OS/Arch pair that the tasks run on, which is the first one if several platforms are listed.
+func (p *PlatformArgsOrString) taskPlatform() *string {
+	switch {
+	case len(p.PlatformList) > 0:
+		return aws.String(p.PlatformList[0])
+	case p.PlatformArgs.bothSpecified():
+		return aws.String(dockerengine.DockerBuildPlatform(aws.StringValue(p.PlatformArgs.OSFamily), aws.StringValue(p.PlatformArgs.Arch)))
+	}
+	return p.PlatformString
+}
+
 // PlatformArgs represents the specifics of a target OS.
 type PlatformArgs struct {
 	OSFamily *string `yaml:"osfamily,omitempty"`
@@ -675,6 +815,23 @@ func validatePlatform(platform *string) error {
 	return fmt.Errorf("platform %s is invalid; %s: %s", aws.StringValue(platform), english.PluralWord(len(validPlatforms), "the valid platform is", "valid platforms are"), english.WordSeries(validPlatforms, "and"))
 }
 
+func validatePlatformList(platforms []string) error {
+	if len(platforms) == 0 {
+		return errors.New("at least one platform must be specified")
+	}
+	seen := make(map[string]bool)
+	for _, platform := range platforms {
+		if seen[platform] {
+			return fmt.Errorf("platform %s is specified more than once", platform)
+		}
+		seen[platform] = true
+		if err := validatePlatform(aws.String(platform)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateOS(os *string) error {
 	if os == nil {
 		return nil
@@ -706,8 +863,17 @@ func requiresBuild(image Image) (bool, error) {
 		return false, fmt.Errorf(`either "image.build" or "image.location" needs to be specified in the manifest`)
 	}
 	if image.Location == nil {
+		if err := image.Sign.validate(); err != nil {
+			return false, err
+		}
 		return true, nil
 	}
+	if image.Scan != nil {
+		return false, errors.New(`"image.scan" can only be specified with "image.build"`)
+	}
+	if image.Sign != nil {
+		return false, errors.New(`"image.sign" can only be specified with "image.build"`)
+	}
 	return false, nil
 }
 
@@ -255,6 +255,19 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildString: nil,
 			},
 		},
+		"Dockerfile with cache to and cache from the previous image": {
+			inContent: []byte(`build:
+  cache_to:
+    - type=inline
+  cache_from_previous: true`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					CacheTo:           []string{"type=inline"},
+					CacheFromPrevious: aws.Bool(true),
+				},
+				BuildString: nil,
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`build:
   badfield: OH NOES
@@ -281,6 +294,8 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.BuildArgs.Args, b.Build.BuildArgs.Args)
 				require.Equal(t, tc.wantedStruct.BuildArgs.Target, b.Build.BuildArgs.Target)
 				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFrom, b.Build.BuildArgs.CacheFrom)
+				require.Equal(t, tc.wantedStruct.BuildArgs.CacheTo, b.Build.BuildArgs.CacheTo)
+				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFromPrevious, b.Build.BuildArgs.CacheFromPrevious)
 			}
 		})
 	}
@@ -303,7 +318,7 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 		"returns error if platform string invalid": {
 			inContent: []byte(`platform: linus/mad64`),
 
-			wantedError: errors.New("validate platform: platform linus/mad64 is invalid; the valid platform is: linux/amd64"),
+			wantedError: errors.New("validate platform: platform linus/mad64 is invalid; valid platforms are: linux/amd64 and linux/arm64"),
 		},
 		"returns error if only args.os specified": {
 			inContent: []byte(`platform:
@@ -325,7 +340,7 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 			inContent: []byte(`platform:
   osfamily: linux
   architecture: abc123`),
-			wantedError: errors.New("validate arch: architecture abc123 is invalid; the valid architecture is: amd64"),
+			wantedError: errors.New("validate arch: architecture abc123 is invalid; valid architectures are: amd64 and arm64"),
 		},
 		"platform string": {
 			inContent: []byte(`platform: linux/amd64`),
@@ -352,6 +367,28 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
   archie: leg64`),
 			wantedError: errUnmarshalPlatformOpts,
 		},
+		"returns error if a platform in the list is invalid": {
+			inContent: []byte(`platform: [linux/amd64, linux/s390x]`),
+
+			wantedError: errors.New("validate platform: platform linux/s390x is invalid; valid platforms are: linux/amd64 and linux/arm64"),
+		},
+		"returns error if a platform is listed twice": {
+			inContent: []byte(`platform: [linux/arm64, linux/arm64]`),
+
+			wantedError: errors.New("validate platform: platform linux/arm64 is specified more than once"),
+		},
+		"returns error if the list is empty": {
+			inContent: []byte(`platform: []`),
+
+			wantedError: errors.New("validate platform: at least one platform must be specified"),
+		},
+		"platform list": {
+			inContent: []byte(`platform: [linux/arm64, linux/amd64]`),
+
+			wantedStruct: PlatformArgsOrString{
+				PlatformList: []string{"linux/arm64", "linux/amd64"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -370,6 +407,7 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedStruct.PlatformString, p.Platform.PlatformString)
+				require.Equal(t, tc.wantedStruct.PlatformList, p.Platform.PlatformList)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.OSFamily, p.Platform.PlatformArgs.OSFamily)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.Arch, p.Platform.PlatformArgs.Arch)
 			}
@@ -377,6 +415,95 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestImageScanSeverity_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedFailOn string
+		wantedError  error
+	}{
+		"valid severity": {
+			inContent: []byte(`fail_on: high`),
+
+			wantedFailOn: ImageScanSeverityHigh,
+		},
+		"returns error if the severity is invalid": {
+			inContent: []byte(`fail_on: hgih`),
+
+			wantedError: errors.New(`invalid "image.scan.fail_on" value "hgih": must be one of "critical", "high", "medium", "low" or "informational"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var scan ImageScan
+			err := yaml.Unmarshal(tc.inContent, &scan)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFailOn, scan.FailOnSeverity())
+			}
+		})
+	}
+}
+
+func TestTaskConfig_Platforms(t *testing.T) {
+	testCases := map[string]struct {
+		in *PlatformArgsOrString
+
+		wantedTaskPlatform   *string
+		wantedImagePlatforms []string
+		wantedArch           string
+	}{
+		"platform string": {
+			in: &PlatformArgsOrString{
+				PlatformString: aws.String("linux/arm64"),
+			},
+			wantedTaskPlatform: aws.String("linux/arm64"),
+			wantedArch:         "arm64",
+		},
+		"platform args": {
+			in: &PlatformArgsOrString{
+				PlatformArgs: PlatformArgs{
+					OSFamily: aws.String("linux"),
+					Arch:     aws.String("arm64"),
+				},
+			},
+			wantedTaskPlatform: aws.String("linux/arm64"),
+			wantedArch:         "arm64",
+		},
+		"list of a single platform": {
+			in: &PlatformArgsOrString{
+				PlatformList: []string{"linux/arm64"},
+			},
+			wantedTaskPlatform: aws.String("linux/arm64"),
+			wantedArch:         "arm64",
+		},
+		"tasks run on the first platform of the list": {
+			in: &PlatformArgsOrString{
+				PlatformList: []string{"linux/amd64", "linux/arm64"},
+			},
+			wantedTaskPlatform:   aws.String("linux/amd64"),
+			wantedImagePlatforms: []string{"linux/amd64", "linux/arm64"},
+			wantedArch:           "amd64",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			conf := TaskConfig{
+				Platform: tc.in,
+			}
+
+			got, err := conf.TaskPlatform()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskPlatform, got)
+			require.Equal(t, tc.wantedImagePlatforms, conf.ImagePlatforms())
+			require.Equal(t, tc.wantedArch, tc.in.Arch())
+		})
+	}
+}
+
 func TestExec_UnmarshalYAML(t *testing.T) {
 	testCases := map[string]struct {
 		inContent []byte
@@ -524,6 +651,8 @@ func TestBuildConfig(t *testing.T) {
 						"foo/bar:latest",
 						"foo/bar/baz:1.2.3",
 					},
+					CacheTo:           []string{"type=inline"},
+					CacheFromPrevious: aws.Bool(true),
 				},
 			},
 			wantedBuild: DockerBuildArgs{
@@ -534,6 +663,8 @@ func TestBuildConfig(t *testing.T) {
 					"foo/bar:latest",
 					"foo/bar/baz:1.2.3",
 				},
+				CacheTo:           []string{"type=inline"},
+				CacheFromPrevious: aws.Bool(true),
 			},
 		},
 	}
@@ -48,6 +48,20 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(args interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), args)
 }
 
+// BuildAndPushMultiPlatform mocks base method.
+func (m *MockContainerLoginBuildPusher) BuildAndPushMultiPlatform(args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildAndPushMultiPlatform", args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildAndPushMultiPlatform indicates an expected call of BuildAndPushMultiPlatform.
+func (mr *MockContainerLoginBuildPusherMockRecorder) BuildAndPushMultiPlatform(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPushMultiPlatform", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).BuildAndPushMultiPlatform), args)
+}
+
 // IsEcrCredentialHelperEnabled mocks base method.
 func (m *MockContainerLoginBuildPusher) IsEcrCredentialHelperEnabled(uri string) bool {
 	m.ctrl.T.Helper()
@@ -135,6 +149,21 @@ func (mr *MockRegistryMockRecorder) Auth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockRegistry)(nil).Auth))
 }
 
+// ImageDigest mocks base method.
+func (m *MockRegistry) ImageDigest(name, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", name, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockRegistryMockRecorder) ImageDigest(name, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockRegistry)(nil).ImageDigest), name, tag)
+}
+
 // RepositoryURI mocks base method.
 func (m *MockRegistry) RepositoryURI(name string) (string, error) {
 	m.ctrl.T.Helper()
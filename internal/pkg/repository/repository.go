@@ -13,6 +13,7 @@ import (
 // ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
 type ContainerLoginBuildPusher interface {
 	Build(args *dockerengine.BuildArguments) error
+	BuildAndPushMultiPlatform(args *dockerengine.BuildArguments) error
 	Login(uri, username, password string) error
 	Push(uri string, tags ...string) (digest string, err error)
 	IsEcrCredentialHelperEnabled(uri string) bool
@@ -22,6 +23,7 @@ type ContainerLoginBuildPusher interface {
 type Registry interface {
 	RepositoryURI(name string) (string, error)
 	Auth() (string, string, error)
+	ImageDigest(name, tag string) (string, error)
 }
 
 // Repository builds and pushes images to a repository.
@@ -29,7 +31,8 @@ type Repository struct {
 	name     string
 	registry Registry
 
-	uri string
+	uri      string
+	loggedIn bool
 }
 
 // New instantiates a new Repository.
@@ -47,23 +50,27 @@ func New(name string, registry Registry) (*Repository, error) {
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
+// If the image is built for several platforms, it's pushed as a manifest list and the digest of the list is returned.
 func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (digest string, err error) {
 	if args.URI == "" {
 		args.URI = r.uri
 	}
+	if len(args.Platforms) > 1 {
+		return r.buildAndPushMultiPlatform(docker, args)
+	}
+	// Pulling the previous image as a cache source requires to log in before building.
+	if args.CacheFromPrevious {
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
+		}
+	}
 	if err := docker.Build(args); err != nil {
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
 
-	// Perform docker login only if credStore attribute value != ecr-login
-	if !docker.IsEcrCredentialHelperEnabled(args.URI) {
-		username, password, err := r.registry.Auth()
-		if err != nil {
-			return "", fmt.Errorf("get auth: %w", err)
-		}
-
-		if err := docker.Login(args.URI, username, password); err != nil {
-			return "", fmt.Errorf("login to repo %s: %w", r.name, err)
+	if !args.CacheFromPrevious {
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
 		}
 	}
 
@@ -74,7 +81,66 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 	return digest, nil
 }
 
+// buildAndPushMultiPlatform pushes the images while they're built, so it needs to log in to the repository first.
+func (r *Repository) buildAndPushMultiPlatform(docker ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
+	}
+	if err := docker.BuildAndPushMultiPlatform(args); err != nil {
+		return "", fmt.Errorf("build and push Dockerfile at %s: %w", args.Dockerfile, err)
+	}
+	// The images are never stored locally, so the digest of the manifest list is retrieved from the repository.
+	tag := "latest"
+	if len(args.Tags) > 0 {
+		tag = args.Tags[0]
+	}
+	return r.Digest(tag)
+}
+
+// Login logs in to the registry of the repository.
+// Images are then built and pushed to the repository without logging in again.
+func (r *Repository) Login(docker ContainerLoginBuildPusher) error {
+	if err := r.login(docker, r.uri); err != nil {
+		return err
+	}
+	r.loggedIn = true
+	return nil
+}
+
+// SkipLogin marks the registry of the repository as logged in to, for example with another repository of the registry.
+// Images are then built and pushed to the repository without logging in.
+func (r *Repository) SkipLogin() {
+	r.loggedIn = true
+}
+
+func (r *Repository) login(docker ContainerLoginBuildPusher, uri string) error {
+	if r.loggedIn {
+		return nil
+	}
+	// Perform docker login only if credStore attribute value != ecr-login
+	if docker.IsEcrCredentialHelperEnabled(uri) {
+		return nil
+	}
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
+	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() string {
 	return r.uri
 }
+
+// Digest returns the digest of the image tagged with tag in the repository.
+func (r *Repository) Digest(tag string) (string, error) {
+	digest, err := r.registry.ImageDigest(r.name, tag)
+	if err != nil {
+		return "", fmt.Errorf("get digest of image %s:%s: %w", r.uri, tag, err)
+	}
+	return digest, nil
+}
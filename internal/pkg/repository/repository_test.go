@@ -144,3 +144,217 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_BuildAndPush_CacheFromPrevious(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRegistry := mocks.NewMockRegistry(ctrl)
+	mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+	gomock.InOrder(
+		mockDocker.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(false),
+		mockRegistry.EXPECT().Auth().Return("my-name", "my-pwd", nil),
+		mockDocker.EXPECT().Login("mockRepoURI", "my-name", "my-pwd").Return(nil),
+		mockDocker.EXPECT().Build(gomock.Any()).Return(nil),
+		mockDocker.EXPECT().Push("mockRepoURI").Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil),
+	)
+	repo := &Repository{
+		name:     "my-repo",
+		registry: mockRegistry,
+
+		uri: "mockRepoURI",
+	}
+
+	// WHEN
+	digest, err := repo.BuildAndPush(mockDocker, &dockerengine.BuildArguments{
+		Dockerfile:        "path/to/dockerfile",
+		CacheFromPrevious: true,
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+}
+
+func TestRepository_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRegistry := mocks.NewMockRegistry(ctrl)
+	mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+	gomock.InOrder(
+		mockDocker.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(false),
+		mockRegistry.EXPECT().Auth().Return("my-name", "my-pwd", nil),
+		mockDocker.EXPECT().Login("mockRepoURI", "my-name", "my-pwd").Return(nil),
+		mockDocker.EXPECT().Build(gomock.Any()).Return(nil),
+		mockDocker.EXPECT().Push("mockRepoURI").Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil),
+	)
+	repo := &Repository{
+		name:     "my-repo",
+		registry: mockRegistry,
+
+		uri: "mockRepoURI",
+	}
+
+	// WHEN
+	err := repo.Login(mockDocker)
+	require.NoError(t, err)
+	_, err = repo.BuildAndPush(mockDocker, &dockerengine.BuildArguments{
+		Dockerfile: "path/to/dockerfile",
+	})
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_SkipLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+	gomock.InOrder(
+		mockDocker.EXPECT().Build(gomock.Any()).Return(nil),
+		mockDocker.EXPECT().Push("mockRepoURI").Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil),
+	)
+	repo := &Repository{
+		name: "my-repo",
+		uri:  "mockRepoURI",
+	}
+
+	// WHEN
+	repo.SkipLogin()
+	_, err := repo.BuildAndPush(mockDocker, &dockerengine.BuildArguments{
+		Dockerfile:        "path/to/dockerfile",
+		CacheFromPrevious: true,
+	})
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_BuildAndPushMultiPlatform(t *testing.T) {
+	const (
+		mockRepoURI = "mockRepoURI"
+		mockDigest  = "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"
+	)
+	mockArgs := func() *dockerengine.BuildArguments {
+		return &dockerengine.BuildArguments{
+			Dockerfile: "path/to/dockerfile",
+			Tags:       []string{"v1"},
+			Platforms:  []string{"linux/amd64", "linux/arm64"},
+		}
+	}
+	testCases := map[string]struct {
+		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedError  error
+		wantedDigest string
+	}{
+		"logs in before building": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(false)
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(errors.New("error logging in"))
+				m.EXPECT().BuildAndPushMultiPlatform(gomock.Any()).Times(0)
+			},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			wantedError: errors.New("login to repo my-repo: error logging in"),
+		},
+		"failed to build and push the image": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(true)
+				m.EXPECT().BuildAndPushMultiPlatform(gomock.Any()).Return(errors.New("some error"))
+			},
+			mockRegistry: func(m *mocks.MockRegistry) {},
+			wantedError:  errors.New("build and push Dockerfile at path/to/dockerfile: some error"),
+		},
+		"returns the digest of the manifest list": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(true)
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().BuildAndPushMultiPlatform(&dockerengine.BuildArguments{
+					URI:        mockRepoURI,
+					Dockerfile: "path/to/dockerfile",
+					Tags:       []string{"v1"},
+					Platforms:  []string{"linux/amd64", "linux/arm64"},
+				}).Return(nil)
+			},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().ImageDigest("my-repo", "v1").Return(mockDigest, nil)
+			},
+			wantedDigest: mockDigest,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.inMockDocker(mockDocker)
+
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+
+				uri: mockRepoURI,
+			}
+
+			digest, err := repo.BuildAndPush(mockDocker, mockArgs())
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDigest, digest)
+			}
+		})
+	}
+}
+
+func TestRepository_Digest(t *testing.T) {
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedError  error
+		wantedDigest string
+	}{
+		"failed to get digest": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().ImageDigest("my-repo", "v1").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get digest of image mockRepoURI:v1: some error"),
+		},
+		"success": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().ImageDigest("my-repo", "v1").Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			tc.mockRegistry(mockRegistry)
+
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+
+				uri: "mockRepoURI",
+			}
+
+			digest, err := repo.Digest("v1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDigest, digest)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+)
+
+// Statuses of a pipeline execution.
+const (
+	pipelineExecutionInProgress = "InProgress"
+	pipelineExecutionStopping   = "Stopping"
+	pipelineExecutionSucceeded  = "Succeeded"
+)
+
+// PipelineExecutionDescriber is the interface to describe an execution of a pipeline.
+type PipelineExecutionDescriber interface {
+	PipelineExecutionStatus(pipelineName, executionID string) (string, error)
+	ActionExecutions(pipelineName, executionID string) ([]codepipeline.ActionExecution, error)
+}
+
+// PipelineExecution is a description of an execution of a pipeline.
+type PipelineExecution struct {
+	Status  string
+	Actions []codepipeline.ActionExecution // Latest run of each action that started, in the order they started.
+}
+
+// ErrPipelineExecutionFailed is returned when an execution of a pipeline ends without succeeding.
+type ErrPipelineExecutionFailed struct {
+	Pipeline    string
+	ExecutionID string
+	Status      string
+}
+
+func (e *ErrPipelineExecutionFailed) Error() string {
+	return fmt.Sprintf("execution %s of pipeline %s ended with status %s", e.ExecutionID, e.Pipeline, e.Status)
+}
+
+// PipelineExecutionStreamer is a Streamer for PipelineExecution descriptions until the execution ends.
+type PipelineExecutionStreamer struct {
+	client      PipelineExecutionDescriber
+	clock       clock
+	rand        func(n int) int
+	pipeline    string
+	executionID string
+
+	subscribers   []chan PipelineExecution
+	once          sync.Once
+	done          chan struct{}
+	isDone        bool
+	eventsToFlush []PipelineExecution
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewPipelineExecutionStreamer creates a new PipelineExecutionStreamer that streams the descriptions of an execution
+// of a pipeline until it ends.
+func NewPipelineExecutionStreamer(client PipelineExecutionDescriber, pipeline, executionID string) *PipelineExecutionStreamer {
+	return &PipelineExecutionStreamer{
+		client:      client,
+		clock:       realClock{},
+		rand:        rand.Intn,
+		pipeline:    pipeline,
+		executionID: executionID,
+		done:        make(chan struct{}),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive execution descriptions from the PipelineExecutionStreamer.
+func (s *PipelineExecutionStreamer) Subscribe() <-chan PipelineExecution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan PipelineExecution)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the status of the execution and of its actions.
+// If the execution ended without succeeding, stores the latest description and returns an ErrPipelineExecutionFailed.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *PipelineExecutionStreamer) Fetch() (next time.Time, err error) {
+	status, err := s.client.PipelineExecutionStatus(s.pipeline, s.executionID)
+	if err != nil {
+		if isThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("fetch status of pipeline execution: %w", err)
+	}
+	actions, err := s.client.ActionExecutions(s.pipeline, s.executionID)
+	if err != nil {
+		if isThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("fetch actions of pipeline execution: %w", err)
+	}
+	s.retries = 0
+	s.eventsToFlush = append(s.eventsToFlush, PipelineExecution{
+		Status:  status,
+		Actions: actions,
+	})
+	if status == pipelineExecutionInProgress || status == pipelineExecutionStopping {
+		return nextFetchDate(s.clock, s.rand, 0), nil
+	}
+	// The execution ended, notify that there is no need for another Fetch call beyond this point.
+	s.once.Do(func() {
+		close(s.done)
+	})
+	if status != pipelineExecutionSucceeded {
+		return next, &ErrPipelineExecutionFailed{
+			Pipeline:    s.pipeline,
+			ExecutionID: s.executionID,
+			Status:      status,
+		}
+	}
+	return next, nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *PipelineExecutionStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan PipelineExecution
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *PipelineExecutionStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+// Done returns a channel that's closed when there are no more events that can be fetched.
+func (s *PipelineExecutionStreamer) Done() <-chan struct{} {
+	return s.done
+}
@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPipelineExecutionDescriber struct {
+	status     string
+	statusErr  error
+	actions    []codepipeline.ActionExecution
+	actionsErr error
+}
+
+func (m mockPipelineExecutionDescriber) PipelineExecutionStatus(pipelineName, executionID string) (string, error) {
+	return m.status, m.statusErr
+}
+
+func (m mockPipelineExecutionDescriber) ActionExecutions(pipelineName, executionID string) ([]codepipeline.ActionExecution, error) {
+	return m.actions, m.actionsErr
+}
+
+func TestPipelineExecutionStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if the streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &PipelineExecutionStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &PipelineExecutionStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestPipelineExecutionStreamer_Fetch(t *testing.T) {
+	startTime := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	actions := []codepipeline.ActionExecution{
+		{
+			StageName:  "Source",
+			ActionName: "SourceCodeFor-dinder",
+			Status:     "Succeeded",
+			StartedAt:  startTime,
+		},
+		{
+			StageName:  "DeployTo-test",
+			ActionName: "CreateOrUpdate-api-test",
+			Status:     "InProgress",
+			StartedAt:  startTime.Add(time.Minute),
+		},
+	}
+	testCases := map[string]struct {
+		describer mockPipelineExecutionDescriber
+
+		wantedEvents []PipelineExecution
+		wantedDone   bool
+		wantedNext   bool
+		wantedErr    error
+	}{
+		"returns a wrapped error if the status cannot be retrieved": {
+			describer: mockPipelineExecutionDescriber{
+				statusErr: errors.New("some error"),
+			},
+			wantedErr: errors.New("fetch status of pipeline execution: some error"),
+		},
+		"returns a wrapped error if the actions cannot be retrieved": {
+			describer: mockPipelineExecutionDescriber{
+				status:     "InProgress",
+				actionsErr: errors.New("some error"),
+			},
+			wantedErr: errors.New("fetch actions of pipeline execution: some error"),
+		},
+		"retries later on throttling errors": {
+			describer: mockPipelineExecutionDescriber{
+				statusErr: fmt.Errorf("get execution: %w", awserr.New("ThrottlingException", "throttle err", errors.New("abc"))),
+			},
+			wantedNext: true,
+		},
+		"stores the execution while it is in progress": {
+			describer: mockPipelineExecutionDescriber{
+				status:  "InProgress",
+				actions: actions,
+			},
+			wantedEvents: []PipelineExecution{
+				{Status: "InProgress", Actions: actions},
+			},
+			wantedNext: true,
+		},
+		"is done once the execution succeeds": {
+			describer: mockPipelineExecutionDescriber{
+				status:  "Succeeded",
+				actions: actions,
+			},
+			wantedEvents: []PipelineExecution{
+				{Status: "Succeeded", Actions: actions},
+			},
+			wantedDone: true,
+		},
+		"is done and returns an error once the execution fails": {
+			describer: mockPipelineExecutionDescriber{
+				status:  "Failed",
+				actions: actions,
+			},
+			wantedEvents: []PipelineExecution{
+				{Status: "Failed", Actions: actions},
+			},
+			wantedDone: true,
+			wantedErr:  errors.New("execution exec-1 of pipeline pipeline-dinder-badgoose-repo ended with status Failed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			streamer := NewPipelineExecutionStreamer(tc.describer, "pipeline-dinder-badgoose-repo", "exec-1")
+			streamer.clock = fakeClock{fakeNow: startTime}
+			streamer.rand = func(n int) int { return n }
+
+			// WHEN
+			next, err := streamer.Fetch()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedEvents, streamer.eventsToFlush)
+			require.Equal(t, tc.wantedNext, next.After(startTime))
+			select {
+			case <-streamer.Done():
+				require.True(t, tc.wantedDone, "streamer should not be done")
+			default:
+				require.False(t, tc.wantedDone, "streamer should be done")
+			}
+		})
+	}
+}
+
+func TestPipelineExecutionStreamer_Notify(t *testing.T) {
+	// GIVEN
+	wantedEvents := []PipelineExecution{
+		{Status: "InProgress"},
+		{Status: "Succeeded"},
+	}
+	sub := make(chan PipelineExecution, 2)
+	streamer := &PipelineExecutionStreamer{
+		subscribers:   []chan PipelineExecution{sub},
+		eventsToFlush: wantedEvents,
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub) // Close the channel to stop expecting to receive new events.
+
+	// THEN
+	var actualEvents []PipelineExecution
+	for event := range sub {
+		actualEvents = append(actualEvents, event)
+	}
+	require.Equal(t, wantedEvents, actualEvents)
+	require.Nil(t, streamer.eventsToFlush)
+}
+
+func TestPipelineExecutionStreamer_Close(t *testing.T) {
+	// GIVEN
+	streamer := &PipelineExecutionStreamer{}
+	c := streamer.Subscribe()
+
+	// WHEN
+	streamer.Close()
+
+	// THEN
+	_, isOpen := <-c
+	require.False(t, isOpen, "expected subscribed channels to be closed")
+	require.True(t, streamer.isDone, "should mark the streamer that it won't allow new subscribers")
+}
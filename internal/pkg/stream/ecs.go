@@ -4,6 +4,7 @@
 package stream
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -11,8 +12,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 )
 
 const (
@@ -30,23 +33,37 @@ type ECSServiceDescriber interface {
 	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
+// ListenerRuleDescriber is the interface to describe how a listener rule splits requests between target groups.
+type ListenerRuleDescriber interface {
+	WeightedListenerRule(targetGroupARN string) (string, error)
+	TargetGroupWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error)
+}
+
 // ECSDeployment represent an ECS rolling update deployment.
 type ECSDeployment struct {
-	Status          string
-	TaskDefRevision string
-	DesiredCount    int
-	RunningCount    int
-	FailedCount     int
-	PendingCount    int
-	RolloutState    string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	Status             string
+	TaskDefRevision    string
+	DesiredCount       int
+	RunningCount       int
+	FailedCount        int
+	PendingCount       int
+	RolloutState       string
+	RolloutStateReason string // Why the deployment is in its rollout state, for example why ECS failed the deployment.
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 func (d ECSDeployment) isPrimary() bool {
 	return d.Status == ecsPrimaryDeploymentStatus
 }
 
+// isRolledBack returns true if the deployment started after startTime and ECS failed it,
+// either with the deployment circuit breaker or because a rollback alarm fired.
+// ECS then creates a new primary deployment that rolls the service back to the previous revision.
+func (d ECSDeployment) isRolledBack(startTime time.Time) bool {
+	return d.RolloutState == rollOutFailed && !d.CreatedAt.Before(startTime)
+}
+
 func (d ECSDeployment) done() bool {
 	switch d.RolloutState {
 	case rollOutFailed:
@@ -58,10 +75,44 @@ func (d ECSDeployment) done() bool {
 	}
 }
 
+// TargetGroupTraffic is the share of production requests forwarded to a target group
+// while a blue/green or canary deployment shifts traffic.
+type TargetGroupTraffic struct {
+	TargetGroup string // Name of the target group.
+	Percent     int
+}
+
 // ECSService is a description of an ECS service.
 type ECSService struct {
 	Deployments         []ECSDeployment
 	LatestFailureEvents []string
+	Traffic             []TargetGroupTraffic // Empty unless the service shifts traffic between target groups.
+}
+
+// ErrECSDeploymentRolledBack is returned when ECS fails a deployment of a service and rolls it back to its previous revision.
+type ErrECSDeploymentRolledBack struct {
+	Cluster        string
+	Service        string
+	TaskDefinition string // ARN of the task definition that failed to deploy.
+	Reason         string
+}
+
+func (e *ErrECSDeploymentRolledBack) Error() string {
+	msg := fmt.Sprintf("deployment of service %s failed and is rolling back to the previous revision", e.Service)
+	if e.Reason == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", msg, e.Reason)
+}
+
+// ECSDeploymentStreamerOption allows to configure optional fields of an ECSDeploymentStreamer.
+type ECSDeploymentStreamerOption func(s *ECSDeploymentStreamer)
+
+// WithTrafficShifting reports how the listener rule in front of the service splits requests between target groups.
+func WithTrafficShifting(rules ListenerRuleDescriber) ECSDeploymentStreamerOption {
+	return func(s *ECSDeploymentStreamer) {
+		s.rules = rules
+	}
 }
 
 // ECSDeploymentStreamer is a Streamer for ECSService descriptions until the deployment is completed.
@@ -73,6 +124,10 @@ type ECSDeploymentStreamer struct {
 	service                string
 	deploymentCreationTime time.Time
 
+	rules          ListenerRuleDescriber // Nil if traffic shifting isn't reported.
+	ruleARN        string                // ARN of the weighted listener rule, empty if the service doesn't shift traffic.
+	isRuleResolved bool
+
 	subscribers   []chan ECSService
 	once          sync.Once
 	done          chan struct{}
@@ -86,8 +141,8 @@ type ECSDeploymentStreamer struct {
 
 // NewECSDeploymentStreamer creates a new ECSDeploymentStreamer that streams service descriptions
 // since the deployment creation time and until the primary deployment is completed.
-func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time) *ECSDeploymentStreamer {
-	return &ECSDeploymentStreamer{
+func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time, opts ...ECSDeploymentStreamerOption) *ECSDeploymentStreamer {
+	s := &ECSDeploymentStreamer{
 		client:                 ecs,
 		clock:                  realClock{},
 		rand:                   rand.Intn,
@@ -97,6 +152,10 @@ func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string,
 		done:                   make(chan struct{}),
 		pastEventIDs:           make(map[string]bool),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Subscribe returns a read-only channel that will receive service descriptions from the ECSDeploymentStreamer.
@@ -115,6 +174,7 @@ func (s *ECSDeploymentStreamer) Subscribe() <-chan ECSService {
 // Fetch retrieves and stores ECSService descriptions since the deployment's creation time
 // until the primary deployment's running count is equal to its desired count.
 // If an error occurs from describe service, returns a wrapped err.
+// If ECS rolls back the deployment, stores the latest description and returns an ErrECSDeploymentRolledBack.
 // Otherwise, returns the time the next Fetch should be attempted.
 func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 	out, err := s.client.Service(s.cluster, s.service)
@@ -125,23 +185,41 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 		}
 		return next, fmt.Errorf("fetch service description: %w", err)
 	}
+	traffic, err := s.fetchTraffic(out)
+	if err != nil {
+		if isThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, err
+	}
 	s.retries = 0
 	var deployments []ECSDeployment
+	var rolledBack *ErrECSDeploymentRolledBack
 	for _, deployment := range out.Deployments {
 		status := aws.StringValue(deployment.Status)
 		desiredCount, runningCount := aws.Int64Value(deployment.DesiredCount), aws.Int64Value(deployment.RunningCount)
 		rollingDeploy := ECSDeployment{
-			Status:          status,
-			TaskDefRevision: parseRevisionFromTaskDefARN(aws.StringValue(deployment.TaskDefinition)),
-			DesiredCount:    int(desiredCount),
-			RunningCount:    int(runningCount),
-			FailedCount:     int(aws.Int64Value(deployment.FailedTasks)),
-			PendingCount:    int(aws.Int64Value(deployment.PendingCount)),
-			RolloutState:    aws.StringValue(deployment.RolloutState),
-			CreatedAt:       aws.TimeValue(deployment.CreatedAt),
-			UpdatedAt:       aws.TimeValue(deployment.UpdatedAt),
+			Status:             status,
+			TaskDefRevision:    parseRevisionFromTaskDefARN(aws.StringValue(deployment.TaskDefinition)),
+			DesiredCount:       int(desiredCount),
+			RunningCount:       int(runningCount),
+			FailedCount:        int(aws.Int64Value(deployment.FailedTasks)),
+			PendingCount:       int(aws.Int64Value(deployment.PendingCount)),
+			RolloutState:       aws.StringValue(deployment.RolloutState),
+			RolloutStateReason: aws.StringValue(deployment.RolloutStateReason),
+			CreatedAt:          aws.TimeValue(deployment.CreatedAt),
+			UpdatedAt:          aws.TimeValue(deployment.UpdatedAt),
 		}
 		deployments = append(deployments, rollingDeploy)
+		if rolledBack == nil && rollingDeploy.isRolledBack(s.deploymentCreationTime) {
+			rolledBack = &ErrECSDeploymentRolledBack{
+				Cluster:        s.cluster,
+				Service:        s.service,
+				TaskDefinition: aws.StringValue(deployment.TaskDefinition),
+				Reason:         rollingDeploy.RolloutStateReason,
+			}
+		}
 		if isDeploymentDone(rollingDeploy, s.deploymentCreationTime) {
 			// The deployment is done, notify that there is no need for another Fetch call beyond this point.
 			// In stream.Stream, it's possible that both the <-Done() event is available as well as another Fetch()
@@ -169,10 +247,53 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 	s.eventsToFlush = append(s.eventsToFlush, ECSService{
 		Deployments:         deployments,
 		LatestFailureEvents: failureMsgs,
+		Traffic:             traffic,
 	})
+	if rolledBack != nil {
+		// There is no need to keep streaming the rollback, the deployment already failed.
+		return next, rolledBack
+	}
 	return nextFetchDate(s.clock, s.rand, 0), nil
 }
 
+// fetchTraffic returns how requests are split between the target groups of the service.
+func (s *ECSDeploymentStreamer) fetchTraffic(svc *ecs.Service) ([]TargetGroupTraffic, error) {
+	if s.rules == nil || len(svc.LoadBalancers) == 0 {
+		return nil, nil
+	}
+	if !s.isRuleResolved {
+		tgARN := aws.StringValue(svc.LoadBalancers[0].TargetGroupArn)
+		arn, err := s.rules.WeightedListenerRule(tgARN)
+		if err != nil {
+			return nil, fmt.Errorf("find listener rule for target group %s: %w", tgARN, err)
+		}
+		s.ruleARN, s.isRuleResolved = arn, true
+	}
+	if s.ruleARN == "" {
+		return nil, nil
+	}
+	weights, err := s.rules.TargetGroupWeights(s.ruleARN)
+	if err != nil {
+		return nil, fmt.Errorf("fetch target group weights: %w", err)
+	}
+	var total int
+	for _, w := range weights {
+		total += w.Weight
+	}
+	var traffic []TargetGroupTraffic
+	for _, w := range weights {
+		percent := 0
+		if total > 0 {
+			percent = w.Weight * 100 / total
+		}
+		traffic = append(traffic, TargetGroupTraffic{
+			TargetGroup: parseTargetGroupName(w.TargetGroupARN),
+			Percent:     percent,
+		})
+	}
+	return traffic, nil
+}
+
 // Notify flushes all new events to the streamer's subscribers.
 func (s *ECSDeploymentStreamer) Notify() {
 	// Copy current list of subscribers over, so that we can we add more subscribers while
@@ -214,6 +335,22 @@ func parseRevisionFromTaskDefARN(arn string) string {
 	return strings.Split(familyName, ":")[1]
 }
 
+// parseTargetGroupName returns the name of a target group given its ARN.
+// For example, given the input "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/demo-Targe-1ABC/73e2d6bc24d8a067"
+// the output is "demo-Targe-1ABC".
+func parseTargetGroupName(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 2 {
+		return arn
+	}
+	return parts[1]
+}
+
+func isThrottle(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && request.IsErrorThrottle(aerr)
+}
+
 func isFailureServiceEvent(msg string) bool {
 	for _, kw := range ecsEventFailureKeywords {
 		if strings.Contains(msg, kw) {
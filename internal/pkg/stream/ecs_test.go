@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +24,22 @@ func (m mockECS) Service(clusterName, serviceName string) (*ecs.Service, error)
 	return m.out, m.err
 }
 
+type mockListenerRules struct {
+	ruleARN    string
+	weights    []elbv2.TargetGroupWeight
+	err        error
+	numLookups int
+}
+
+func (m *mockListenerRules) WeightedListenerRule(targetGroupARN string) (string, error) {
+	m.numLookups++
+	return m.ruleARN, m.err
+}
+
+func (m *mockListenerRules) TargetGroupWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error) {
+	return m.weights, m.err
+}
+
 func TestECSDeploymentStreamer_Subscribe(t *testing.T) {
 	t.Run("allow new subscriptions if stack streamer is still active", func(t *testing.T) {
 		// GIVEN
@@ -192,6 +209,56 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		_, isOpen := <-streamer.Done()
 		require.False(t, isOpen, "there should be no more work to do since the deployment is completed")
 	})
+	t.Run("returns an error with the failure reason if the deployment is rolled back", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := mockECS{
+			out: &ecs.Service{
+				Deployments: []*awsecs.Deployment{
+					{
+						DesiredCount:   aws.Int64(10),
+						FailedTasks:    aws.Int64(0),
+						PendingCount:   aws.Int64(10),
+						RunningCount:   aws.Int64(0),
+						Status:         aws.String("PRIMARY"),
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
+						RolloutState:   aws.String("IN_PROGRESS"),
+						CreatedAt:      aws.Time(startDate.Add(10 * time.Minute)),
+						UpdatedAt:      aws.Time(startDate.Add(10 * time.Minute)),
+					},
+					{
+						DesiredCount:       aws.Int64(10),
+						FailedTasks:        aws.Int64(10),
+						PendingCount:       aws.Int64(0),
+						RunningCount:       aws.Int64(0),
+						Status:             aws.String("ACTIVE"),
+						TaskDefinition:     aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:2"),
+						RolloutState:       aws.String("FAILED"),
+						RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+						CreatedAt:          aws.Time(startDate.Add(1 * time.Minute)),
+						UpdatedAt:          aws.Time(startDate.Add(10 * time.Minute)),
+					},
+				},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", startDate)
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		var errRolledBack *ErrECSDeploymentRolledBack
+		require.True(t, errors.As(err, &errRolledBack))
+		require.Equal(t, &ErrECSDeploymentRolledBack{
+			Cluster:        "my-cluster",
+			Service:        "my-svc",
+			TaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:2",
+			Reason:         "ECS deployment circuit breaker: tasks failed to start.",
+		}, errRolledBack)
+		require.EqualError(t, err, "deployment of service my-svc failed and is rolling back to the previous revision: ECS deployment circuit breaker: tasks failed to start.")
+		require.Len(t, streamer.eventsToFlush, 1, "the latest service description should still be flushed")
+		require.Equal(t, "FAILED", streamer.eventsToFlush[0].Deployments[1].RolloutState)
+	})
 	t.Run("stores only failure event messages", func(t *testing.T) {
 		// GIVEN
 		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
@@ -345,6 +412,68 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only event to flush")
 		require.Nil(t, streamer.eventsToFlush[0].LatestFailureEvents, "there should be no failed events emitted")
 	})
+	t.Run("returns a wrapped error if the listener rule cannot be found", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{
+				LoadBalancers: []*awsecs.LoadBalancer{{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/blue/1234")}},
+			},
+		}
+		rules := &mockListenerRules{err: errors.New("some error")}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", time.Now(), WithTrafficShifting(rules))
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "find listener rule for target group arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/blue/1234: some error")
+	})
+	t.Run("stores the traffic split between target groups", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{
+				LoadBalancers: []*awsecs.LoadBalancer{{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/blue/1234")}},
+			},
+		}
+		rules := &mockListenerRules{
+			ruleARN: "rule-1",
+			weights: []elbv2.TargetGroupWeight{
+				{TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/blue/1234", Weight: 90},
+				{TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/green/5678", Weight: 10},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", time.Now(), WithTrafficShifting(rules))
+
+		// WHEN
+		_, err := streamer.Fetch()
+		require.NoError(t, err)
+		_, err = streamer.Fetch()
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 1, rules.numLookups, "should look up the listener rule only once")
+		require.Equal(t, []TargetGroupTraffic{
+			{TargetGroup: "blue", Percent: 90},
+			{TargetGroup: "green", Percent: 10},
+		}, streamer.eventsToFlush[1].Traffic)
+	})
+	t.Run("does not report traffic if the service is not behind a weighted listener rule", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{
+				LoadBalancers: []*awsecs.LoadBalancer{{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/blue/1234")}},
+			},
+		}
+		rules := &mockListenerRules{}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", time.Now(), WithTrafficShifting(rules))
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Nil(t, streamer.eventsToFlush[0].Traffic)
+	})
 }
 
 func TestECSDeploymentStreamer_Notify(t *testing.T) {
@@ -34,6 +34,7 @@ type Streamer interface {
 
 // Stream streams event updates by calling Fetch followed with Notify until there are no more events left.
 // If the context is canceled or Fetch errors, then Stream short-circuits and returns the error.
+// Events stored by Fetch before it errored are still published to subscribers.
 func Stream(ctx context.Context, streamer Streamer) error {
 	defer streamer.Close()
 
@@ -55,6 +56,7 @@ func Stream(ctx context.Context, streamer Streamer) error {
 		case <-time.After(fetchDelay):
 			next, err = streamer.Fetch()
 			if err != nil {
+				streamer.Notify() // Flush events stored before the error so that subscribers can still render them.
 				return err
 			}
 			streamer.Notify()
@@ -41,15 +41,18 @@ func (s *counterStreamer) Done() <-chan struct{} {
 
 // errStreamer returns an error when Fetch is invoked.
 type errStreamer struct {
-	err  error
-	done chan struct{}
+	err        error
+	done       chan struct{}
+	isNotified bool
 }
 
 func (s *errStreamer) Fetch() (time.Time, error) {
 	return time.Now(), s.err
 }
 
-func (s *errStreamer) Notify() {}
+func (s *errStreamer) Notify() {
+	s.isNotified = true
+}
 
 func (s *errStreamer) Close() {}
 
@@ -71,6 +74,7 @@ func TestStream(t *testing.T) {
 
 		// THEN
 		require.EqualError(t, actualErr, wantedErr.Error())
+		require.True(t, streamer.isNotified, "events stored before the error should be flushed")
 	})
 
 	t.Run("calls Fetch and Notify multiple times until context is canceled", func(t *testing.T) {
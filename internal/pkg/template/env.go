@@ -39,8 +39,13 @@ type EnvOpts struct {
 	CustomDomainLambda        string
 	ScriptBucketName          string
 
-	ImportVPC *config.ImportVPC
-	VPCConfig *config.AdjustVPC
+	ImportVPC        *config.ImportVPC
+	VPCConfig        *config.AdjustVPC
+	ForceNATGateways bool // Create the NAT gateways even if no workload is placed in the private subnets.
+
+	ImportCertARNs []string          // ARNs of existing ACM certificates attached to the HTTPS listener of the public load balancer.
+	ImportALB      *config.ImportALB // Existing Application Load Balancer that the listeners are attached to instead of creating one.
+	Telemetry      *config.Telemetry
 
 	LatestVersion string
 }
@@ -6,7 +6,9 @@ package template
 import (
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseEnv(t *testing.T) {
@@ -33,3 +35,105 @@ func TestTemplate_ParseEnv(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "test", c.String())
 }
+
+func TestTemplate_ParseEnvManifestSettings(t *testing.T) {
+	type cfn struct {
+		Conditions map[string]interface{} `yaml:"Conditions"`
+		Resources  map[string]struct {
+			Condition  string                 `yaml:"Condition"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+		Outputs map[string]struct {
+			Condition string `yaml:"Condition"`
+		} `yaml:"Outputs"`
+	}
+	defaultVPC := &config.AdjustVPC{
+		CIDR:               "10.0.0.0/16",
+		PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+		PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+	}
+
+	t.Run("uses the certificate validated by Copilot by default", func(t *testing.T) {
+		content, err := New().ParseEnv(&EnvOpts{
+			VPCConfig: defaultVPC,
+		}, WithFuncs(map[string]interface{}{
+			"inc": IncFunc,
+		}))
+		require.NoError(t, err)
+
+		var tpl cfn
+		require.NoError(t, yaml.Unmarshal(content.Bytes(), &tpl))
+		require.Equal(t, "ExportHTTPSListener", tpl.Resources["HTTPSListener"].Condition)
+		require.Equal(t, "ExportHTTPSListener", tpl.Outputs["HTTPSListenerArn"].Condition)
+		require.NotContains(t, tpl.Resources, "HTTPSImportCertificates")
+		require.NotContains(t, tpl.Resources, "HTTPSCopilotCertificate")
+		require.Equal(t, "CreateInternalALB", tpl.Resources["InternalLoadBalancer"].Condition)
+		require.Equal(t, "internal", tpl.Resources["InternalLoadBalancer"].Properties["Scheme"])
+		require.Equal(t, "CreateInternalALB", tpl.Outputs["InternalHTTPListenerArn"].Condition)
+		require.NotContains(t, tpl.Resources["Cluster"].Properties, "ClusterSettings")
+		// The yaml package drops the short form of the intrinsic functions: !Not [!Equals [ !Ref NATWorkloads, ""]]
+		require.Equal(t, []interface{}{[]interface{}{"NATWorkloads", ""}}, tpl.Conditions["CreateNATGateways"])
+	})
+
+	t.Run("renders the settings of the environment manifest", func(t *testing.T) {
+		content, err := New().ParseEnv(&EnvOpts{
+			VPCConfig:        defaultVPC,
+			ForceNATGateways: true,
+			ImportCertARNs:   []string{"arn:aws:acm:us-west-2:123456789012:certificate/1", "arn:aws:acm:us-west-2:123456789012:certificate/2"},
+			Telemetry: &config.Telemetry{
+				EnableContainerInsights: true,
+			},
+		}, WithFuncs(map[string]interface{}{
+			"inc": IncFunc,
+		}))
+		require.NoError(t, err)
+
+		var tpl cfn
+		require.NoError(t, yaml.Unmarshal(content.Bytes(), &tpl))
+		listener := tpl.Resources["HTTPSListener"]
+		require.Equal(t, "CreateALB", listener.Condition)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"CertificateArn": "arn:aws:acm:us-west-2:123456789012:certificate/1"},
+		}, listener.Properties["Certificates"])
+		require.Equal(t, "CreateALB", tpl.Outputs["HTTPSListenerArn"].Condition)
+		require.Len(t, tpl.Resources["HTTPSImportCertificates"].Properties["Certificates"], 2)
+		require.Equal(t, "ExportHTTPSListener", tpl.Resources["HTTPSCopilotCertificate"].Condition)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"Name": "containerInsights", "Value": "enabled"},
+		}, tpl.Resources["Cluster"].Properties["ClusterSettings"])
+		require.Equal(t, []interface{}{"true", "true"}, tpl.Conditions["CreateNATGateways"])
+	})
+
+	t.Run("attaches the listeners to an imported load balancer", func(t *testing.T) {
+		content, err := New().ParseEnv(&EnvOpts{
+			ImportVPC: &config.ImportVPC{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+			ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/1"},
+			ImportALB: &config.ImportALB{
+				ARN:              "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+				DNSName:          "my-lb-1234.us-west-2.elb.amazonaws.com",
+				HostedZoneID:     "Z1H1FL5HABSF5",
+				SecurityGroupIDs: []string{"sg-1", "sg-2"},
+			},
+		}, WithFuncs(map[string]interface{}{
+			"inc": IncFunc,
+		}))
+		require.NoError(t, err)
+
+		var tpl cfn
+		require.NoError(t, yaml.Unmarshal(content.Bytes(), &tpl))
+		require.NotContains(t, tpl.Resources, "PublicLoadBalancer")
+		require.NotContains(t, tpl.Resources, "PublicLoadBalancerSecurityGroup")
+		require.NotContains(t, tpl.Resources, "HTTPSImportCertificates")
+		require.Equal(t, "sg-1", tpl.Resources["EnvironmentSecurityGroupIngressFromPublicALB1"].Properties["SourceSecurityGroupId"])
+		require.Equal(t, "sg-2", tpl.Resources["EnvironmentSecurityGroupIngressFromPublicALB2"].Properties["SourceSecurityGroupId"])
+		for _, listener := range []string{"HTTPListener", "HTTPSListener"} {
+			require.Equal(t, "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+				tpl.Resources[listener].Properties["LoadBalancerArn"], listener)
+		}
+		require.Equal(t, "my-lb-1234.us-west-2.elb.amazonaws.com", tpl.Resources["CustomDomainAction"].Properties["LoadBalancerDNS"])
+	})
+}
@@ -200,6 +200,19 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				ServiceDiscoveryEndpoint: "test.app.local",
 			},
 		},
+		"renders a valid template with a canary deployment": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Deployment: &template.DeploymentOpts{
+					Strategy:                template.DeploymentStrategyCanary,
+					BakeTimeInMinutes:       aws.Int(10),
+					CanaryPercent:           aws.Int(10),
+					CanaryBakeTimeInMinutes: aws.Int(5),
+					RollbackAlarms:          []string{"frontend-5xx"},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -41,6 +41,11 @@ const (
 	DisablePublicIP         = "DISABLED"
 	PublicSubnetsPlacement  = "PublicSubnets"
 	PrivateSubnetsPlacement = "PrivateSubnets"
+
+	// ECS deployment strategies.
+	DeploymentStrategyRolling   = "ROLLING"
+	DeploymentStrategyBlueGreen = "BLUE_GREEN"
+	DeploymentStrategyCanary    = "CANARY"
 )
 
 // Constants for ARN options.
@@ -76,6 +81,9 @@ var (
 		"accessrole",
 		"publish",
 		"subscribe",
+		"target-group-properties",
+		"job-subscribe",
+		"nlb",
 	}
 )
 
@@ -182,6 +190,36 @@ type HTTPHealthCheckOpts struct {
 	GracePeriod         *int64
 }
 
+// NetworkLoadBalancer holds configuration for a network load balancer in front of a service.
+type NetworkLoadBalancer struct {
+	Port            string
+	Protocol        string // One of TCP, UDP or TLS.
+	CertificateARN  string // Only set when the listener terminates TLS.
+	TargetContainer string
+	TargetPort      string
+	// UDPOnMainContainer is true if the main container needs an additional UDP port mapping to receive traffic.
+	UDPOnMainContainer bool
+	HealthCheck        NLBHealthCheck
+}
+
+// TargetProtocol returns the protocol that the load balancer uses to route traffic to the targets.
+// TLS connections are terminated at the listener and forwarded over TCP.
+func (nlb *NetworkLoadBalancer) TargetProtocol() string {
+	if nlb.Protocol == "TLS" {
+		return "TCP"
+	}
+	return nlb.Protocol
+}
+
+// NLBHealthCheck holds configuration for the TCP health checks of a network load balancer target group.
+type NLBHealthCheck struct {
+	Port               string // Defaults to the traffic port if empty.
+	HealthyThreshold   *int64
+	UnhealthyThreshold *int64
+	Timeout            *int64
+	Interval           *int64
+}
+
 // AdvancedCount holds configuration for autoscaling and capacity provider
 // parameters.
 type AdvancedCount struct {
@@ -206,17 +244,81 @@ type AutoscalingOpts struct {
 	Memory       *float64
 	Requests     *float64
 	ResponseTime *float64
+	QueueDelay   *AutoscalingQueueDelayOpts
+}
+
+// AutoscalingQueueDelayOpts holds configuration to scale a service based on the backlog of its SQS queues.
+type AutoscalingQueueDelayOpts struct {
+	AcceptableBacklogPerTask int
+}
+
+// DeploymentOpts holds configuration for how ECS rolls out new revisions of a service.
+type DeploymentOpts struct {
+	Strategy                string // One of ROLLING, BLUE_GREEN or CANARY.
+	BakeTimeInMinutes       *int
+	CanaryPercent           *int
+	CanaryBakeTimeInMinutes *int
+	RollbackAlarms          []string
+	DisableCircuitBreaker   bool
+}
+
+// ShiftsTraffic returns true if the deployment shifts traffic between an alternate and a production target group.
+func (d *DeploymentOpts) ShiftsTraffic() bool {
+	return d.Strategy == DeploymentStrategyBlueGreen || d.Strategy == DeploymentStrategyCanary
+}
+
+// CircuitBreakerEnabled returns true if ECS should roll back deployments that fail to reach a steady state.
+// The circuit breaker is enabled by default, including when no deployment configuration is provided.
+func (d *DeploymentOpts) CircuitBreakerEnabled() bool {
+	if d == nil {
+		return true
+	}
+	return !d.DisableCircuitBreaker
 }
 
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// Operating system families and CPU architectures of the runtime platform of an ECS task.
+const (
+	OSLinux   = "LINUX"
+	ArchARM64 = "ARM64"
+)
+
+// RuntimePlatformOpts holds configuration needed for the operating system and CPU architecture that the tasks run on.
+type RuntimePlatformOpts struct {
+	OS   string
+	Arch string
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
 	Retries *int
 }
 
+// JobTriggerOpts holds configuration for the events, other than a schedule, that trigger a job.
+type JobTriggerOpts struct {
+	EventPatterns []string // JSON-encoded EventBridge event patterns.
+	S3            []*S3TriggerOpts
+	Topics        []*TopicSubscription
+}
+
+// HasRules returns true if the job needs EventBridge rules to be triggered.
+func (j *JobTriggerOpts) HasRules() bool {
+	if j == nil {
+		return false
+	}
+	return len(j.EventPatterns) > 0 || len(j.S3) > 0
+}
+
+// S3TriggerOpts holds configuration needed to trigger a job when objects are created in a storage addon bucket.
+type S3TriggerOpts struct {
+	Name         string // Logical ID safe name of the bucket.
+	BucketOutput string // Name of the addons stack output that holds the bucket name.
+	Prefix       string // Optional object key prefix.
+}
+
 // PublishOpts holds configuration needed if the service has publishers.
 type PublishOpts struct {
 	Topics []*Topic
@@ -301,6 +403,7 @@ type WorkloadOpts struct {
 	Storage                  *StorageOpts
 	Network                  *NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	Platform                 *RuntimePlatformOpts
 	EntryPoint               []string
 	Command                  []string
 	DomainAlias              string
@@ -308,13 +411,16 @@ type WorkloadOpts struct {
 	DependsOn                map[string]string
 	Publish                  *PublishOpts
 	ServiceDiscoveryEndpoint string
+	Deployment               *DeploymentOpts
 
 	// Additional options for service templates.
 	WorkloadType         string
+	ALBEnabled           bool // Whether a backend service is placed behind the internal load balancer of the environment.
 	HealthCheck          *ecs.HealthCheck
 	HTTPHealthCheck      HTTPHealthCheckOpts
 	DeregistrationDelay  *int64
 	AllowedSourceIps     []string
+	NLB                  *NetworkLoadBalancer
 	RulePriorityLambda   string
 	DesiredCountLambda   string
 	EnvControllerLambda  string
@@ -323,9 +429,11 @@ type WorkloadOpts struct {
 	// Additional options for job templates.
 	ScheduleExpression string
 	StateMachine       *StateMachineOpts
+	JobTriggers        *JobTriggerOpts
 
 	// Additional options for worker service templates.
-	Subscribe *SubscribeOpts
+	Subscribe               *SubscribeOpts
+	BacklogCalculatorLambda string
 }
 
 // ParseRequestDrivenWebServiceInput holds data that can be provided to enable features for a request-driven web service stack.
@@ -460,6 +568,9 @@ func envControllerParameters(o WorkloadOpts) []string {
 	if o.WorkloadType == "Load Balanced Web Service" {
 		parameters = append(parameters, []string{"ALBWorkloads,", "Aliases,"}...) // YAML needs the comma separator; resolved in EnvContr.
 	}
+	if o.WorkloadType == "Backend Service" && o.ALBEnabled {
+		parameters = append(parameters, "InternalALBWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
+	}
 	if o.Network.SubnetsType == PrivateSubnetsPlacement {
 		parameters = append(parameters, "NATWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -54,6 +55,9 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/accessrole.yml":                      []byte("accessrole"),
 					"templates/workloads/partials/cf/publish.yml":                         []byte("publish"),
 					"templates/workloads/partials/cf/subscribe.yml":                       []byte("subscribe"),
+					"templates/workloads/partials/cf/target-group-properties.yml":         []byte("target-group-properties"),
+					"templates/workloads/partials/cf/job-subscribe.yml":                   []byte("job-subscribe"),
+					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -81,6 +85,9 @@ func TestTemplate_ParseSvc(t *testing.T) {
   accessrole
   publish
   subscribe
+  target-group-properties
+  job-subscribe
+  nlb
 `,
 		},
 	}
@@ -236,3 +243,458 @@ func TestTemplate_ParseNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseDeploymentConfiguration(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					DeploymentConfiguration map[interface{}]interface{} `yaml:"DeploymentConfiguration"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input *DeploymentOpts
+
+		wantedDeploymentConfig string
+	}{
+		"should enable the circuit breaker by default": {
+			input: nil,
+
+			wantedDeploymentConfig: `
+  DeploymentCircuitBreaker:
+    Enable: true
+    Rollback: true
+  MinimumHealthyPercent: 100
+  MaximumPercent: 200
+`,
+		},
+		"should disable the circuit breaker": {
+			input: &DeploymentOpts{
+				Strategy:              DeploymentStrategyRolling,
+				DisableCircuitBreaker: true,
+			},
+
+			wantedDeploymentConfig: `
+  DeploymentCircuitBreaker:
+    Enable: false
+    Rollback: false
+  MinimumHealthyPercent: 100
+  MaximumPercent: 200
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			wanted := make(map[interface{}]interface{})
+			err := yaml.Unmarshal([]byte(tc.wantedDeploymentConfig), &wanted)
+			require.NoError(t, err, "unmarshal wanted config")
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				Deployment: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			require.Equal(t, wanted, actual.Resources.Service.Properties.DeploymentConfiguration)
+		})
+	}
+}
+
+func TestTemplate_ParseScheduledJobTriggers(t *testing.T) {
+	type cfn struct {
+		Resources map[string]interface{} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inSchedule string
+		inTriggers *JobTriggerOpts
+
+		wantedResources   []string
+		unwantedResources []string
+	}{
+		"renders only the schedule rule": {
+			inSchedule: "rate(1 hour)",
+
+			wantedResources:   []string{"Rule", "RuleRole"},
+			unwantedResources: []string{"EventPatternRule0", "EventsQueue", "EventsPipe"},
+		},
+		"renders event rules and topic subscriptions without a schedule": {
+			inTriggers: &JobTriggerOpts{
+				EventPatterns: []string{`{"source":["aws.ecr"]}`},
+				S3: []*S3TriggerOpts{
+					{
+						Name:         "uploads",
+						BucketOutput: "uploadsName",
+						Prefix:       "incoming/",
+					},
+				},
+				Topics: []*TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+
+			wantedResources: []string{"EventPatternRule0", "uploadsS3EventRule", "RuleRole",
+				"EventsQueue", "QueuePolicy", "apiordersSNSTopicSubscription", "EventsPipe", "EventsPipeRole"},
+			unwantedResources: []string{"Rule"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseScheduledJob(WorkloadOpts{
+				ScheduleExpression: tc.inSchedule,
+				JobTriggers:        tc.inTriggers,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse scheduled job")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal template")
+			for _, resource := range tc.wantedResources {
+				require.Contains(t, actual.Resources, resource)
+			}
+			for _, resource := range tc.unwantedResources {
+				require.NotContains(t, actual.Resources, resource)
+			}
+		})
+	}
+}
+
+func TestTemplate_ParseWorkerServiceQueueScaling(t *testing.T) {
+	type cfn struct {
+		Resources map[string]interface{} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inAutoscaling *AutoscalingOpts
+
+		wantedResources   []string
+		unwantedResources []string
+	}{
+		"does not render queue scaling without queue delay": {
+			inAutoscaling: &AutoscalingOpts{
+				MinCapacity: aws.Int(1),
+				MaxCapacity: aws.Int(10),
+				CPU:         aws.Float64(70),
+			},
+
+			wantedResources:   []string{"AutoScalingTarget", "AutoScalingPolicyECSServiceAverageCPUUtilization"},
+			unwantedResources: []string{"BacklogPerTaskCalculatorFunction", "AutoScalingPolicyEventsQueue"},
+		},
+		"renders a scaling policy for each queue": {
+			inAutoscaling: &AutoscalingOpts{
+				MinCapacity: aws.Int(1),
+				MaxCapacity: aws.Int(10),
+				QueueDelay: &AutoscalingQueueDelayOpts{
+					AcceptableBacklogPerTask: 2400,
+				},
+			},
+
+			wantedResources: []string{"BacklogPerTaskCalculatorLogGroup", "BacklogPerTaskCalculatorFunction",
+				"BacklogPerTaskCalculatorRole", "BacklogPerTaskScheduledRule", "PermissionToInvokeBacklogPerTaskCalculatorLambda",
+				"AutoScalingPolicyEventsQueue", "AutoScalingPolicyapiordersEventsQueue"},
+			unwantedResources: []string{"AutoScalingPolicyapiusersEventsQueue"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseWorkerService(WorkloadOpts{
+				Autoscaling: tc.inAutoscaling,
+				Subscribe: &SubscribeOpts{
+					Topics: []*TopicSubscription{
+						{
+							Name:    aws.String("orders"),
+							Service: aws.String("api"),
+							Queue:   &SQSQueue{},
+						},
+						{
+							Name:    aws.String("users"),
+							Service: aws.String("api"),
+						},
+					},
+				},
+				BacklogCalculatorLambda: "lambda",
+			})
+
+			// THEN
+			require.NoError(t, err, "parse worker service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal template")
+			for _, resource := range tc.wantedResources {
+				require.Contains(t, actual.Resources, resource)
+			}
+			for _, resource := range tc.unwantedResources {
+				require.NotContains(t, actual.Resources, resource)
+			}
+		})
+	}
+}
+
+func TestTemplate_ParseBackendServiceInternalALB(t *testing.T) {
+	type cfn struct {
+		Parameters map[string]interface{} `yaml:"Parameters"`
+		Resources  map[string]struct {
+			DependsOn  interface{}            `yaml:"DependsOn"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inALBEnabled bool
+
+		wantedResources   []string
+		unwantedResources []string
+	}{
+		"does not render the listener rule without http": {
+			unwantedResources: []string{"TargetGroup", "RulePriorityFunction", "HTTPListenerRule", "CustomResourceRole"},
+		},
+		"renders a listener rule on the internal load balancer": {
+			inALBEnabled:    true,
+			wantedResources: []string{"TargetGroup", "RulePriorityFunction", "HTTPRulePriorityAction", "HTTPListenerRule", "CustomResourceRole"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				WorkloadType: "Backend Service",
+				ALBEnabled:   tc.inALBEnabled,
+				HTTPHealthCheck: HTTPHealthCheckOpts{
+					HealthCheckPath: "/",
+					GracePeriod:     aws.Int64(60),
+				},
+				DeregistrationDelay: aws.Int64(60),
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual), "unmarshal template")
+			for _, resource := range tc.wantedResources {
+				require.Contains(t, actual.Resources, resource)
+			}
+			for _, resource := range tc.unwantedResources {
+				require.NotContains(t, actual.Resources, resource)
+			}
+			if !tc.inALBEnabled {
+				require.NotContains(t, actual.Parameters, "RulePath")
+				return
+			}
+			require.Contains(t, actual.Parameters, "RulePath")
+			require.Equal(t, []interface{}{"EnvControllerAction", "HTTPListenerRule"}, actual.Resources["Service"].DependsOn)
+			require.Contains(t, actual.Resources["Service"].Properties, "LoadBalancers")
+			require.Contains(t, actual.Resources["EnvControllerAction"].Properties["Parameters"], "InternalALBWorkloads")
+		})
+	}
+}
+
+func TestTemplate_ParseLoadBalancedWebServiceNLB(t *testing.T) {
+	type cfn struct {
+		Resources map[string]struct {
+			DependsOn  interface{}            `yaml:"DependsOn"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+		Outputs map[string]interface{} `yaml:"Outputs"`
+	}
+
+	testCases := map[string]struct {
+		inNLB *NetworkLoadBalancer
+
+		wantedListener      map[string]interface{}
+		wantedTargetGroup   map[string]interface{}
+		wantedPortMappings  []interface{}
+		wantedLoadBalancers int
+	}{
+		"does not render a network load balancer by default": {
+			wantedPortMappings: []interface{}{
+				map[string]interface{}{"ContainerPort": "ContainerPort"},
+			},
+			wantedLoadBalancers: 1,
+		},
+		"renders a TLS listener that forwards TCP traffic": {
+			inNLB: &NetworkLoadBalancer{
+				Port:            "443",
+				Protocol:        "TLS",
+				CertificateARN:  "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+				TargetContainer: "frontend",
+				TargetPort:      "8080",
+			},
+			wantedListener: map[string]interface{}{
+				"Port":     443,
+				"Protocol": "TLS",
+				"Certificates": []interface{}{
+					map[string]interface{}{"CertificateArn": "arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+				},
+			},
+			wantedTargetGroup: map[string]interface{}{
+				"Port":     8080,
+				"Protocol": "TCP",
+			},
+			wantedPortMappings: []interface{}{
+				map[string]interface{}{"ContainerPort": "ContainerPort"},
+			},
+			wantedLoadBalancers: 2,
+		},
+		"renders a UDP listener and port mapping on the main container": {
+			inNLB: &NetworkLoadBalancer{
+				Port:               "27015",
+				Protocol:           "UDP",
+				TargetContainer:    "frontend",
+				TargetPort:         "27015",
+				UDPOnMainContainer: true,
+				HealthCheck: NLBHealthCheck{
+					Port: "80",
+				},
+			},
+			wantedListener: map[string]interface{}{
+				"Port":     27015,
+				"Protocol": "UDP",
+			},
+			wantedTargetGroup: map[string]interface{}{
+				"Port":            27015,
+				"Protocol":        "UDP",
+				"HealthCheckPort": 80,
+			},
+			wantedPortMappings: []interface{}{
+				map[string]interface{}{"ContainerPort": "ContainerPort"},
+				map[string]interface{}{"ContainerPort": "ContainerPort", "Protocol": "udp"},
+			},
+			wantedLoadBalancers: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				WorkloadType: "Load Balanced Web Service",
+				HTTPHealthCheck: HTTPHealthCheckOpts{
+					HealthCheckPath: "/",
+					GracePeriod:     aws.Int64(60),
+				},
+				DeregistrationDelay: aws.Int64(60),
+				NLB:                 tc.inNLB,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			var actual cfn
+			require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual), "unmarshal template")
+			containers := actual.Resources["TaskDefinition"].Properties["ContainerDefinitions"].([]interface{})
+			require.Equal(t, tc.wantedPortMappings, containers[0].(map[string]interface{})["PortMappings"])
+			require.Len(t, actual.Resources["Service"].Properties["LoadBalancers"], tc.wantedLoadBalancers)
+			if tc.inNLB == nil {
+				require.NotContains(t, actual.Resources, "PublicNetworkLoadBalancer")
+				require.NotContains(t, actual.Outputs, "PublicNetworkLoadBalancerDNSName")
+				return
+			}
+			require.Contains(t, actual.Resources, "PublicNetworkLoadBalancer")
+			require.Contains(t, actual.Resources, "NLBSecurityGroup")
+			for k, v := range tc.wantedListener {
+				require.Equal(t, v, actual.Resources["NLBListener"].Properties[k], k)
+			}
+			for k, v := range tc.wantedTargetGroup {
+				require.Equal(t, v, actual.Resources["NLBTargetGroup"].Properties[k], k)
+			}
+			require.Equal(t, []interface{}{"WaitUntilListenerRuleIsCreated", "NLBListener"}, actual.Resources["Service"].DependsOn)
+			require.Contains(t, actual.Outputs, "PublicNetworkLoadBalancerDNSName")
+			require.Contains(t, actual.Outputs, "PublicNetworkLoadBalancerPort")
+		})
+	}
+}
+
+func TestTemplate_ParseWorkloadContainerMountPoints(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []struct {
+						MountPoints  []map[string]interface{} `yaml:"MountPoints"`
+						DockerLabels map[string]interface{}   `yaml:"DockerLabels"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inStorage *StorageOpts
+
+		wantedMountPoints int
+	}{
+		"without storage": {},
+		"with mount points": {
+			inStorage: &StorageOpts{
+				Volumes: []*Volume{
+					{
+						Name: aws.String("persistence"),
+					},
+				},
+				MountPoints: []*MountPoint{
+					{
+						ContainerPath: aws.String("/etc/data"),
+						ReadOnly:      aws.Bool(true),
+						SourceVolume:  aws.String("persistence"),
+					},
+				},
+			},
+			wantedMountPoints: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				WorkloadType: "Backend Service",
+				Storage:      tc.inStorage,
+				HTTPHealthCheck: HTTPHealthCheckOpts{
+					HealthCheckPath: "/",
+				},
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual), "unmarshal template")
+			containers := actual.Resources.TaskDefinition.Properties.ContainerDefinitions
+			require.NotEmpty(t, containers)
+			require.Len(t, containers[0].MountPoints, tc.wantedMountPoints)
+			require.Contains(t, containers[0].DockerLabels, "copilot-deployed-by")
+		})
+	}
+}
@@ -30,9 +30,10 @@ type ResourceRendererOpts struct {
 
 // ECSServiceRendererOpts is optional configuration for a listening ECS service renderer.
 type ECSServiceRendererOpts struct {
-	Group      *errgroup.Group
-	Ctx        context.Context
-	RenderOpts RenderOptions
+	Group         *errgroup.Group
+	Ctx           context.Context
+	RenderOpts    RenderOptions
+	ListenerRules stream.ListenerRuleDescriber // Optional client to render traffic shifting between target groups.
 }
 
 // ListeningChangeSetRenderer returns a component that listens for CloudFormation
@@ -74,9 +75,10 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 		ecsDescriber: ecsDescriber,
 		logicalID:    logicalID,
 
-		group:      g,
-		ctx:        ctx,
-		renderOpts: opts.RenderOpts,
+		group:         g,
+		ctx:           ctx,
+		renderOpts:    opts.RenderOpts,
+		listenerRules: opts.ListenerRules,
 		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
 			RenderOpts: opts.RenderOpts,
 		}),
@@ -241,9 +243,10 @@ type ecsServiceResourceComponent struct {
 	logicalID    string                     // LogicalID for the service.
 
 	// Optional inputs.
-	group      *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
-	ctx        context.Context // Context for the ECSDeploymentStreamer.
-	renderOpts RenderOptions
+	group         *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
+	ctx           context.Context // Context for the ECSDeploymentStreamer.
+	renderOpts    RenderOptions
+	listenerRules stream.ListenerRuleDescriber // Client to report traffic shifting between target groups.
 
 	// Sub-components.
 	resourceRenderer   DynamicRenderer
@@ -326,7 +329,11 @@ func (c *ecsServiceResourceComponent) Done() <-chan struct{} {
 
 func (c *ecsServiceResourceComponent) newListeningRollingUpdateRenderer(serviceARN string, startTime time.Time) DynamicRenderer {
 	cluster, service := parseServiceARN(serviceARN)
-	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime)
+	var opts []stream.ECSDeploymentStreamerOption
+	if c.listenerRules != nil {
+		opts = append(opts, stream.WithTrafficShifting(c.listenerRules))
+	}
+	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime, opts...)
 	renderer := ListeningRollingUpdateRenderer(streamer, NestedRenderOptions(c.renderOpts))
 	c.group.Go(func() error {
 		return stream.Stream(c.ctx, streamer)
@@ -25,6 +25,7 @@ type ECSServiceSubscriber interface {
 }
 
 // ListeningRollingUpdateRenderer renders ECS rolling update deployments.
+// If the service shifts traffic between target groups, it also renders the share of requests sent to each target group.
 func ListeningRollingUpdateRenderer(streamer ECSServiceSubscriber, opts RenderOptions) DynamicRenderer {
 	c := &rollingUpdateComponent{
 		padding:           opts.Padding,
@@ -40,6 +41,7 @@ type rollingUpdateComponent struct {
 	// Data to render.
 	deployments []stream.ECSDeployment
 	failureMsgs []string
+	traffic     []stream.TargetGroupTraffic
 
 	// Style configuration for the component.
 	padding           int
@@ -55,6 +57,7 @@ func (c *rollingUpdateComponent) Listen() {
 	for ev := range c.stream {
 		c.mu.Lock()
 		c.deployments = ev.Deployments
+		c.traffic = ev.Traffic
 		c.failureMsgs = append(c.failureMsgs, ev.LatestFailureEvents...)
 		if len(c.failureMsgs) > c.maxLenFailureMsgs {
 			c.failureMsgs = c.failureMsgs[len(c.failureMsgs)-c.maxLenFailureMsgs:]
@@ -76,6 +79,12 @@ func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error)
 	}
 	numLines += nl
 
+	nl, err = c.renderTraffic(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
 	nl, err = c.renderFailureMsgs(buf)
 	if err != nil {
 		return 0, err
@@ -116,6 +125,27 @@ func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int,
 	return nl, err
 }
 
+func (c *rollingUpdateComponent) renderTraffic(out io.Writer) (numLines int, err error) {
+	if len(c.traffic) == 0 {
+		return 0, nil
+	}
+	header := []string{"Target group", "Traffic"}
+	var rows [][]string
+	for _, t := range c.traffic {
+		rows = append(rows, []string{t.TargetGroup, fmt.Sprintf("%d%%", t.Percent)})
+	}
+	table := newTableComponent(color.Faint.Sprintf("Traffic shifting"), header, rows)
+	table.Padding = c.padding
+	nl, err := renderComponents(out, []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the traffic table.
+		table,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("render traffic table: %w", err)
+	}
+	return nl, nil
+}
+
 func (c *rollingUpdateComponent) renderFailureMsgs(out io.Writer) (numLines int, err error) {
 	if len(c.failureMsgs) == 0 {
 		return 0, nil
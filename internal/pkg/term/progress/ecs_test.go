@@ -79,6 +79,7 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 	testCases := map[string]struct {
 		inDeployments []stream.ECSDeployment
 		inFailureMsgs []string
+		inTraffic     []stream.TargetGroupTraffic
 
 		wantedNumLines int
 		wantedOut      string
@@ -98,6 +99,32 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			wantedOut: `Deployments
            Revision  Rollout      Desired  Running  Failed  Pending
   PRIMARY  2         [completed]  10       10       0       0
+`,
+		},
+		"should render traffic shifting between target groups": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "3",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "IN_PROGRESS",
+				},
+			},
+			inTraffic: []stream.TargetGroupTraffic{
+				{TargetGroup: "blue", Percent: 90},
+				{TargetGroup: "green", Percent: 10},
+			},
+
+			wantedNumLines: 8,
+			wantedOut: `Deployments
+           Revision  Rollout        Desired  Running  Failed  Pending
+  PRIMARY  3         [in progress]  10       10       0       0
+
+Traffic shifting
+  Target group  Traffic
+  blue          90%
+  green         10%
 `,
 		},
 		"should render a single failure event": {
@@ -142,6 +169,7 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			c := &rollingUpdateComponent{
 				deployments: tc.inDeployments,
 				failureMsgs: tc.inFailureMsgs,
+				traffic:     tc.inTraffic,
 			}
 
 			// WHEN
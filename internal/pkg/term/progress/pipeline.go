@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const pipelineActionFailed = "Failed"
+
+// PipelineExecutionSubscriber is the interface to subscribe channels to pipeline execution descriptions.
+type PipelineExecutionSubscriber interface {
+	Subscribe() <-chan stream.PipelineExecution
+}
+
+// ListeningPipelineExecutionRenderer renders the status of the actions of a pipeline execution as they run.
+func ListeningPipelineExecutionRenderer(streamer PipelineExecutionSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &pipelineExecutionComponent{
+		padding: opts.Padding,
+		stream:  streamer.Subscribe(),
+		done:    make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type pipelineExecutionComponent struct {
+	// Data to render.
+	execution stream.PipelineExecution
+
+	// Style configuration for the component.
+	padding int
+
+	stream <-chan stream.PipelineExecution // Channel where execution descriptions are received.
+	done   chan struct{}                   // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                      // Lock used to mutate data to render.
+}
+
+// Listen updates the execution to render as descriptions are streamed.
+func (c *pipelineExecutionComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.execution = ev
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the actions as a tableComponent and then the summaries of failed actions as singleLineComponents.
+func (c *pipelineExecutionComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	buf := new(bytes.Buffer)
+
+	nl, err := c.renderActions(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	nl, err = c.renderFailures(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render pipeline execution component to writer: %w", err)
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *pipelineExecutionComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *pipelineExecutionComponent) renderActions(out io.Writer) (numLines int, err error) {
+	header := []string{"Stage", "Action", "Status"}
+	var rows [][]string
+	for _, action := range c.execution.Actions {
+		rows = append(rows, []string{
+			action.StageName,
+			action.ActionName,
+			prettifyPipelineStatus(action.Status),
+		})
+	}
+	title := color.Faint.Sprintf("Actions")
+	if c.execution.Status != "" {
+		title = fmt.Sprintf("%s %s", title, prettifyPipelineStatus(c.execution.Status))
+	}
+	table := newTableComponent(title, header, rows)
+	table.Padding = c.padding
+	nl, err := table.Render(out)
+	if err != nil {
+		return 0, fmt.Errorf("render actions table: %w", err)
+	}
+	return nl, nil
+}
+
+func (c *pipelineExecutionComponent) renderFailures(out io.Writer) (numLines int, err error) {
+	var components []Renderer
+	for _, action := range c.execution.Actions {
+		if action.Status != pipelineActionFailed || action.Summary == "" {
+			continue
+		}
+		components = append(components, &singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("%s failed", action.ActionName)),
+			Padding: c.padding,
+		})
+		for i, truncatedMsg := range splitByLength(action.Summary, maxCellLength) {
+			pretty := fmt.Sprintf("  %s", truncatedMsg)
+			if i == 0 {
+				pretty = fmt.Sprintf("- %s", truncatedMsg)
+			}
+			components = append(components, &singleLineComponent{
+				Text:    pretty,
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	if len(components) == 0 {
+		return 0, nil
+	}
+	// Add an empty line before rendering the failures.
+	return renderComponents(out, append([]Renderer{&singleLineComponent{}}, components...))
+}
+
+// prettifyPipelineStatus transforms a CodePipeline status such as "InProgress" to "[in progress]".
+func prettifyPipelineStatus(status string) string {
+	var words []string
+	var word strings.Builder
+	for _, r := range status {
+		if unicode.IsUpper(r) && word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+		word.WriteRune(unicode.ToLower(r))
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(words, " "))
+}
@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineExecutionComponent_Listen(t *testing.T) {
+	// GIVEN
+	events := make(chan stream.PipelineExecution)
+	c := &pipelineExecutionComponent{
+		stream: events,
+		done:   make(chan struct{}),
+	}
+
+	// WHEN
+	go c.Listen()
+	go func() {
+		events <- stream.PipelineExecution{Status: "InProgress"}
+		events <- stream.PipelineExecution{
+			Status: "Succeeded",
+			Actions: []codepipeline.ActionExecution{
+				{StageName: "Source", ActionName: "SourceCodeFor-dinder", Status: "Succeeded"},
+			},
+		}
+		close(events)
+	}()
+
+	// THEN
+	<-c.done // Listen should have closed the channel.
+	require.Equal(t, stream.PipelineExecution{
+		Status: "Succeeded",
+		Actions: []codepipeline.ActionExecution{
+			{StageName: "Source", ActionName: "SourceCodeFor-dinder", Status: "Succeeded"},
+		},
+	}, c.execution, "expected only the latest execution to be stored")
+}
+
+func TestPipelineExecutionComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inExecution stream.PipelineExecution
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should not render anything before actions start": {
+			inExecution: stream.PipelineExecution{Status: "InProgress"},
+		},
+		"should render the status of the actions": {
+			inExecution: stream.PipelineExecution{
+				Status: "InProgress",
+				Actions: []codepipeline.ActionExecution{
+					{StageName: "Source", ActionName: "SourceCodeFor-dinder", Status: "Succeeded"},
+					{StageName: "Build", ActionName: "Build", Status: "InProgress"},
+				},
+			},
+
+			wantedNumLines: 4,
+			wantedOut: `Actions [in progress]
+  Stage   Action                Status
+  Source  SourceCodeFor-dinder  [succeeded]
+  Build   Build                 [in progress]
+`,
+		},
+		"should render the summaries of failed actions": {
+			inExecution: stream.PipelineExecution{
+				Status: "Failed",
+				Actions: []codepipeline.ActionExecution{
+					{StageName: "DeployTo-test", ActionName: "CreateOrUpdate-api-test", Status: "Failed", Summary: "Stack update failed."},
+				},
+			},
+
+			wantedNumLines: 6,
+			wantedOut: `Actions [failed]
+  Stage          Action                   Status
+  DeployTo-test  CreateOrUpdate-api-test  [failed]
+
+✘ CreateOrUpdate-api-test failed
+  - Stack update failed.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &pipelineExecutionComponent{
+				execution: tc.inExecution,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
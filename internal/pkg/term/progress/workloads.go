@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Statuses of an operation on a workload.
+const (
+	workloadNotStarted = "not started"
+	workloadFailed     = "failed"
+	workloadSkipped    = "skipped"
+)
+
+// workloadOperation holds the labels of an operation run on several workloads, such as an image build.
+type workloadOperation struct {
+	title      string
+	inProgress string
+	succeeded  string
+}
+
+var (
+	imageBuildOperation = workloadOperation{
+		title:      "Images",
+		inProgress: "building",
+		succeeded:  "pushed",
+	}
+	deploymentOperation = workloadOperation{
+		title:      "Deployments",
+		inProgress: "deploying",
+		succeeded:  "deployed",
+	}
+)
+
+type workloadRow struct {
+	status string
+	sw     *stopWatch
+}
+
+// Workloads renders the status of an operation run concurrently on several workloads, such as image builds or deployments.
+// It's done once the operation has stopped or was skipped for every workload.
+type Workloads struct {
+	op      workloadOperation
+	names   []string
+	rows    map[string]*workloadRow
+	padding int
+
+	remaining int
+	done      chan struct{}
+	mu        sync.Mutex
+}
+
+// NewImageBuilds returns a Workloads that renders the image build of each workload name in order.
+func NewImageBuilds(names []string, opts RenderOptions) *Workloads {
+	return newWorkloads(imageBuildOperation, names, opts)
+}
+
+// NewDeployments returns a Workloads that renders the deployment of each workload name in order.
+func NewDeployments(names []string, opts RenderOptions) *Workloads {
+	return newWorkloads(deploymentOperation, names, opts)
+}
+
+func newWorkloads(op workloadOperation, names []string, opts RenderOptions) *Workloads {
+	rows := make(map[string]*workloadRow, len(names))
+	for _, name := range names {
+		rows[name] = &workloadRow{
+			status: workloadNotStarted,
+			sw:     newStopWatch(),
+		}
+	}
+	w := &Workloads{
+		op:        op,
+		names:     names,
+		rows:      rows,
+		padding:   opts.Padding,
+		remaining: len(names),
+		done:      make(chan struct{}),
+	}
+	if len(names) == 0 {
+		close(w.done)
+	}
+	return w
+}
+
+// Start marks the operation on the workload as in progress.
+func (w *Workloads) Start(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	row, ok := w.rows[name]
+	if !ok {
+		return
+	}
+	row.status = w.op.inProgress
+	row.sw.start()
+}
+
+// Stop marks the operation on the workload as succeeded, or as failed if err is not nil.
+func (w *Workloads) Stop(name string, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	row, ok := w.rows[name]
+	if !ok || w.isStopped(row) {
+		return
+	}
+	row.status = w.op.succeeded
+	if err != nil {
+		row.status = workloadFailed
+	}
+	row.sw.stop()
+	w.markStopped()
+}
+
+// Skip marks the operation on the workload as skipped.
+func (w *Workloads) Skip(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	row, ok := w.rows[name]
+	if !ok || w.isStopped(row) {
+		return
+	}
+	row.status = workloadSkipped
+	w.markStopped()
+}
+
+// Render prints the status of the operation on each workload as a table.
+func (w *Workloads) Render(out io.Writer) (numLines int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var rows [][]string
+	for _, name := range w.names {
+		row := w.rows[name]
+		rows = append(rows, []string{
+			name,
+			w.colorStatus(row.status),
+			prettifyElapsedTime(row.sw),
+		})
+	}
+	table := newTableComponent(color.Faint.Sprintf(w.op.title), []string{"Workload", "Status", ""}, rows)
+	table.Padding = w.padding
+	nl, err := table.Render(out)
+	if err != nil {
+		return 0, fmt.Errorf("render workloads table: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when the operation has stopped or was skipped for every workload.
+func (w *Workloads) Done() <-chan struct{} {
+	return w.done
+}
+
+func (w *Workloads) isStopped(row *workloadRow) bool {
+	return row.status == w.op.succeeded || row.status == workloadFailed || row.status == workloadSkipped
+}
+
+func (w *Workloads) markStopped() {
+	w.remaining -= 1
+	if w.remaining == 0 {
+		close(w.done)
+	}
+}
+
+func (w *Workloads) colorStatus(status string) string {
+	switch status {
+	case w.op.succeeded:
+		return color.Green.Sprintf("[%s]", status)
+	case workloadFailed:
+		return color.Red.Sprintf("[%s]", status)
+	}
+	return color.Faint.Sprintf("[%s]", status)
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloads_Done(t *testing.T) {
+	t.Run("is done right away without any workload", func(t *testing.T) {
+		w := NewImageBuilds(nil, RenderOptions{})
+
+		_, ok := <-w.Done()
+		require.False(t, ok, "expected the done channel to be closed")
+	})
+	t.Run("is done once every operation has stopped or was skipped", func(t *testing.T) {
+		// GIVEN
+		w := NewDeployments([]string{"api", "worker", "mailer"}, RenderOptions{})
+
+		// WHEN
+		w.Start("api")
+		w.Start("worker")
+		w.Stop("api", nil)
+		w.Stop("api", nil) // Stopping an operation twice should not count twice.
+		w.Skip("mailer")
+		w.Skip("mailer")
+
+		// THEN
+		select {
+		case <-w.Done():
+			require.FailNow(t, "expected the deployments not to be done while worker is deploying")
+		default:
+		}
+		w.Stop("worker", errors.New("some error"))
+		_, ok := <-w.Done()
+		require.False(t, ok, "expected the done channel to be closed")
+	})
+}
+
+func TestWorkloads_Render(t *testing.T) {
+	testCases := map[string]struct {
+		newWorkloads func(names []string, opts RenderOptions) *Workloads
+		wanted       string
+	}{
+		"image builds": {
+			newWorkloads: NewImageBuilds,
+			wanted: "Images\n" +
+				"  Workload  Status         \n" +
+				"  api       [pushed]       [10.0s]\n" +
+				"  worker    [failed]       [10.0s]\n" +
+				"  job       [skipped]      \n" +
+				"  cron      [not started]  \n",
+		},
+		"deployments": {
+			newWorkloads: NewDeployments,
+			wanted: "Deployments\n" +
+				"  Workload  Status         \n" +
+				"  api       [deployed]     [10.0s]\n" +
+				"  worker    [failed]       [10.0s]\n" +
+				"  job       [skipped]      \n" +
+				"  cron      [not started]  \n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			clock := &fakeClock{
+				wantedValues: []time.Time{testDate, testDate.Add(10 * time.Second)},
+			}
+			w := tc.newWorkloads([]string{"api", "worker", "job", "cron"}, RenderOptions{})
+			for _, row := range w.rows {
+				row.sw.clock = clock
+			}
+			w.Start("api")
+			w.Stop("api", nil)
+			w.Start("worker")
+			w.Stop("worker", errors.New("some error"))
+			w.Skip("job")
+			buf := new(strings.Builder)
+
+			// WHEN
+			nl, err := w.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, 6, nl, "expected the title, header and a row per workload to be rendered")
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
+
+const pipelineFinalMessage = "Pipeline:"
+
+// PipelineLister lists the names of deployed pipelines.
+type PipelineLister interface {
+	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
+}
+
+// PipelineSelect is an application selector, but can also choose a deployed pipeline of the application.
+type PipelineSelect struct {
+	*Select
+	pipelineSvc PipelineLister
+}
+
+// NewPipelineSelect returns a new selector that chooses applications from the config store and their deployed pipelines.
+func NewPipelineSelect(prompt Prompter, store ConfigLister, lister PipelineLister) *PipelineSelect {
+	return &PipelineSelect{
+		Select:      NewSelect(prompt, store),
+		pipelineSvc: lister,
+	}
+}
+
+// DeployedPipeline has the user select one of the deployed pipelines of the application.
+// If the application has a single pipeline, it is selected without prompting.
+func (s *PipelineSelect) DeployedPipeline(msg, help, app string) (string, error) {
+	pipelines, err := s.pipelineSvc.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: app,
+	})
+	if err != nil {
+		return "", fmt.Errorf("list pipelines: %w", err)
+	}
+	if len(pipelines) == 0 {
+		return "", fmt.Errorf("no pipelines found for application %s", color.HighlightUserInput(app))
+	}
+	if len(pipelines) == 1 {
+		log.Infof("Found pipeline: %s\n", color.HighlightUserInput(pipelines[0]))
+		return pipelines[0], nil
+	}
+	pipeline, err := s.prompt.SelectOne(msg, help, pipelines, prompt.WithFinalMessage(pipelineFinalMessage))
+	if err != nil {
+		return "", fmt.Errorf("select pipeline for application %s: %w", app, err)
+	}
+	return pipeline, nil
+}
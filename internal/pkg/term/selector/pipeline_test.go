@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/selector/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineSelectMocks struct {
+	prompt      *mocks.MockPrompter
+	pipelineSvc *mocks.MockPipelineLister
+}
+
+func TestPipelineSelect_DeployedPipeline(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks pipelineSelectMocks)
+
+		wantErr      error
+		wantPipeline string
+	}{
+		"return error if fail to list pipelines": {
+			setupMocks: func(m pipelineSelectMocks) {
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, mockErr)
+			},
+			wantErr: errors.New("list pipelines: some error"),
+		},
+		"return error if no pipeline found": {
+			setupMocks: func(m pipelineSelectMocks) {
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil)
+			},
+			wantErr: errors.New("no pipelines found for application phonetool"),
+		},
+		"select the only pipeline without prompting": {
+			setupMocks: func(m pipelineSelectMocks) {
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return([]string{"pipeline-phonetool-api"}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantPipeline: "pipeline-phonetool-api",
+		},
+		"return error if fail to select a pipeline": {
+			setupMocks: func(m pipelineSelectMocks) {
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return([]string{"pipeline-phonetool-api", "pipeline-phonetool-web"}, nil)
+				m.prompt.EXPECT().SelectOne("Select a pipeline", "Help text", []string{"pipeline-phonetool-api", "pipeline-phonetool-web"}, gomock.Any()).
+					Return("", mockErr)
+			},
+			wantErr: errors.New("select pipeline for application phonetool: some error"),
+		},
+		"success": {
+			setupMocks: func(m pipelineSelectMocks) {
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return([]string{"pipeline-phonetool-api", "pipeline-phonetool-web"}, nil)
+				m.prompt.EXPECT().SelectOne("Select a pipeline", "Help text", []string{"pipeline-phonetool-api", "pipeline-phonetool-web"}, gomock.Any()).
+					Return("pipeline-phonetool-web", nil)
+			},
+			wantPipeline: "pipeline-phonetool-web",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineSelectMocks{
+				prompt:      mocks.NewMockPrompter(ctrl),
+				pipelineSvc: mocks.NewMockPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+			sel := NewPipelineSelect(m.prompt, mocks.NewMockConfigLister(ctrl), m.pipelineSvc)
+
+			// WHEN
+			pipeline, err := sel.DeployedPipeline("Select a pipeline", "Help text", "phonetool")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantPipeline, pipeline)
+			}
+		})
+	}
+}
@@ -35,6 +35,7 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	environmentsDirName       = "environments"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
@@ -203,6 +204,15 @@ func (ws *Workspace) ReadJobManifest(name string) ([]byte, error) {
 	return mf, nil
 }
 
+// ReadEnvironmentManifest returns the contents of the environment's manifest under copilot/environments/{name}/manifest.yml.
+func (ws *Workspace) ReadEnvironmentManifest(name string) ([]byte, error) {
+	mf, err := ws.read(environmentsDirName, name, manifestFileName)
+	if err != nil {
+		return nil, fmt.Errorf("read environment %s manifest file: %w", name, err)
+	}
+	return mf, nil
+}
+
 func (ws *Workspace) readWorkloadManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
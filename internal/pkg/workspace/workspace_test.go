@@ -771,6 +771,55 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadEnvironmentManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     string
+	}{
+		"reads the manifest of the environment": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				afero.WriteFile(fs, "/copilot/environments/test/manifest.yml", []byte("type: Environment"), 0644)
+				return fs
+			},
+			wantedContent: "type: Environment",
+		},
+		"errors if the manifest does not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/test", 0755)
+				afero.WriteFile(fs, "/copilot/test/manifest.yml", []byte("type: Backend Service"), 0644)
+				return fs
+			},
+			wantedErr: "read environment test manifest file: open /copilot/environments/test/manifest.yml: file does not exist",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			content, err := ws.ReadEnvironmentManifest("test")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string